@@ -2,23 +2,28 @@ package bumpcmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 
+	"github.com/indaco/verso/internal/changeset"
 	"github.com/indaco/verso/internal/clix"
 	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
 	"github.com/indaco/verso/internal/hooks"
 	"github.com/indaco/verso/internal/operations"
 	"github.com/indaco/verso/internal/plugins/changelogparser"
 	"github.com/indaco/verso/internal/plugins/commitparser"
 	"github.com/indaco/verso/internal/plugins/commitparser/gitlog"
 	"github.com/indaco/verso/internal/semver"
+	"github.com/indaco/verso/internal/workspace"
 	"github.com/urfave/cli/v3"
 )
 
 var (
 	tryInferBumpTypeFromCommitParserPluginFn    = tryInferBumpTypeFromCommitParserPlugin
 	tryInferBumpTypeFromChangelogParserPluginFn = tryInferBumpTypeFromChangelogParserPlugin
+	tryInferModuleBumpTypeFn                    = tryInferModuleBumpType
 )
 
 // autoCmd returns the "auto" subcommand.
@@ -27,7 +32,7 @@ func autoCmd(cfg *config.Config) *cli.Command {
 		Name:    "auto",
 		Aliases: []string{"next"},
 		Usage:   "Smart bump logic (e.g. promote pre-release or bump patch)",
-		UsageText: `verso bump auto [--label patch|minor|major] [--meta data] [--preserve-meta] [--since ref] [--until ref] [--no-infer] [--all] [--module name]
+		UsageText: `verso bump auto [--label patch|minor|major] [--meta data] [--preserve-meta] [--since ref] [--until ref] [--no-infer] [--explain] [--all] [--module name]
 
 By default, verso tries to infer the bump type from recent commit messages using the built-in commit-parser plugin.
 You can override this behavior with the --label flag, disable it explicitly with --no-infer, or disable the plugin via the config file (.verso.yaml).`,
@@ -56,6 +61,10 @@ You can override this behavior with the --label flag, disable it explicitly with
 				Name:  "no-infer",
 				Usage: "Disable bump inference from commit messages (overrides config)",
 			},
+			&cli.BoolFlag{
+				Name:  "explain",
+				Usage: "Explain how the bump type is inferred, without changing the version (use --format json for JSON)",
+			},
 			&cli.BoolFlag{
 				Name:  "hook-only",
 				Usage: "Only run pre-release hooks, do not modify the version",
@@ -81,7 +90,25 @@ func runBumpAuto(ctx context.Context, cfg *config.Config, cmd *cli.Command) erro
 	isNoInferFlag := cmd.Bool("no-infer")
 	isSkipHooks := cmd.Bool("skip-hooks")
 
-	disableInfer := isNoInferFlag || (cfg != nil && cfg.Plugins != nil && !cfg.Plugins.CommitParser)
+	disableReason := inferenceDisabledReason(cfg, isNoInferFlag)
+	disableInfer := disableReason != ""
+
+	// While major is 0, inferred breaking changes bump minor and features bump patch
+	initialDev := cfg == nil || cfg.InitialDev.GetEnabled()
+
+	// Pending change files take precedence over the changelog and commits
+	fs := core.NewOSFileSystem()
+	var changes []*changeset.Change
+	if label == "" && changesEnabled(isNoInferFlag) {
+		var err error
+		if changes, err = changeset.Load(fs, changeset.DefaultDir); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Bool("explain") {
+		return runAutoExplain(ctx, cmd, cfg, label, since, until, disableReason, changes, initialDev, isPreserveMeta)
+	}
 
 	// Run pre-release hooks first (before any version operations)
 	if err := hooks.RunPreReleaseHooksFn(isSkipHooks); err != nil {
@@ -96,17 +123,78 @@ func runBumpAuto(ctx context.Context, cfg *config.Config, cmd *cli.Command) erro
 
 	// Handle single-module mode
 	if execCtx.IsSingleModule() {
-		return runSingleModuleAuto(cmd, execCtx.Path, label, meta, since, until, isPreserveMeta, disableInfer)
+		return runSingleModuleAuto(cmd, execCtx.Path, label, meta, since, until, changes, isPreserveMeta, disableInfer, initialDev)
 	}
 
 	// Handle multi-module mode
-	// For auto bump, we need to determine the bump type first
-	bumpType := determineBumpType(label, disableInfer, since, until)
-	return runMultiModuleBump(ctx, cmd, execCtx, bumpType, "", meta, isPreserveMeta)
+	pipeline := bumpPipeline(cfg, cmd)
+	if len(changes) > 0 {
+		fmt.Fprintf(os.Stderr, "Using %d pending change file(s) from %s\n", len(changes), changeset.DefaultDir)
+		pipeline.changes = changes
+		operation := newModuleAutoOperation(fs, since, until, meta, isPreserveMeta, initialDev, pipeline, execCtx.Groups).withChanges(changes)
+		err := runMultiModuleOperation(ctx, cmd, execCtx, operation, pipeline, "Bump auto")
+		if err != nil && pipeline.tx != nil {
+			return err // rolled back: nothing was released
+		}
+		return errors.Join(err, consumeChanges(fs, changes, pipeline.released))
+	}
+	if label == "" && !disableInfer {
+		// A changelog taking precedence describes the whole repository, so its
+		// bump type applies to every module
+		if inferred := tryInferBumpTypeFromChangelogParserPluginFn(); inferred != "" {
+			fmt.Fprintf(os.Stderr, "Inferred bump type: %s\n", inferred)
+			bumpType := labelBumpType(inferred)
+			operation := operations.NewBumpOperation(fs, bumpType, "", meta, isPreserveMeta).
+				WithInitialDevelopment(initialDev).
+				WithHooks(pipeline)
+			return runMultiModuleOperation(ctx, cmd, execCtx, operation, pipeline, fmt.Sprintf("Bump %s", bumpType))
+		}
+
+		// Otherwise each module is bumped by the commits touching its directory
+		operation := newModuleAutoOperation(fs, since, until, meta, isPreserveMeta, initialDev, pipeline, execCtx.Groups)
+		return runMultiModuleOperation(ctx, cmd, execCtx, operation, pipeline, "Bump auto")
+	}
+
+	bumpType := labelBumpType(label)
+	operation := operations.NewBumpOperation(fs, bumpType, "", meta, isPreserveMeta).WithHooks(pipeline)
+	return runMultiModuleOperation(ctx, cmd, execCtx, operation, pipeline, fmt.Sprintf("Bump %s", bumpType))
+}
+
+// changesEnabled reports whether pending change files decide bump types. Like
+// other inference sources, they are ignored with --no-infer and with CalVer.
+func changesEnabled(isNoInferFlag bool) bool {
+	return !isNoInferFlag && semver.DefaultScheme().Name() != semver.SchemeCalVer
+}
+
+// consumeChanges removes the bumps of the released modules from the change
+// files, deleting the files left without bumps.
+func consumeChanges(fs core.FileSystem, changes []*changeset.Change, released []string) error {
+	removed, err := changeset.Consume(fs, changes, released)
+	if removed > 0 {
+		fmt.Fprintf(os.Stderr, "Consumed %d change file(s)\n", removed)
+	}
+	return err
 }
 
-// determineBumpType determines the bump type for multi-module auto bump.
-func determineBumpType(label string, disableInfer bool, since, until string) operations.BumpType {
+// inferenceDisabledReason returns why bump inference is disabled, or an empty
+// string if it is enabled.
+func inferenceDisabledReason(cfg *config.Config, isNoInferFlag bool) string {
+	switch {
+	case isNoInferFlag:
+		return "inference disabled with --no-infer"
+	case cfg != nil && cfg.Plugins != nil && !cfg.Plugins.CommitParser.IsEnabled():
+		return "inference disabled: commit-parser plugin is not enabled"
+	case semver.DefaultScheme().Name() == semver.SchemeCalVer:
+		// CalVer versions follow the calendar, so commit messages cannot pick a bump level
+		return "inference disabled: calver versions follow the calendar"
+	default:
+		return ""
+	}
+}
+
+// labelBumpType returns the bump type for a bump label. An empty or invalid
+// label selects the auto bump, which promotes a pre-release or bumps patch.
+func labelBumpType(label string) operations.BumpType {
 	switch label {
 	case "patch":
 		return operations.BumpPatch
@@ -114,48 +202,40 @@ func determineBumpType(label string, disableInfer bool, since, until string) ope
 		return operations.BumpMinor
 	case "major":
 		return operations.BumpMajor
-	case "":
-		if !disableInfer {
-			// Try changelog parser first if it should take precedence
-			inferred := tryInferBumpTypeFromChangelogParserPluginFn()
-			if inferred == "" {
-				// Fall back to commit parser
-				inferred = tryInferBumpTypeFromCommitParserPluginFn(since, until)
-			}
-
-			if inferred != "" {
-				fmt.Fprintf(os.Stderr, "Inferred bump type: %s\n", inferred)
-				switch inferred {
-				case "minor":
-					return operations.BumpMinor
-				case "major":
-					return operations.BumpMajor
-				default:
-					return operations.BumpPatch
-				}
-			}
-		}
-		// Default to auto which will handle pre-release promotion or patch bump
-		return operations.BumpAuto
 	default:
-		// Invalid label, will be caught during execution
 		return operations.BumpAuto
 	}
 }
 
 // runSingleModuleAuto handles the single-module auto bump operation.
-func runSingleModuleAuto(cmd *cli.Command, path, label, meta, since, until string, isPreserveMeta, disableInfer bool) error {
+func runSingleModuleAuto(cmd *cli.Command, path, label, meta, since, until string, changes []*changeset.Change, isPreserveMeta, disableInfer, initialDev bool) error {
 	if _, err := clix.FromCommandFn(cmd); err != nil {
 		return err
 	}
 
+	release, err := lockVersionFile(path)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	current, err := semver.ReadVersion(path)
 	if err != nil {
 		return fmt.Errorf("failed to read version: %w", err)
 	}
 
-	next, err := getNextVersion(current, label, disableInfer, since, until, isPreserveMeta)
-	if err != nil {
+	var next semver.SemVersion
+	if level := changeset.HighestLevel(changes); level != "" {
+		fmt.Fprintf(os.Stderr, "Inferred bump type from %d change file(s): %s\n", len(changes), level)
+		var note string
+		// Change file levels are explicit: the initial development policy does not apply
+		if next, note, err = bumpInferred(current, level, false, isPreserveMeta); err != nil {
+			return err
+		}
+		if note != "" {
+			fmt.Fprintln(os.Stderr, note)
+		}
+	} else if next, err = getNextVersion(current, label, disableInfer, initialDev, since, until, isPreserveMeta); err != nil {
 		return err
 	}
 
@@ -185,8 +265,8 @@ func runSingleModuleAuto(cmd *cli.Command, path, label, meta, since, until strin
 		return fmt.Errorf("failed to save version: %w", err)
 	}
 
-	// Generate changelog entry
-	if err := generateChangelogAfterBump(next, current, "auto"); err != nil {
+	// Generate changelog entry, with the summaries of the change files
+	if err := generateChangelogWithNotes(next, current, "auto", changeset.Summaries(changes, "")); err != nil {
 		return err
 	}
 
@@ -196,16 +276,25 @@ func runSingleModuleAuto(cmd *cli.Command, path, label, meta, since, until strin
 	}
 
 	fmt.Printf("Bumped version from %s to %s\n", current.String(), next.String())
+
+	if len(changes) > 0 {
+		if err := changeset.Remove(core.NewOSFileSystem(), changes); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Consumed %d change file(s)\n", len(changes))
+	}
 	return nil
 }
 
 // getNextVersion determines the next semantic version based on the provided label,
-// commit inference, or default bump logic. It returns an error if bumping fails
+// commit inference, or default bump logic. With initialDev, inferred bumps of
+// 0.x versions follow the initial development policy (see
+// semver.InitialDevelopmentLabel). It returns an error if bumping fails
 // or if an invalid label is specified.
 func getNextVersion(
 	current semver.SemVersion,
 	label string,
-	disableInfer bool,
+	disableInfer, initialDev bool,
 	since, until string,
 	preserveMeta bool,
 ) (semver.SemVersion, error) {
@@ -230,12 +319,12 @@ func getNextVersion(
 			if inferred != "" {
 				fmt.Fprintf(os.Stderr, "Inferred bump type: %s\n", inferred)
 
-				if current.PreRelease != "" {
-					return promotePreRelease(current, preserveMeta), nil
-				}
-				next, err = semver.BumpByLabelFunc(current, inferred)
+				next, note, err := bumpInferred(current, inferred, initialDev, preserveMeta)
 				if err != nil {
-					return semver.SemVersion{}, fmt.Errorf("failed to bump inferred version: %w", err)
+					return semver.SemVersion{}, err
+				}
+				if note != "" {
+					fmt.Fprintln(os.Stderr, note)
 				}
 				return next, nil
 			}
@@ -252,6 +341,28 @@ func getNextVersion(
 	return next, nil
 }
 
+// bumpInferred applies an inferred bump type to current. Pre-releases are
+// promoted to their release, and with initialDev 0.x versions follow the
+// initial development policy. The returned note describes such an adjustment.
+func bumpInferred(current semver.SemVersion, inferred string, initialDev, preserveMeta bool) (semver.SemVersion, string, error) {
+	if current.PreRelease != "" {
+		return promotePreRelease(current, preserveMeta), "", nil
+	}
+
+	note := ""
+	if initialDev {
+		if adjusted := semver.InitialDevelopmentLabel(current, inferred); adjusted != inferred {
+			note = fmt.Sprintf("Initial development (0.x): bumping %s instead of %s", adjusted, inferred)
+			inferred = adjusted
+		}
+	}
+	next, err := semver.BumpByLabelFunc(current, inferred)
+	if err != nil {
+		return semver.SemVersion{}, "", fmt.Errorf("failed to bump inferred version: %w", err)
+	}
+	return next, note, nil
+}
+
 // setBuildMetadata updates the build metadata of the next version based on
 // the provided meta string and the preserve flag.
 func setBuildMetadata(current, next semver.SemVersion, meta string, preserve bool) semver.SemVersion {
@@ -300,6 +411,37 @@ func tryInferBumpTypeFromCommitParserPlugin(since, until string) string {
 	return label
 }
 
+// tryInferModuleBumpType tries to infer the bump type of a module from the
+// commits touching its directory. An empty since resolves to the last tag of
+// the module. changed reports whether any commit touches the module in a way
+// that implies a bump: commits that imply none, such as docs or chore
+// commits, leave the module unchanged.
+func tryInferModuleBumpType(mod *workspace.Module, since, until string) (label string, changed bool) {
+	parser := commitparser.GetCommitParserFn()
+	if parser == nil {
+		return "", true
+	}
+
+	commits, err := gitlog.GetModuleCommitsFn(mod.Name, mod.Dir, since, until)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: failed to read commits: %v\n", mod.Name, err)
+		return "", true
+	}
+	if len(commits) == 0 {
+		return "", false
+	}
+
+	label, err = parser.Parse(commits)
+	if errors.Is(err, commitparser.ErrNoBumpType) {
+		return "", false
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: commit parser failed: %v\n", mod.Name, err)
+		return "", true
+	}
+	return label, true
+}
+
 // tryInferBumpTypeFromChangelogParserPlugin tries to infer bump type from CHANGELOG.md.
 func tryInferBumpTypeFromChangelogParserPlugin() string {
 	parser := changelogparser.GetChangelogParserFn()
@@ -21,6 +21,10 @@ func Run(cfg *config.Config) *cli.Command {
 			Name:  "preserve-meta",
 			Usage: "Preserve existing build metadata when bumping",
 		},
+		&cli.BoolFlag{
+			Name:  "atomic",
+			Usage: "Roll back every module if any module fails (multi-module only)",
+		},
 	}
 	cmdFlags = append(cmdFlags, flags.MultiModuleFlags()...)
 
@@ -34,7 +38,11 @@ func Run(cfg *config.Config) *cli.Command {
 			minorCmd(cfg),
 			majorCmd(cfg),
 			preCmd(cfg),
+			prePatchCmd(cfg),
+			preMinorCmd(cfg),
+			preMajorCmd(cfg),
 			releaseCmd(cfg),
+			stableCmd(cfg),
 			autoCmd(cfg),
 		},
 	}
@@ -2,18 +2,26 @@ package bumpcmd
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/indaco/verso/internal/changeset"
 	"github.com/indaco/verso/internal/clix"
 	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
 	"github.com/indaco/verso/internal/hooks"
+	"github.com/indaco/verso/internal/operations"
 	"github.com/indaco/verso/internal/plugins/auditlog"
 	"github.com/indaco/verso/internal/plugins/changeloggenerator"
+	"github.com/indaco/verso/internal/plugins/changelogparser"
 	"github.com/indaco/verso/internal/plugins/commitparser"
 	"github.com/indaco/verso/internal/plugins/commitparser/gitlog"
 	"github.com/indaco/verso/internal/plugins/dependencycheck"
@@ -331,10 +339,10 @@ func TestCLI_BumpAutoCmd(t *testing.T) {
 			expected: "0.9.0",
 		},
 		{
-			name:     "bump minor from 0.9.0 as a special case",
+			name:     "default patch bump from 0.9.0",
 			initial:  "0.9.0",
 			args:     []string{"verso", "bump", "auto"},
-			expected: "0.10.0",
+			expected: "0.9.1",
 		},
 		{
 			name:     "preserve build metadata",
@@ -376,7 +384,7 @@ func TestCLI_BumpAutoCmd_InferredBump(t *testing.T) {
 		return "minor"
 	}
 
-	cfg := &config.Config{Path: versionPath, Plugins: &config.PluginConfig{CommitParser: true}}
+	cfg := &config.Config{Path: versionPath, Plugins: &config.PluginConfig{CommitParser: &config.CommitParserConfig{Enabled: true}}}
 	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
 
 	err := appCli.Run(context.Background(), []string{
@@ -394,6 +402,97 @@ func TestCLI_BumpAutoCmd_InferredBump(t *testing.T) {
 	}
 }
 
+func TestCLI_BumpAutoCmd_InitialDevelopment(t *testing.T) {
+	originalInfer := tryInferBumpTypeFromCommitParserPluginFn
+	defer func() { tryInferBumpTypeFromCommitParserPluginFn = originalInfer }()
+
+	disabled := false
+	tests := []struct {
+		name       string
+		initial    string
+		inferred   string
+		initialDev *config.InitialDevelopmentConfig
+		args       []string
+		want       string
+	}{
+		{"breaking change bumps minor on 0.x", "0.4.2", "major", nil, nil, "0.5.0"},
+		{"feature bumps patch on 0.x", "0.4.2", "minor", nil, nil, "0.4.3"},
+		{"fix bumps patch on 0.x", "0.4.2", "patch", nil, nil, "0.4.3"},
+		{"breaking change bumps major from 1.x", "1.4.2", "major", nil, nil, "2.0.0"},
+		{"policy disabled", "0.4.2", "major", &config.InitialDevelopmentConfig{Enabled: &disabled}, nil, "1.0.0"},
+		{"explicit label is not adjusted", "0.4.2", "", nil, []string{"--label", "major"}, "1.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmp := t.TempDir()
+			versionPath := testutils.WriteTempVersionFile(t, tmp, tt.initial)
+
+			tryInferBumpTypeFromCommitParserPluginFn = func(since, until string) string {
+				return tt.inferred
+			}
+
+			cfg := &config.Config{
+				Path:       versionPath,
+				Plugins:    &config.PluginConfig{CommitParser: &config.CommitParserConfig{Enabled: true}},
+				InitialDev: tt.initialDev,
+			}
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+			args := append([]string{"verso", "bump", "auto", "--path", versionPath}, tt.args...)
+			if err := appCli.Run(context.Background(), args); err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+
+			if got := testutils.ReadTempVersionFile(t, tmp); got != tt.want {
+				t.Errorf("expected bumped version %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCLI_BumpAutoCmd_CalVerScheme(t *testing.T) {
+	tmp := t.TempDir()
+	versionPath := testutils.WriteTempVersionFile(t, tmp, "26.03.4")
+
+	now := func() time.Time { return time.Date(2026, time.April, 1, 9, 0, 0, 0, time.UTC) }
+	calver, err := semver.NewCalVerScheme("YY.0M.MICRO", now)
+	if err != nil {
+		t.Fatalf("NewCalVerScheme failed: %v", err)
+	}
+	defer semver.SetDefaultScheme(calver)()
+
+	// Commit inference must be ignored for date-driven versions
+	originalInfer := tryInferBumpTypeFromCommitParserPluginFn
+	defer func() { tryInferBumpTypeFromCommitParserPluginFn = originalInfer }()
+	tryInferBumpTypeFromCommitParserPluginFn = func(since, until string) string {
+		t.Error("commit inference should not run under the calver scheme")
+		return "major"
+	}
+
+	cfg := &config.Config{Path: versionPath, Plugins: &config.PluginConfig{CommitParser: &config.CommitParserConfig{Enabled: true}}}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	if err := appCli.Run(context.Background(), []string{"verso", "bump", "auto", "--path", versionPath}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got := testutils.ReadTempVersionFile(t, tmp); got != "26.04.0" {
+		t.Errorf("expected period change to reset micro to 26.04.0, got %q", got)
+	}
+
+	if err := appCli.Run(context.Background(), []string{"verso", "bump", "patch", "--path", versionPath}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got := testutils.ReadTempVersionFile(t, tmp); got != "26.04.1" {
+		t.Errorf("expected patch bump to 26.04.1, got %q", got)
+	}
+
+	err = appCli.Run(context.Background(), []string{"verso", "bump", "minor", "--path", versionPath})
+	if err == nil || !strings.Contains(err.Error(), "not supported by the calver scheme") {
+		t.Errorf("expected calver minor bump error, got %v", err)
+	}
+}
+
 func TestCLI_BumpAutoCommand_WithLabelAndMeta(t *testing.T) {
 	tmpDir := t.TempDir()
 	versionPath := filepath.Join(tmpDir, ".version")
@@ -470,7 +569,7 @@ func TestCLI_BumpAutoCmd_InferredPromotion(t *testing.T) {
 		return "minor"
 	}
 
-	cfg := &config.Config{Path: versionPath, Plugins: &config.PluginConfig{CommitParser: true}}
+	cfg := &config.Config{Path: versionPath, Plugins: &config.PluginConfig{CommitParser: &config.CommitParserConfig{Enabled: true}}}
 	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
 
 	err := appCli.Run(context.Background(), []string{
@@ -920,6 +1019,69 @@ func TestCLI_BumpPreCmd(t *testing.T) {
 	}
 }
 
+func TestCLI_BumpPreCmd_NextChannel(t *testing.T) {
+	tmpDir := t.TempDir()
+	versionPath := filepath.Join(tmpDir, ".version")
+
+	tests := []struct {
+		name     string
+		channels []string
+		initial  string
+		expected string
+	}{
+		{"alpha to beta", nil, "1.3.0-alpha.3", "1.3.0-beta.1"},
+		{"beta to rc keeps separator", nil, "1.3.0-beta-2", "1.3.0-rc-1"},
+		{"custom channels", []string{"dev", "qa", "rc"}, "1.3.0-dev.7", "1.3.0-qa.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{Path: versionPath, PreRelease: &config.PreReleaseConfig{Channels: tt.channels}}
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+			testutils.WriteTempVersionFile(t, tmpDir, tt.initial)
+			testutils.RunCLITest(t, appCli, []string{"verso", "bump", "pre", "--next-channel"}, tmpDir)
+
+			got := testutils.ReadTempVersionFile(t, tmpDir)
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCLI_BumpPreCmd_NextChannel_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		initial     string
+		args        []string
+		expectedErr string
+	}{
+		{"last channel", "1.3.0-rc.2", []string{"verso", "bump", "pre", "--next-channel"}, "already on the last channel"},
+		{"no pre-release", "1.3.0", []string{"verso", "bump", "pre", "--next-channel"}, "has no pre-release"},
+		{"with label", "1.3.0-alpha.1", []string{"verso", "bump", "pre", "--next-channel", "--label", "beta"}, "cannot be combined with --label"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			versionPath := filepath.Join(tmpDir, ".version")
+			testutils.WriteTempVersionFile(t, tmpDir, tt.initial)
+
+			cfg := &config.Config{Path: versionPath}
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+			err := appCli.Run(context.Background(), tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+				t.Fatalf("expected error containing %q, got: %v", tt.expectedErr, err)
+			}
+			if got := testutils.ReadTempVersionFile(t, tmpDir); got != tt.initial {
+				t.Errorf("expected version to be unchanged, got %q", got)
+			}
+		})
+	}
+}
+
 func TestCLI_BumpPreCmd_ErrorNoPreRelease(t *testing.T) {
 	tmpDir := t.TempDir()
 	versionPath := filepath.Join(tmpDir, ".version")
@@ -1034,6 +1196,165 @@ func TestCLI_BumpPreCmd_PreserveMetadata(t *testing.T) {
 	}
 }
 
+func TestCLI_BumpStableCmd(t *testing.T) {
+	tmpDir := t.TempDir()
+	versionPath := filepath.Join(tmpDir, ".version")
+
+	cfg := &config.Config{Path: versionPath}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	tests := []struct {
+		name     string
+		initial  string
+		args     []string
+		expected string
+	}{
+		{"graduates 0.x", "0.9.3", []string{"verso", "bump", "stable"}, "1.0.0"},
+		{"graduates 0.x pre-release", "0.10.0-rc.2+ci.4", []string{"verso", "bump", "stable"}, "1.0.0"},
+		{"with pre-release", "0.9.3", []string{"verso", "bump", "--pre", "rc.1", "stable"}, "1.0.0-rc.1"},
+		{"preserve metadata", "0.9.3+ci.4", []string{"verso", "bump", "--preserve-meta", "stable"}, "1.0.0+ci.4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutils.WriteTempVersionFile(t, tmpDir, tt.initial)
+			testutils.RunCLITest(t, appCli, tt.args, tmpDir)
+
+			got := testutils.ReadTempVersionFile(t, tmpDir)
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCLI_BumpStableCmd_AlreadyStable(t *testing.T) {
+	tmpDir := t.TempDir()
+	versionPath := filepath.Join(tmpDir, ".version")
+	testutils.WriteTempVersionFile(t, tmpDir, "1.2.3")
+
+	cfg := &config.Config{Path: versionPath}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	err := appCli.Run(context.Background(), []string{"verso", "bump", "stable"})
+	if err == nil || !strings.Contains(err.Error(), "already stable") {
+		t.Fatalf("expected already stable error, got: %v", err)
+	}
+	if got := testutils.ReadTempVersionFile(t, tmpDir); got != "1.2.3" {
+		t.Errorf("expected version to be unchanged, got %q", got)
+	}
+}
+
+func TestCLI_BumpCompoundCmds(t *testing.T) {
+	tmpDir := t.TempDir()
+	versionPath := filepath.Join(tmpDir, ".version")
+
+	cfg := &config.Config{Path: versionPath}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	tests := []struct {
+		name     string
+		initial  string
+		args     []string
+		expected string
+	}{
+		{"preminor defaults to rc", "1.2.5", []string{"verso", "bump", "preminor"}, "1.3.0-rc.1"},
+		{"premajor with label", "1.2.5", []string{"verso", "bump", "premajor", "--label", "alpha"}, "2.0.0-alpha.1"},
+		{"prepatch with short label flag", "1.2.5", []string{"verso", "bump", "prepatch", "-l", "beta"}, "1.2.6-beta.1"},
+		{"reuses current label", "1.3.0-beta.4", []string{"verso", "bump", "preminor"}, "1.4.0-beta.1"},
+		{"keeps dash separator", "1.2.5-rc-4", []string{"verso", "bump", "prepatch", "--label", "rc"}, "1.2.6-rc-1"},
+		{"keeps no separator", "1.2.5-rc4", []string{"verso", "bump", "prepatch"}, "1.2.6-rc1"},
+		{"new metadata", "1.2.5+ci.1", []string{"verso", "bump", "--meta", "ci.2", "preminor"}, "1.3.0-rc.1+ci.2"},
+		{"preserve metadata", "1.2.5+ci.1", []string{"verso", "bump", "--preserve-meta", "preminor"}, "1.3.0-rc.1+ci.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutils.WriteTempVersionFile(t, tmpDir, tt.initial)
+			testutils.RunCLITest(t, appCli, tt.args, tmpDir)
+
+			got := testutils.ReadTempVersionFile(t, tmpDir)
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCLI_BumpCompoundCmds_EarlyFailures(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		override    func() func()
+		expectedErr string
+	}{
+		{
+			name: "FromCommand fails",
+			args: []string{"verso", "bump", "preminor"},
+			override: func() func() {
+				original := clix.FromCommandFn
+				clix.FromCommandFn = func(cmd *cli.Command) (bool, error) {
+					return false, fmt.Errorf("mock FromCommand error")
+				}
+				return func() { clix.FromCommandFn = original }
+			},
+			expectedErr: "mock FromCommand error",
+		},
+		{
+			name: "RunPreReleaseHooks fails",
+			args: []string{"verso", "bump", "premajor"},
+			override: func() func() {
+				original := hooks.RunPreReleaseHooksFn
+				hooks.RunPreReleaseHooksFn = func(skip bool) error {
+					return fmt.Errorf("mock pre-release hooks error")
+				}
+				return func() { hooks.RunPreReleaseHooksFn = original }
+			},
+			expectedErr: "mock pre-release hooks error",
+		},
+		{
+			name:        "parent --pre flag",
+			args:        []string{"verso", "bump", "--pre", "beta", "preminor"},
+			override:    func() func() { return func() {} },
+			expectedErr: "--pre is not supported by preminor; use --label",
+		},
+		{
+			name: "BumpByLabel fails",
+			args: []string{"verso", "bump", "prepatch"},
+			override: func() func() {
+				original := semver.BumpByLabelFunc
+				semver.BumpByLabelFunc = func(v semver.SemVersion, label string) (semver.SemVersion, error) {
+					return semver.SemVersion{}, fmt.Errorf("mock bump error")
+				}
+				return func() { semver.BumpByLabelFunc = original }
+			},
+			expectedErr: "mock bump error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			versionPath := filepath.Join(tmpDir, ".version")
+			testutils.WriteTempVersionFile(t, tmpDir, "1.2.5")
+
+			restore := tt.override()
+			defer restore()
+
+			cfg := &config.Config{Path: versionPath}
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+			err := appCli.Run(context.Background(), tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+				t.Fatalf("expected error containing %q, got: %v", tt.expectedErr, err)
+			}
+			if got := testutils.ReadTempVersionFile(t, tmpDir); got != "1.2.5" {
+				t.Errorf("expected version to be unchanged, got %q", got)
+			}
+		})
+	}
+}
+
 /* ------------------------------------------------------------------------- */
 /* HELPER FUNCTION TESTS                                                     */
 /* ------------------------------------------------------------------------- */
@@ -1202,65 +1523,452 @@ func TestSetBuildMetadata(t *testing.T) {
 	}
 }
 
-func TestDetermineBumpType(t *testing.T) {
-	// Save original function
-	originalInferFromChangelog := tryInferBumpTypeFromChangelogParserPluginFn
-	originalInferFromCommit := tryInferBumpTypeFromCommitParserPluginFn
-	defer func() {
-		tryInferBumpTypeFromChangelogParserPluginFn = originalInferFromChangelog
-		tryInferBumpTypeFromCommitParserPluginFn = originalInferFromCommit
-	}()
-
+func TestLabelBumpType(t *testing.T) {
 	tests := []struct {
-		name          string
-		label         string
-		disableInfer  bool
-		mockChangelog string
-		mockCommit    string
-		expected      string
+		label    string
+		expected operations.BumpType
 	}{
-		{"explicit patch", "patch", false, "", "", "patch"},
-		{"explicit minor", "minor", false, "", "", "minor"},
-		{"explicit major", "major", false, "", "", "major"},
-		{"infer from changelog minor", "", false, "minor", "", "minor"},
-		{"infer from changelog major", "", false, "major", "", "major"},
-		{"infer from commits when changelog empty", "", false, "", "minor", "minor"},
-		{"default to auto when inference disabled", "", true, "", "", "auto"},
-		{"invalid label defaults to auto", "invalid", false, "", "", "auto"},
+		{"patch", operations.BumpPatch},
+		{"minor", operations.BumpMinor},
+		{"major", operations.BumpMajor},
+		{"", operations.BumpAuto},
+		{"invalid", operations.BumpAuto},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			tryInferBumpTypeFromChangelogParserPluginFn = func() string { return tt.mockChangelog }
-			tryInferBumpTypeFromCommitParserPluginFn = func(since, until string) string { return tt.mockCommit }
+		if got := labelBumpType(tt.label); got != tt.expected {
+			t.Errorf("labelBumpType(%q) = %q, want %q", tt.label, got, tt.expected)
+		}
+	}
+}
 
-			result := determineBumpType(tt.label, tt.disableInfer, "", "")
+func TestCLI_BumpAutoCmd_MultiModule_PerModuleInference(t *testing.T) {
+	origInferModule := tryInferModuleBumpTypeFn
+	origInferChangelog := tryInferBumpTypeFromChangelogParserPluginFn
+	defer func() {
+		tryInferModuleBumpTypeFn = origInferModule
+		tryInferBumpTypeFromChangelogParserPluginFn = origInferChangelog
+	}()
 
-			if string(result) != tt.expected {
-				t.Errorf("expected %q, got %q", tt.expected, string(result))
-			}
-		})
+	tmpDir := t.TempDir()
+	for name, version := range map[string]string{"api": "1.0.0", "web": "2.0.0", "docs": "0.3.0"} {
+		dir := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		testutils.WriteTempVersionFile(t, dir, version)
+	}
+
+	tryInferBumpTypeFromChangelogParserPluginFn = func() string { return "" }
+	var gotSince []string
+	tryInferModuleBumpTypeFn = func(mod *workspace.Module, since, until string) (string, bool) {
+		gotSince = append(gotSince, since)
+		switch mod.Name {
+		case "api":
+			return "minor", true
+		case "docs":
+			return "minor", true // 0.x: initial development bumps patch
+		default:
+			return "", false
+		}
 	}
-}
 
-func TestTryInferBumpTypeFromChangelogParserPlugin_NoParser(t *testing.T) {
-	// Ensure no parser is registered
-	originalFn := tryInferBumpTypeFromChangelogParserPluginFn
-	defer func() { tryInferBumpTypeFromChangelogParserPluginFn = originalFn }()
+	enabled := true
+	recursive := true
+	maxDepth := 10
+	cfg := &config.Config{
+		Path: ".version",
+		Workspace: &config.WorkspaceConfig{
+			Discovery: &config.DiscoveryConfig{
+				Enabled:   &enabled,
+				Recursive: &recursive,
+				MaxDepth:  &maxDepth,
+			},
+		},
+	}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
 
-	// Use the actual function
-	tryInferBumpTypeFromChangelogParserPluginFn = tryInferBumpTypeFromChangelogParserPlugin
+	_, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "bump", "auto", "--all", "--since", "v1.0.0"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
 
-	label := tryInferBumpTypeFromChangelogParserPlugin()
-	if label != "" {
-		t.Errorf("expected empty label when no parser, got %q", label)
+	for name, want := range map[string]string{"api": "1.1.0", "web": "2.0.0", "docs": "0.3.1"} {
+		if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, name)); got != want {
+			t.Errorf("%s: expected %s, got %s", name, want, got)
+		}
+	}
+	for _, since := range gotSince {
+		if since != "v1.0.0" {
+			t.Errorf("expected --since to be passed to every module, got %q", since)
+		}
 	}
 }
 
-func TestGetNextVersion(t *testing.T) {
-	tests := []struct {
-		name         string
-		current      semver.SemVersion
+func TestCLI_BumpAutoCmd_ChangeFiles(t *testing.T) {
+	origInfer := tryInferBumpTypeFromCommitParserPluginFn
+	origInferChangelog := tryInferBumpTypeFromChangelogParserPluginFn
+	defer func() {
+		tryInferBumpTypeFromCommitParserPluginFn = origInfer
+		tryInferBumpTypeFromChangelogParserPluginFn = origInferChangelog
+	}()
+	tryInferBumpTypeFromCommitParserPluginFn = func(since, until string) string { return "patch" }
+	tryInferBumpTypeFromChangelogParserPluginFn = func() string { return "" }
+
+	tmpDir := t.TempDir()
+	testutils.WriteTempVersionFile(t, tmpDir, "1.2.3")
+	pending := filepath.Join(tmpDir, changeset.DefaultDir)
+	if err := os.MkdirAll(pending, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"a.md": "---\n\".\": patch\n---\n\nFix the parser.\n",
+		"b.md": "---\n\".\": minor\n---\n\nAdd search.\n",
+	} {
+		if err := os.WriteFile(filepath.Join(pending, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cfg := &config.Config{
+		Path:    ".version",
+		Plugins: &config.PluginConfig{CommitParser: &config.CommitParserConfig{Enabled: true}},
+	}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "bump", "auto"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+
+	if got := testutils.ReadTempVersionFile(t, tmpDir); got != "1.3.0" {
+		t.Errorf("expected the change files to bump to 1.3.0, got %s", got)
+	}
+	if !strings.Contains(output, "Consumed 2 change file(s)") {
+		t.Errorf("expected consumed change files in output, got %q", output)
+	}
+	if entries, _ := os.ReadDir(pending); len(entries) != 0 {
+		t.Errorf("expected change files to be removed, %d left", len(entries))
+	}
+}
+
+func TestCLI_BumpAutoCmd_MultiModule_ChangeFiles(t *testing.T) {
+	origInferModule := tryInferModuleBumpTypeFn
+	origInferChangelog := tryInferBumpTypeFromChangelogParserPluginFn
+	defer func() {
+		tryInferModuleBumpTypeFn = origInferModule
+		tryInferBumpTypeFromChangelogParserPluginFn = origInferChangelog
+	}()
+	tryInferBumpTypeFromChangelogParserPluginFn = func() string { return "" }
+	tryInferModuleBumpTypeFn = func(mod *workspace.Module, since, until string) (string, bool) {
+		t.Errorf("unexpected commit inference for %s", mod.Name)
+		return "", false
+	}
+
+	tmpDir := t.TempDir()
+	for _, name := range []string{"api", "web"} {
+		dir := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		testutils.WriteTempVersionFile(t, dir, "1.0.0")
+	}
+	pending := filepath.Join(tmpDir, changeset.DefaultDir)
+	if err := os.MkdirAll(pending, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"a.md": "---\napi: minor\nweb: patch\n---\n\nAdd search.\n",
+		"b.md": "---\napi: patch\n---\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(pending, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	enabled := true
+	cfg := &config.Config{
+		Path:      ".version",
+		Workspace: &config.WorkspaceConfig{Discovery: &config.DiscoveryConfig{Enabled: &enabled}},
+	}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	_, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "bump", "auto", "--module", "api"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+
+	for name, want := range map[string]string{"api": "1.1.0", "web": "1.0.0"} {
+		if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, name)); got != want {
+			t.Errorf("%s: expected %s, got %s", name, want, got)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(pending, "b.md")); !os.IsNotExist(err) {
+		t.Error("expected the change file of api alone to be removed")
+	}
+	data, err := os.ReadFile(filepath.Join(pending, "a.md"))
+	if err != nil {
+		t.Fatalf("expected the change file bumping web to be kept: %v", err)
+	}
+	if want := "---\nweb: patch\n---\n\nAdd search.\n"; string(data) != want {
+		t.Errorf("expected the released module to be dropped, got %q", data)
+	}
+}
+
+func TestCLI_BumpAutoCmd_ChangeFiles_InitialDevelopment(t *testing.T) {
+	origInfer := tryInferBumpTypeFromCommitParserPluginFn
+	origInferModule := tryInferModuleBumpTypeFn
+	origInferChangelog := tryInferBumpTypeFromChangelogParserPluginFn
+	defer func() {
+		tryInferBumpTypeFromCommitParserPluginFn = origInfer
+		tryInferModuleBumpTypeFn = origInferModule
+		tryInferBumpTypeFromChangelogParserPluginFn = origInferChangelog
+	}()
+	tryInferBumpTypeFromCommitParserPluginFn = func(since, until string) string { return "" }
+	tryInferModuleBumpTypeFn = func(mod *workspace.Module, since, until string) (string, bool) { return "", false }
+	tryInferBumpTypeFromChangelogParserPluginFn = func() string { return "" }
+
+	enabled := true
+	cfg := &config.Config{
+		Path:      ".version",
+		Workspace: &config.WorkspaceConfig{Discovery: &config.DiscoveryConfig{Enabled: &enabled}},
+	}
+
+	// Change file levels are explicit: a major change file releases 1.0.0.
+	tests := []struct {
+		name     string
+		versions map[string]string
+		change   string
+		args     []string
+		want     map[string]string
+		output   string
+	}{
+		{
+			name:     "single module",
+			versions: map[string]string{".": "0.4.2"},
+			change:   "---\n\".\": major\n---\n",
+			args:     []string{"verso", "bump", "auto"},
+			want:     map[string]string{".": "1.0.0"},
+		},
+		{
+			name:     "multi-module",
+			versions: map[string]string{"api": "0.4.2", "web": "0.2.0"},
+			change:   "---\napi: major\n---\n",
+			args:     []string{"verso", "bump", "auto", "--all"},
+			want:     map[string]string{"api": "1.0.0", "web": "0.2.0"},
+		},
+		{
+			name:     "multi-module plan",
+			versions: map[string]string{"api": "0.4.2", "web": "0.2.0"},
+			change:   "---\napi: major\n---\n",
+			args:     []string{"verso", "plan"},
+			want:     map[string]string{"api": "0.4.2", "web": "0.2.0"},
+			output:   "api: 0.4.2 -> 1.0.0 (major, from changes)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for name, version := range tt.versions {
+				dir := filepath.Join(tmpDir, name)
+				if err := os.MkdirAll(dir, 0755); err != nil {
+					t.Fatal(err)
+				}
+				testutils.WriteTempVersionFile(t, dir, version)
+			}
+			pending := filepath.Join(tmpDir, changeset.DefaultDir)
+			if err := os.MkdirAll(pending, 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(pending, "a.md"), []byte(tt.change), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg), PlanCmd(cfg)})
+			output, err := testutils.CaptureStdout(func() {
+				testutils.RunCLITest(t, appCli, tt.args, tmpDir)
+			})
+			if err != nil {
+				t.Fatalf("failed to capture stdout: %v", err)
+			}
+
+			for name, want := range tt.want {
+				if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, name)); got != want {
+					t.Errorf("%s: expected %s, got %s", name, want, got)
+				}
+			}
+			if strings.Contains(output, "Initial development") {
+				t.Errorf("expected no initial development adjustment, got %q", output)
+			}
+			if !strings.Contains(output, tt.output) {
+				t.Errorf("expected output to contain %q, got %q", tt.output, output)
+			}
+		})
+	}
+}
+
+func TestCLI_Bump_MultiModule_Groups(t *testing.T) {
+	origInferModule := tryInferModuleBumpTypeFn
+	origInferChangelog := tryInferBumpTypeFromChangelogParserPluginFn
+	defer func() {
+		tryInferModuleBumpTypeFn = origInferModule
+		tryInferBumpTypeFromChangelogParserPluginFn = origInferChangelog
+	}()
+
+	tryInferBumpTypeFromChangelogParserPluginFn = func() string { return "" }
+	tryInferModuleBumpTypeFn = func(mod *workspace.Module, since, until string) (string, bool) {
+		switch mod.Name {
+		case "sdk":
+			return "patch", true
+		case "server":
+			return "minor", true
+		default:
+			return "", false
+		}
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want map[string]string
+	}{
+		{
+			name: "highest inferred bump applies to the group",
+			args: []string{"verso", "bump", "auto", "--all"},
+			want: map[string]string{"sdk": "1.3.0", "cli": "1.3.0", "server": "1.3.0", "docs": "2.0.0"},
+		},
+		{
+			name: "selecting a member bumps the group",
+			args: []string{"verso", "bump", "patch", "--module", "cli"},
+			want: map[string]string{"sdk": "1.2.1", "cli": "1.2.1", "server": "1.2.1", "docs": "2.0.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for name, version := range map[string]string{"sdk": "1.2.0", "cli": "1.2.0", "server": "1.2.0", "docs": "2.0.0"} {
+				dir := filepath.Join(tmpDir, name)
+				if err := os.MkdirAll(dir, 0755); err != nil {
+					t.Fatal(err)
+				}
+				testutils.WriteTempVersionFile(t, dir, version)
+			}
+
+			cfg := &config.Config{
+				Path: ".version",
+				Workspace: &config.WorkspaceConfig{
+					Groups: []config.GroupConfig{{Name: "platform", Modules: []string{"sdk", "cli", "server"}}},
+				},
+			}
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+			if _, err := testutils.CaptureStdout(func() {
+				testutils.RunCLITest(t, appCli, tt.args, tmpDir)
+			}); err != nil {
+				t.Fatalf("failed to capture stdout: %v", err)
+			}
+
+			for name, want := range tt.want {
+				if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, name)); got != want {
+					t.Errorf("%s: expected %s, got %s", name, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestModuleAutoOperation_NoInference(t *testing.T) {
+	origInferModule := tryInferModuleBumpTypeFn
+	defer func() { tryInferModuleBumpTypeFn = origInferModule }()
+
+	tryInferModuleBumpTypeFn = func(mod *workspace.Module, since, until string) (string, bool) {
+		return "", true // changed, but nothing inferred
+	}
+
+	tmpDir := t.TempDir()
+	path := testutils.WriteTempVersionFile(t, tmpDir, "1.2.3-rc.1")
+	mod := &workspace.Module{Name: "api", Path: path, Dir: tmpDir}
+
+	op := newModuleAutoOperation(core.NewOSFileSystem(), "", "", "", false, true, nil, nil)
+	if err := op.Execute(context.Background(), mod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mod.CurrentVersion != "1.2.3" {
+		t.Errorf("expected pre-release promotion to 1.2.3, got %q", mod.CurrentVersion)
+	}
+}
+
+func TestTryInferModuleBumpType(t *testing.T) {
+	origGetModuleCommits := gitlog.GetModuleCommitsFn
+	origParser := commitparser.GetCommitParserFn
+	defer func() {
+		gitlog.GetModuleCommitsFn = origGetModuleCommits
+		commitparser.GetCommitParserFn = origParser
+	}()
+
+	parser := commitparser.NewCommitParser(nil)
+	commitparser.GetCommitParserFn = func() commitparser.CommitParser { return parser }
+
+	history := map[string][]string{
+		"/repo/api":  {"fix(api): timeout", "feat(api): search"},
+		"/repo/web":  {},
+		"/repo/docs": {"docs: readme", "chore: tidy"},
+	}
+	gitlog.GetModuleCommitsFn = func(name, dir, since, until string) ([]string, error) {
+		return history[dir], nil
+	}
+
+	if label, changed := tryInferModuleBumpType(&workspace.Module{Name: "api", Dir: "/repo/api"}, "", ""); label != "minor" || !changed {
+		t.Errorf("api: expected minor and changed, got %q, %v", label, changed)
+	}
+	if label, changed := tryInferModuleBumpType(&workspace.Module{Name: "web", Dir: "/repo/web"}, "", ""); label != "" || changed {
+		t.Errorf("web: expected no bump and unchanged, got %q, %v", label, changed)
+	}
+
+	// Commits implying no bump leave the module unchanged, without an error.
+	var label string
+	var changed bool
+	output, err := testutils.CaptureStdout(func() {
+		label, changed = tryInferModuleBumpType(&workspace.Module{Name: "docs", Dir: "/repo/docs"}, "", "")
+	})
+	if err != nil {
+		t.Fatalf("failed to capture output: %v", err)
+	}
+	if label != "" || changed {
+		t.Errorf("docs: expected no bump and unchanged, got %q, %v", label, changed)
+	}
+	if strings.Contains(output, "commit parser failed") {
+		t.Errorf("docs: expected no parser error, got %q", output)
+	}
+}
+
+func TestTryInferBumpTypeFromChangelogParserPlugin_NoParser(t *testing.T) {
+	// Ensure no parser is registered
+	originalFn := tryInferBumpTypeFromChangelogParserPluginFn
+	defer func() { tryInferBumpTypeFromChangelogParserPluginFn = originalFn }()
+
+	// Use the actual function
+	tryInferBumpTypeFromChangelogParserPluginFn = tryInferBumpTypeFromChangelogParserPlugin
+
+	label := tryInferBumpTypeFromChangelogParserPlugin()
+	if label != "" {
+		t.Errorf("expected empty label when no parser, got %q", label)
+	}
+}
+
+func TestGetNextVersion(t *testing.T) {
+	tests := []struct {
+		name         string
+		current      semver.SemVersion
 		label        string
 		disableInfer bool
 		expected     string
@@ -1305,7 +2013,7 @@ func TestGetNextVersion(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := getNextVersion(tt.current, tt.label, tt.disableInfer, "", "", false)
+			result, err := getNextVersion(tt.current, tt.label, tt.disableInfer, false, "", "", false)
 			if tt.expectError {
 				if err == nil {
 					t.Error("expected error, got nil")
@@ -1584,12 +2292,40 @@ func TestSyncDependencies(t *testing.T) {
 
 	t.Run("nil checker returns nil", func(t *testing.T) {
 		dependencycheck.GetDependencyCheckerFn = func() dependencycheck.DependencyChecker { return nil }
-		err := syncDependencies(version)
+		err := syncDependencies(".version", version)
 		if err != nil {
 			t.Errorf("expected nil error, got %v", err)
 		}
 	})
 
+	t.Run("waits for the version file lock", func(t *testing.T) {
+		origTimeout := core.LockTimeout
+		core.LockTimeout = 20 * time.Millisecond
+		defer func() { core.LockTimeout = origTimeout }()
+
+		tmpDir := t.TempDir()
+		versionPath := filepath.Join(tmpDir, ".version")
+		depPath := filepath.Join(tmpDir, "VERSION")
+		testutils.WriteFile(t, depPath, "0.9.0", 0644)
+		// Another process is bumping the version
+		testutils.WriteFile(t, core.LockPath(versionPath), "pid 42", 0600)
+
+		dc := dependencycheck.NewDependencyChecker(&dependencycheck.Config{
+			Enabled:  true,
+			AutoSync: true,
+			Files:    []dependencycheck.FileConfig{{Path: depPath, Format: "raw"}},
+		})
+		dependencycheck.GetDependencyCheckerFn = func() dependencycheck.DependencyChecker { return dc }
+
+		err := syncDependencies(versionPath, version)
+		if err == nil || !strings.Contains(err.Error(), "pid 42") {
+			t.Fatalf("expected a lock error naming the holder, got %v", err)
+		}
+		if data, _ := os.ReadFile(depPath); string(data) != "0.9.0" {
+			t.Errorf("expected the dependency file to be kept, got %q", data)
+		}
+	})
+
 	// Note: syncDependencies uses type assertion to *DependencyCheckerPlugin
 	// so mock implementations will be treated as disabled and return nil
 }
@@ -1683,3 +2419,941 @@ func TestRunPostBumpExtensionHooks(t *testing.T) {
 		}
 	})
 }
+
+func TestCLI_BumpAutoCmd_Explain(t *testing.T) {
+	origGetCommits := gitlog.GetCommitsFn
+	origParser := commitparser.GetCommitParserFn
+	defer func() {
+		gitlog.GetCommitsFn = origGetCommits
+		commitparser.GetCommitParserFn = origParser
+	}()
+
+	gitlog.GetCommitsFn = func(since, until string) ([]string, error) {
+		return []string{"docs: readme", "feat(ui): add button", "fix: crash"}, nil
+	}
+	parser := commitparser.NewCommitParser(nil)
+	commitparser.GetCommitParserFn = func() commitparser.CommitParser { return parser }
+
+	tmp := t.TempDir()
+	versionPath := testutils.WriteTempVersionFile(t, tmp, "1.2.3")
+
+	cfg := &config.Config{Path: versionPath, Plugins: &config.PluginConfig{CommitParser: &config.CommitParserConfig{Enabled: true}}}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "bump", "auto", "--explain", "--path", versionPath}, tmp)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+
+	for _, want := range []string{
+		"Commits (last tag..HEAD): minor",
+		"minor  feat(ui): add button",
+		`type "feat" maps to minor`,
+		`Result: minor (from commits)`,
+		`first from "feat(ui): add button"`,
+		"Version: 1.2.3 -> 1.3.0",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+
+	if got := testutils.ReadTempVersionFile(t, tmp); got != "1.2.3" {
+		t.Errorf("expected --explain to leave the version unchanged, got %q", got)
+	}
+}
+
+func TestCLI_BumpAutoCmd_ExplainJSON_ChangelogPrecedence(t *testing.T) {
+	origGetCommits := gitlog.GetCommitsFn
+	origParser := commitparser.GetCommitParserFn
+	defer func() {
+		gitlog.GetCommitsFn = origGetCommits
+		commitparser.GetCommitParserFn = origParser
+		changelogparser.ResetChangelogParser()
+	}()
+
+	gitlog.GetCommitsFn = func(since, until string) ([]string, error) {
+		return []string{"feat!: drop v1"}, nil
+	}
+	parser := commitparser.NewCommitParser(nil)
+	commitparser.GetCommitParserFn = func() commitparser.CommitParser { return parser }
+
+	tmp := t.TempDir()
+	versionPath := testutils.WriteTempVersionFile(t, tmp, "1.2.3")
+	changelogPath := filepath.Join(tmp, "CHANGELOG.md")
+	if err := os.WriteFile(changelogPath, []byte("# Changelog\n\n## [Unreleased]\n\n### Added\n- Login page\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	changelogparser.ResetChangelogParser()
+	changelogparser.Register(&changelogparser.Config{Enabled: true, Path: changelogPath, InferBumpType: true, Priority: "changelog"})
+
+	cfg := &config.Config{Path: versionPath, Plugins: &config.PluginConfig{CommitParser: &config.CommitParserConfig{Enabled: true}}}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "bump", "--format", "json", "auto", "--explain", "--path", versionPath}, tmp)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+
+	var got inferenceExplanation
+	if err := json.Unmarshal([]byte(output), &got); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, output)
+	}
+	if got.Source != sourceChangelog || got.BumpType != "minor" || got.Next != "1.3.0" {
+		t.Errorf("expected minor from changelog to 1.3.0, got %+v", got)
+	}
+	if !strings.Contains(got.Reason, `priority is "changelog"`) {
+		t.Errorf("expected precedence reason, got %q", got.Reason)
+	}
+	if got.Changelog == nil || len(got.Changelog.Entries) != 1 || got.Changelog.Entries[0].Level != "minor" {
+		t.Errorf("expected one minor changelog entry, got %+v", got.Changelog)
+	}
+	if got.Commits == nil || got.Commits.Level != "major" || len(got.Commits.Commits) != 1 {
+		t.Errorf("expected commits to be listed with level major, got %+v", got.Commits)
+	}
+}
+
+func TestExplainInference_LabelAndDisabled(t *testing.T) {
+	e := explainInference("major", "", "", "", nil)
+	if e.Source != sourceLabel || e.BumpType != "major" {
+		t.Errorf("expected label source, got %+v", e)
+	}
+
+	e = explainInference("", "inference disabled with --no-infer", "", "", nil)
+	if e.Source != sourceDefault || e.BumpType != "auto" || !strings.Contains(e.Reason, "--no-infer") {
+		t.Errorf("expected default source with disabled reason, got %+v", e)
+	}
+	if e.Commits != nil || e.Changelog != nil {
+		t.Errorf("expected no sources when inference is disabled, got %+v", e)
+	}
+}
+
+func TestCLI_BumpAutoCmd_Explain_MultiModule(t *testing.T) {
+	origGetModuleCommits := gitlog.GetModuleCommitsFn
+	origParser := commitparser.GetCommitParserFn
+	defer func() {
+		gitlog.GetModuleCommitsFn = origGetModuleCommits
+		commitparser.GetCommitParserFn = origParser
+	}()
+
+	history := map[string][]string{
+		"sdk":  {"fix(sdk): retry"},
+		"cli":  {"feat(cli): add flag"},
+		"docs": {"feat(docs): add guide"},
+	}
+	gitlog.GetModuleCommitsFn = func(name, dir, since, until string) ([]string, error) {
+		return history[name], nil
+	}
+	parser := commitparser.NewCommitParser(nil)
+	commitparser.GetCommitParserFn = func() commitparser.CommitParser { return parser }
+
+	tmpDir := t.TempDir()
+	versions := map[string]string{"sdk": "1.2.0", "cli": "1.2.0", "docs": "0.3.0", "web": "2.0.0"}
+	for name, version := range versions {
+		dir := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		testutils.WriteTempVersionFile(t, dir, version)
+	}
+
+	cfg := &config.Config{
+		Path: ".version",
+		Workspace: &config.WorkspaceConfig{
+			Groups: []config.GroupConfig{{Name: "platform", Modules: []string{"sdk", "cli"}}},
+		},
+	}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "bump", "--format", "json", "auto", "--explain"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+
+	var got workspaceExplanation
+	if err := json.Unmarshal([]byte(output[strings.Index(output, "{"):]), &got); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, output)
+	}
+	byName := map[string]*moduleExplanation{}
+	for _, m := range got.Modules {
+		byName[m.Module] = m
+	}
+	if len(byName) != 4 {
+		t.Fatalf("expected 4 modules, got %d:\n%s", len(byName), output)
+	}
+
+	tests := []struct {
+		module   string
+		group    string
+		bumpType string
+		next     string
+		reason   string
+		level    string
+	}{
+		{module: "sdk", group: "platform", bumpType: "minor", next: "1.3.0", reason: "group platform", level: "patch"},
+		{module: "cli", group: "platform", bumpType: "minor", next: "1.3.0", reason: "group platform", level: "minor"},
+		{module: "docs", bumpType: "minor", next: "0.3.1", reason: "touching docs", level: "minor"},
+		{module: "web", bumpType: "none", reason: "no commits touching web"},
+	}
+	for _, tt := range tests {
+		m := byName[tt.module]
+		if m == nil {
+			t.Errorf("%s: missing explanation", tt.module)
+			continue
+		}
+		if m.Group != tt.group || m.BumpType != tt.bumpType || m.Next != tt.next || m.Current != versions[tt.module] {
+			t.Errorf("%s: unexpected explanation %+v", tt.module, m)
+		}
+		if m.Source != sourceCommits || !strings.Contains(m.Reason, tt.reason) {
+			t.Errorf("%s: expected reason from commits containing %q, got %q (%s)", tt.module, tt.reason, m.Reason, m.Source)
+		}
+		if m.Commits == nil || m.Commits.Level != tt.level || m.Commits.Range != tt.module+" last tag..HEAD" {
+			t.Errorf("%s: expected %s commits in its range, got %+v", tt.module, levelOrNone(tt.level), m.Commits)
+		}
+	}
+	if !strings.Contains(byName["docs"].Note, "Initial development") {
+		t.Errorf("docs: expected the initial development note, got %q", byName["docs"].Note)
+	}
+
+	output, err = testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "bump", "auto", "--explain", "--all"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+	for _, want := range []string{
+		"== Module sdk (group platform) ==",
+		"Commits (sdk last tag..HEAD): patch",
+		"Version: 1.2.0 -> 1.3.0",
+		"== Module web ==",
+		"Version: 2.0.0 (not bumped)",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+
+	for name, want := range versions {
+		if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, name)); got != want {
+			t.Errorf("%s: expected --explain to keep %s, got %s", name, want, got)
+		}
+	}
+}
+
+func TestCLI_BumpPatch_MultiModule_Cascade(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"api/.version":        "2.0.0",
+		"api/package.json":    `{"name": "@repo/api", "dependencies": {"@repo/shared": "^1.2.0"}}`,
+		"shared/.version":     "1.2.0",
+		"shared/package.json": `{"name": "@repo/shared"}`,
+		"docs/.version":       "1.0.0",
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cfg := &config.Config{
+		Path: ".version",
+		Workspace: &config.WorkspaceConfig{
+			Dependencies: &config.DependenciesConfig{Cascade: "patch"},
+		},
+	}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	_, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "bump", "minor", "--module", "shared"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+
+	for name, want := range map[string]string{"shared": "1.3.0", "api": "2.0.1", "docs": "1.0.0"} {
+		if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, name)); got != want {
+			t.Errorf("%s: expected %s, got %s", name, want, got)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, "api", "package.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"@repo/shared": "^1.3.0"`) {
+		t.Errorf("expected api to depend on ^1.3.0, got %s", data)
+	}
+}
+
+/* ------------------------------------------------------------------------- */
+/* MULTI-MODULE PLUGIN PIPELINE TESTS                                        */
+/* ------------------------------------------------------------------------- */
+
+// mockModuleTagManager implements tagmanager.ModuleTagManager and records the
+// created module tags.
+type mockModuleTagManager struct {
+	mockTagManager
+	existing map[string]bool
+	created  []string
+}
+
+func (m *mockModuleTagManager) FormatModuleTagName(module string, v semver.SemVersion) string {
+	return module + "/v" + v.String()
+}
+
+func (m *mockModuleTagManager) CreateModuleTag(module string, v semver.SemVersion, msg string) error {
+	m.created = append(m.created, m.FormatModuleTagName(module, v))
+	return nil
+}
+
+func (m *mockModuleTagManager) ValidateModuleTagAvailable(module string, v semver.SemVersion) error {
+	if name := m.FormatModuleTagName(module, v); m.existing[name] {
+		return fmt.Errorf("tag %s already exists", name)
+	}
+	return nil
+}
+
+// recordingReleaseGate records the bumps it validates, and rejects the bump
+// to version reject.
+type recordingReleaseGate struct {
+	mockReleaseGate
+	reject string
+	gated  []string
+}
+
+func (m *recordingReleaseGate) ValidateRelease(newV, prevV semver.SemVersion, bumpType string) error {
+	m.gated = append(m.gated, fmt.Sprintf("%s -> %s (%s)", prevV, newV, bumpType))
+	if newV.String() == m.reject {
+		return fmt.Errorf("release %s rejected", newV)
+	}
+	return nil
+}
+
+func TestCLI_BumpPatch_MultiModule_PluginPipeline(t *testing.T) {
+	origGetTagManagerFn := tagmanager.GetTagManagerFn
+	origGetDependencyCheckerFn := dependencycheck.GetDependencyCheckerFn
+	origGetAuditLogFn := auditlog.GetAuditLogFn
+	origGetReleaseGateFn := releasegate.GetReleaseGateFn
+	defer func() {
+		tagmanager.GetTagManagerFn = origGetTagManagerFn
+		dependencycheck.GetDependencyCheckerFn = origGetDependencyCheckerFn
+		auditlog.GetAuditLogFn = origGetAuditLogFn
+		releasegate.GetReleaseGateFn = origGetReleaseGateFn
+	}()
+
+	setup := func(t *testing.T) string {
+		t.Helper()
+		tmpDir := t.TempDir()
+		files := map[string]string{
+			"api/.version":     "1.0.0",
+			"api/package.json": `{"name": "api", "version": "1.0.0"}`,
+			"web/.version":     "2.0.0",
+		}
+		for name, content := range files {
+			path := filepath.Join(tmpDir, name)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return tmpDir
+	}
+
+	cfg := &config.Config{Path: ".version"}
+
+	t.Run("modules are gated, synced, audited and tagged", func(t *testing.T) {
+		tmpDir := setup(t)
+
+		tm := &mockModuleTagManager{}
+		tagmanager.GetTagManagerFn = func() tagmanager.TagManager { return tm }
+		gate := &recordingReleaseGate{}
+		releasegate.GetReleaseGateFn = func() releasegate.ReleaseGate { return gate }
+		dc := dependencycheck.NewDependencyChecker(&dependencycheck.Config{
+			Enabled:  true,
+			AutoSync: true,
+			Files:    []dependencycheck.FileConfig{{Path: "api/package.json", Field: "version", Format: "json"}},
+		})
+		dependencycheck.GetDependencyCheckerFn = func() dependencycheck.DependencyChecker { return dc }
+		auditPath := filepath.Join(tmpDir, ".version-history.json")
+		al := auditlog.NewAuditLog(&auditlog.Config{Enabled: true, Path: auditPath, Format: "json"})
+		auditlog.GetAuditLogFn = func() auditlog.AuditLog { return al }
+
+		appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+		if _, err := testutils.CaptureStdout(func() {
+			testutils.RunCLITest(t, appCli, []string{"verso", "bump", "patch", "--all"}, tmpDir)
+		}); err != nil {
+			t.Fatalf("failed to capture stdout: %v", err)
+		}
+
+		slices.Sort(gate.gated)
+		if want := []string{"1.0.0 -> 1.0.1 (patch)", "2.0.0 -> 2.0.1 (patch)"}; !slices.Equal(gate.gated, want) {
+			t.Errorf("gated bumps = %v, want %v", gate.gated, want)
+		}
+
+		slices.Sort(tm.created)
+		if want := []string{"api/v1.0.1", "web/v2.0.1"}; !slices.Equal(tm.created, want) {
+			t.Errorf("created tags = %v, want %v", tm.created, want)
+		}
+
+		data, err := os.ReadFile(filepath.Join(tmpDir, "api", "package.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(data), "1.0.1") {
+			t.Errorf("expected api/package.json to be synced to 1.0.1, got %s", data)
+		}
+
+		var logFile auditlog.AuditLogFile
+		data, err = os.ReadFile(auditPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(data, &logFile); err != nil {
+			t.Fatal(err)
+		}
+		modules := map[string]string{}
+		for _, entry := range logFile.Entries {
+			modules[entry.Module] = entry.NewVersion
+		}
+		if modules["api"] != "1.0.1" || modules["web"] != "2.0.1" {
+			t.Errorf("audit log entries = %v, want api 1.0.1 and web 2.0.1", modules)
+		}
+	})
+
+	t.Run("a rejected bump is gated before any version is written", func(t *testing.T) {
+		tmpDir := setup(t)
+
+		tm := &mockModuleTagManager{}
+		tagmanager.GetTagManagerFn = func() tagmanager.TagManager { return tm }
+		releasegate.GetReleaseGateFn = func() releasegate.ReleaseGate { return &recordingReleaseGate{reject: "2.0.1"} }
+		dependencycheck.GetDependencyCheckerFn = func() dependencycheck.DependencyChecker { return nil }
+		auditlog.GetAuditLogFn = func() auditlog.AuditLog { return nil }
+
+		appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+		var runErr error
+		if _, err := testutils.CaptureStdout(func() {
+			runErr = testutils.RunCLITestAllowError(t, appCli, []string{"verso", "bump", "patch", "--all", "--parallel"}, tmpDir)
+		}); err != nil {
+			t.Fatalf("failed to capture stdout: %v", err)
+		}
+		if runErr == nil || !strings.Contains(runErr.Error(), "web: release 2.0.1 rejected") {
+			t.Fatalf("expected the web bump to be rejected, got %v", runErr)
+		}
+
+		if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, "api")); got != "1.0.0" {
+			t.Errorf("api: expected 1.0.0, got %s", got)
+		}
+		if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, "web")); got != "2.0.0" {
+			t.Errorf("web: expected 2.0.0, got %s", got)
+		}
+		if len(tm.created) != 0 {
+			t.Errorf("expected no tags, got %v", tm.created)
+		}
+	})
+
+	t.Run("a failed check leaves the module unchanged", func(t *testing.T) {
+		tmpDir := setup(t)
+
+		tm := &mockModuleTagManager{existing: map[string]bool{"web/v2.0.1": true}}
+		tagmanager.GetTagManagerFn = func() tagmanager.TagManager { return tm }
+		releasegate.GetReleaseGateFn = func() releasegate.ReleaseGate { return nil }
+		dependencycheck.GetDependencyCheckerFn = func() dependencycheck.DependencyChecker { return nil }
+		auditlog.GetAuditLogFn = func() auditlog.AuditLog { return nil }
+
+		appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+		var runErr error
+		if _, err := testutils.CaptureStdout(func() {
+			runErr = testutils.RunCLITestAllowError(t, appCli, []string{"verso", "bump", "patch", "--all", "--continue-on-error"}, tmpDir)
+		}); err != nil {
+			t.Fatalf("failed to capture stdout: %v", err)
+		}
+		if runErr == nil {
+			t.Fatal("expected an error for the existing tag")
+		}
+
+		if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, "api")); got != "1.0.1" {
+			t.Errorf("api: expected 1.0.1, got %s", got)
+		}
+		if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, "web")); got != "2.0.0" {
+			t.Errorf("web: expected 2.0.0, got %s", got)
+		}
+		if want := []string{"api/v1.0.1"}; !slices.Equal(tm.created, want) {
+			t.Errorf("created tags = %v, want %v", tm.created, want)
+		}
+	})
+}
+
+func TestCLI_BumpPatch_MultiModule_Atomic(t *testing.T) {
+	origGetTagManagerFn := tagmanager.GetTagManagerFn
+	origGetDependencyCheckerFn := dependencycheck.GetDependencyCheckerFn
+	defer func() {
+		tagmanager.GetTagManagerFn = origGetTagManagerFn
+		dependencycheck.GetDependencyCheckerFn = origGetDependencyCheckerFn
+	}()
+
+	dc := dependencycheck.NewDependencyChecker(&dependencycheck.Config{
+		Enabled:  true,
+		AutoSync: true,
+		Files:    []dependencycheck.FileConfig{{Path: "api/package.json", Field: "version", Format: "json"}},
+	})
+	dependencycheck.GetDependencyCheckerFn = func() dependencycheck.DependencyChecker { return dc }
+
+	tests := []struct {
+		name        string
+		existing    map[string]bool
+		wantErr     bool
+		wantVersion map[string]string
+		wantTags    []string
+		wantPkg     string
+	}{
+		{
+			name:        "all modules succeed",
+			wantVersion: map[string]string{"api": "1.0.1", "web": "2.0.1"},
+			wantTags:    []string{"api/v1.0.1", "web/v2.0.1"},
+			wantPkg:     `"version": "1.0.1"`,
+		},
+		{
+			name:        "a failure rolls back every module",
+			existing:    map[string]bool{"web/v2.0.1": true},
+			wantErr:     true,
+			wantVersion: map[string]string{"api": "1.0.0", "web": "2.0.0"},
+			wantPkg:     `"version": "1.0.0"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			files := map[string]string{
+				"api/.version":     "1.0.0",
+				"api/package.json": `{"name": "api", "version": "1.0.0"}`,
+				"web/.version":     "2.0.0",
+			}
+			for name, content := range files {
+				path := filepath.Join(tmpDir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tm := &mockModuleTagManager{existing: tt.existing}
+			tagmanager.GetTagManagerFn = func() tagmanager.TagManager { return tm }
+
+			cfg := &config.Config{Path: ".version"}
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+			var runErr error
+			if _, err := testutils.CaptureStdout(func() {
+				runErr = testutils.RunCLITestAllowError(t, appCli, []string{"verso", "bump", "--atomic", "--continue-on-error", "patch", "--all"}, tmpDir)
+			}); err != nil {
+				t.Fatalf("failed to capture stdout: %v", err)
+			}
+			if (runErr != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", runErr, tt.wantErr)
+			}
+			if tt.wantErr && !strings.Contains(runErr.Error(), "rolled back") {
+				t.Errorf("expected the error to report the rollback, got %v", runErr)
+			}
+
+			for name, want := range tt.wantVersion {
+				if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, name)); got != want {
+					t.Errorf("%s: expected %s, got %s", name, want, got)
+				}
+			}
+
+			data, err := os.ReadFile(filepath.Join(tmpDir, "api", "package.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(data), tt.wantPkg) {
+				t.Errorf("api/package.json = %s, want it to contain %s", data, tt.wantPkg)
+			}
+
+			slices.Sort(tm.created)
+			if !slices.Equal(tm.created, tt.wantTags) {
+				t.Errorf("created tags = %v, want %v", tm.created, tt.wantTags)
+			}
+		})
+	}
+}
+
+// mockPushingTagManager is a module tag manager with push enabled. It records
+// the tags created locally and the pushes, in order.
+type mockPushingTagManager struct {
+	mockModuleTagManager
+	failCreate string
+	pushErr    error
+	events     []string
+}
+
+func (m *mockPushingTagManager) PushesTags() bool { return true }
+
+func (m *mockPushingTagManager) CreateModuleTag(module string, v semver.SemVersion, msg string) error {
+	m.events = append(m.events, "create and push "+m.FormatModuleTagName(module, v))
+	return nil
+}
+
+func (m *mockPushingTagManager) CreateLocalModuleTag(module string, v semver.SemVersion, msg string) error {
+	name := m.FormatModuleTagName(module, v)
+	if name == m.failCreate {
+		return fmt.Errorf("cannot create %s", name)
+	}
+	m.events = append(m.events, "create "+name)
+	return nil
+}
+
+func (m *mockPushingTagManager) PushTags(names ...string) error {
+	sorted := slices.Sorted(slices.Values(names))
+	m.events = append(m.events, "push "+strings.Join(sorted, " "))
+	return m.pushErr
+}
+
+func TestCLI_BumpPatch_MultiModule_AtomicPush(t *testing.T) {
+	origGetTagManagerFn := tagmanager.GetTagManagerFn
+	defer func() { tagmanager.GetTagManagerFn = origGetTagManagerFn }()
+
+	tests := []struct {
+		name        string
+		failCreate  string
+		pushErr     error
+		wantErr     string
+		wantVersion map[string]string
+		wantPush    bool
+	}{
+		{
+			name:        "tags are pushed once all exist",
+			wantVersion: map[string]string{"api": "1.0.1", "web": "2.0.1"},
+			wantPush:    true,
+		},
+		{
+			name:        "a failed tag pushes nothing",
+			failCreate:  "web/v2.0.1",
+			wantErr:     "cannot create web/v2.0.1",
+			wantVersion: map[string]string{"api": "1.0.0", "web": "2.0.0"},
+		},
+		{
+			name:        "a failed push rolls back",
+			pushErr:     errors.New("remote rejected"),
+			wantErr:     "failed to push tags",
+			wantVersion: map[string]string{"api": "1.0.0", "web": "2.0.0"},
+			wantPush:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for name, version := range map[string]string{"api": "1.0.0", "web": "2.0.0"} {
+				dir := filepath.Join(tmpDir, name)
+				if err := os.MkdirAll(dir, 0755); err != nil {
+					t.Fatal(err)
+				}
+				testutils.WriteTempVersionFile(t, dir, version)
+			}
+
+			tm := &mockPushingTagManager{failCreate: tt.failCreate, pushErr: tt.pushErr}
+			tagmanager.GetTagManagerFn = func() tagmanager.TagManager { return tm }
+
+			cfg := &config.Config{Path: ".version"}
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+			var runErr error
+			if _, err := testutils.CaptureStdout(func() {
+				runErr = testutils.RunCLITestAllowError(t, appCli, []string{"verso", "bump", "--atomic", "patch", "--all"}, tmpDir)
+			}); err != nil {
+				t.Fatalf("failed to capture stdout: %v", err)
+			}
+			if tt.wantErr == "" && runErr != nil {
+				t.Fatalf("unexpected error: %v", runErr)
+			}
+			if tt.wantErr != "" && (runErr == nil || !strings.Contains(runErr.Error(), tt.wantErr) || !strings.Contains(runErr.Error(), "rolled back")) {
+				t.Fatalf("error = %v, want %q and a rollback", runErr, tt.wantErr)
+			}
+
+			for name, want := range tt.wantVersion {
+				if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, name)); got != want {
+					t.Errorf("%s: expected %s, got %s", name, want, got)
+				}
+			}
+
+			var creates, pushes []string
+			for i, event := range tm.events {
+				switch {
+				case strings.HasPrefix(event, "create and push "):
+					t.Errorf("event %d: tag pushed before every tag exists: %v", i, tm.events)
+				case strings.HasPrefix(event, "create "):
+					creates = append(creates, event)
+					if len(pushes) > 0 {
+						t.Errorf("event %d: tag created after a push: %v", i, tm.events)
+					}
+				default:
+					pushes = append(pushes, event)
+				}
+			}
+			if tt.failCreate == "" && len(creates) != 2 {
+				t.Errorf("expected both tags to be created locally, got %v", tm.events)
+			}
+			var want []string
+			if tt.wantPush {
+				want = []string{"push api/v1.0.1 web/v2.0.1"}
+			}
+			if !slices.Equal(pushes, want) {
+				t.Errorf("pushes = %v, want %v", pushes, want)
+			}
+		})
+	}
+}
+
+/* ------------------------------------------------------------------------- */
+/* RELEASE PLAN TESTS                                                        */
+/* ------------------------------------------------------------------------- */
+
+func TestCLI_Plan_MultiModule(t *testing.T) {
+	origInferModule := tryInferModuleBumpTypeFn
+	origInferChangelog := tryInferBumpTypeFromChangelogParserPluginFn
+	origGetTagManagerFn := tagmanager.GetTagManagerFn
+	origGetDependencyCheckerFn := dependencycheck.GetDependencyCheckerFn
+	origGetChangelogGeneratorFn := changeloggenerator.GetChangelogGeneratorFn
+	origGetModuleCommitsFn := changeloggenerator.GetModuleCommitsFn
+	origGetLatestModuleTagFn := changeloggenerator.GetLatestModuleTagFn
+	defer func() {
+		tryInferModuleBumpTypeFn = origInferModule
+		tryInferBumpTypeFromChangelogParserPluginFn = origInferChangelog
+		tagmanager.GetTagManagerFn = origGetTagManagerFn
+		dependencycheck.GetDependencyCheckerFn = origGetDependencyCheckerFn
+		changeloggenerator.GetChangelogGeneratorFn = origGetChangelogGeneratorFn
+		changeloggenerator.GetModuleCommitsFn = origGetModuleCommitsFn
+		changeloggenerator.GetLatestModuleTagFn = origGetLatestModuleTagFn
+	}()
+
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"api/.version":        "1.0.0",
+		"api/package.json":    `{"name": "@repo/api", "version": "1.0.0", "dependencies": {"@repo/shared": "^1.2.0"}}`,
+		"shared/.version":     "1.2.0",
+		"shared/package.json": `{"name": "@repo/shared"}`,
+		"web/.version":        "2.0.0",
+		"docs/.version":       "0.3.0",
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tryInferBumpTypeFromChangelogParserPluginFn = func() string { return "" }
+	tryInferModuleBumpTypeFn = func(mod *workspace.Module, since, until string) (string, bool) {
+		switch mod.Name {
+		case "shared", "docs":
+			return "minor", true
+		default:
+			return "", false
+		}
+	}
+	tm := &mockModuleTagManager{}
+	tagmanager.GetTagManagerFn = func() tagmanager.TagManager { return tm }
+	dc := dependencycheck.NewDependencyChecker(&dependencycheck.Config{
+		Enabled:  true,
+		AutoSync: true,
+		Files:    []dependencycheck.FileConfig{{Path: "api/package.json", Field: "version", Format: "json"}},
+	})
+	dependencycheck.GetDependencyCheckerFn = func() dependencycheck.DependencyChecker { return dc }
+	cg := changeloggenerator.NewChangelogGenerator(&changeloggenerator.Config{Enabled: true, Mode: "versioned", ChangesDir: ".changes"})
+	changeloggenerator.GetChangelogGeneratorFn = func() changeloggenerator.ChangelogGenerator { return cg }
+	changeloggenerator.GetLatestModuleTagFn = func(module string) (string, error) { return module + "/v1.0.0", nil }
+	changeloggenerator.GetModuleCommitsFn = func(since, until, dir string) ([]changeloggenerator.CommitInfo, error) {
+		if filepath.Base(dir) != "shared" {
+			return nil, nil
+		}
+		return []changeloggenerator.CommitInfo{{Hash: "abc123", ShortHash: "abc123", Subject: "feat(shared): add retries"}}, nil
+	}
+
+	cfg := &config.Config{
+		Path: ".version",
+		Workspace: &config.WorkspaceConfig{
+			Dependencies: &config.DependenciesConfig{Cascade: "patch"},
+		},
+	}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{PlanCmd(cfg)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "plan", "--format", "json"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+
+	var plan struct {
+		Modules []struct {
+			Module      string   `json:"module"`
+			NextVersion string   `json:"next_version"`
+			Source      string   `json:"source"`
+			Reason      string   `json:"reason"`
+			Note        string   `json:"note"`
+			Tags        []string `json:"tags"`
+			Files       []string `json:"files"`
+			Changelog   string   `json:"changelog"`
+		} `json:"modules"`
+		Total  int `json:"total"`
+		Bumped int `json:"bumped"`
+	}
+	if err := json.Unmarshal([]byte(output[strings.Index(output, "{"):]), &plan); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, output)
+	}
+	if plan.Total != 4 || plan.Bumped != 3 {
+		t.Errorf("total = %d, bumped = %d, want 4 and 3", plan.Total, plan.Bumped)
+	}
+
+	byName := map[string]int{}
+	for i, m := range plan.Modules {
+		byName[m.Module] = i
+	}
+	shared := plan.Modules[byName["shared"]]
+	if shared.NextVersion != "1.3.0" || shared.Source != "commits" || !slices.Equal(shared.Tags, []string{"shared/v1.3.0"}) {
+		t.Errorf("unexpected shared plan: %+v", shared)
+	}
+	if !strings.Contains(shared.Changelog, "## v1.3.0") || !strings.Contains(shared.Changelog, "add retries") {
+		t.Errorf("expected the shared changelog entry, got %q", shared.Changelog)
+	}
+	api := plan.Modules[byName["api"]]
+	if api.NextVersion != "1.0.1" || api.Source != "cascade" || !strings.Contains(api.Reason, "shared") {
+		t.Errorf("unexpected api plan: %+v", api)
+	}
+	if !slices.Equal(api.Files, []string{"api/package.json"}) {
+		t.Errorf("api files = %v, want api/package.json", api.Files)
+	}
+	docs := plan.Modules[byName["docs"]]
+	if docs.NextVersion != "0.3.1" || !strings.Contains(docs.Note, "Initial development") {
+		t.Errorf("unexpected docs plan: %+v", docs)
+	}
+	web := plan.Modules[byName["web"]]
+	if web.NextVersion != "" || !strings.Contains(web.Reason, "no commits") {
+		t.Errorf("unexpected web plan: %+v", web)
+	}
+
+	// Nothing is written
+	for name, want := range map[string]string{"api": "1.0.0", "shared": "1.2.0", "web": "2.0.0", "docs": "0.3.0"} {
+		if got := testutils.ReadTempVersionFile(t, filepath.Join(tmpDir, name)); got != want {
+			t.Errorf("%s: expected %s to be kept, got %s", name, want, got)
+		}
+	}
+	if len(tm.created) != 0 {
+		t.Errorf("expected no tags, created %v", tm.created)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "shared", ".changes")); !os.IsNotExist(err) {
+		t.Error("expected no changelog to be written")
+	}
+}
+
+func TestPlanCascade_Groups(t *testing.T) {
+	tmpDir := t.TempDir()
+	modules := map[string]*workspace.Module{}
+	var all []*workspace.Module
+	for _, name := range []string{"tool", "cli", "api", "shared"} {
+		version := map[string]string{"shared": "1.2.0", "api": "2.0.0", "cli": "2.0.0", "tool": "1.0.0"}[name]
+		dir := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		path := testutils.WriteTempVersionFile(t, dir, version)
+		modules[name] = &workspace.Module{Name: name, Path: path, Dir: dir, CurrentVersion: version}
+		all = append(all, modules[name])
+	}
+
+	cfg := &config.Config{Workspace: &config.WorkspaceConfig{
+		Modules: []config.ModuleConfig{
+			{Name: "api", DependsOn: []string{"shared"}},
+			{Name: "tool", DependsOn: []string{"cli"}},
+		},
+		Groups: []config.GroupConfig{{Name: "platform", Modules: []string{"api", "cli"}}},
+	}}
+	graph, err := workspace.BuildGraph(core.NewOSFileSystem(), all, cfg)
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+	groups, err := workspace.BuildGroups(all, cfg)
+	if err != nil {
+		t.Fatalf("BuildGroups() error = %v", err)
+	}
+	execCtx := &clix.ExecutionContext{Graph: graph, Groups: groups, Cascade: "patch"}
+
+	entries := []workspace.PlanEntry{{Module: modules["shared"], CurrentVersion: "1.2.0", NextVersion: "1.3.0", BumpType: "minor"}}
+	entries = planCascade(core.NewOSFileSystem(), execCtx, entries)
+
+	want := map[string]string{"shared": "1.3.0", "api": "2.0.1", "cli": "2.0.1", "tool": "1.0.1"}
+	if len(entries) != len(want) {
+		t.Fatalf("expected %d entries, got %+v", len(want), entries)
+	}
+	for _, entry := range entries {
+		if entry.NextVersion != want[entry.Module.Name] {
+			t.Errorf("%s: next = %q, want %q", entry.Module.Name, entry.NextVersion, want[entry.Module.Name])
+		}
+		if entry.Module.Name != "shared" && entry.Source != sourceCascade {
+			t.Errorf("%s: source = %q, want cascade", entry.Module.Name, entry.Source)
+		}
+		if entry.Module.Name == "cli" && !strings.Contains(entry.Reason, "group platform") {
+			t.Errorf("cli: expected the group in the reason, got %q", entry.Reason)
+		}
+	}
+}
+
+func TestCLI_Plan_SingleModule(t *testing.T) {
+	origInfer := tryInferBumpTypeFromCommitParserPluginFn
+	defer func() { tryInferBumpTypeFromCommitParserPluginFn = origInfer }()
+	tryInferBumpTypeFromCommitParserPluginFn = func(since, until string) string { return "patch" }
+
+	tmpDir := t.TempDir()
+	testutils.WriteTempVersionFile(t, tmpDir, "1.2.3")
+	pending := filepath.Join(tmpDir, changeset.DefaultDir)
+	if err := os.MkdirAll(pending, 0755); err != nil {
+		t.Fatal(err)
+	}
+	changePath := filepath.Join(pending, "a.md")
+	if err := os.WriteFile(changePath, []byte("---\n\".\": minor\n---\n\nAdd search.\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{Path: ".version"}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{PlanCmd(cfg)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "plan"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+
+	for _, want := range []string{
+		"Release plan\n",
+		"  • .version: 1.2.3 -> 1.3.0 (minor, from changes)\n",
+		"1 pending change file(s) imply minor",
+		"Plan: 1 of 1 module to bump",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output does not contain %q:\n%s", want, output)
+		}
+	}
+	if got := testutils.ReadTempVersionFile(t, tmpDir); got != "1.2.3" {
+		t.Errorf("expected the version to be kept, got %s", got)
+	}
+	if _, err := os.Stat(changePath); err != nil {
+		t.Errorf("expected the change file to be kept: %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+package bumpcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/indaco/verso/internal/clix"
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/hooks"
+	"github.com/indaco/verso/internal/operations"
+	"github.com/indaco/verso/internal/semver"
+	"github.com/urfave/cli/v3"
+)
+
+// prePatchCmd returns the "prepatch" subcommand.
+func prePatchCmd(cfg *config.Config) *cli.Command {
+	return compoundCmd(cfg, operations.BumpPrePatch, "patch", "Increment patch and start a pre-release (1.2.5 -> 1.2.6-rc.1)")
+}
+
+// preMinorCmd returns the "preminor" subcommand.
+func preMinorCmd(cfg *config.Config) *cli.Command {
+	return compoundCmd(cfg, operations.BumpPreMinor, "minor", "Increment minor and start a pre-release (1.2.5 -> 1.3.0-rc.1)")
+}
+
+// preMajorCmd returns the "premajor" subcommand.
+func preMajorCmd(cfg *config.Config) *cli.Command {
+	return compoundCmd(cfg, operations.BumpPreMajor, "major", "Increment major and start a pre-release (1.2.5 -> 2.0.0-rc.1)")
+}
+
+// compoundCmd builds a compound bump subcommand: bump level, then start a new pre-release.
+func compoundCmd(cfg *config.Config, bumpType operations.BumpType, level, usage string) *cli.Command {
+	name := string(bumpType)
+	return &cli.Command{
+		Name:      name,
+		Usage:     usage,
+		UsageText: fmt.Sprintf("verso bump %s [--label name] [--meta data] [--preserve-meta] [--skip-hooks] [--all] [--module name]", name),
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "label",
+				Aliases: []string{"l"},
+				Usage:   "Pre-release label (e.g., alpha, beta, rc). Defaults to the current label, or rc",
+			},
+			&cli.BoolFlag{
+				Name:  "skip-hooks",
+				Usage: "Skip pre-release hooks",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runBumpCompound(ctx, cmd, cfg, bumpType, level)
+		},
+	}
+}
+
+// runBumpCompound bumps level and starts a new pre-release series.
+func runBumpCompound(ctx context.Context, cmd *cli.Command, cfg *config.Config, bumpType operations.BumpType, level string) error {
+	// The parent --pre sets a literal pre-release; compound bumps start a series from --label
+	if cmd.String("pre") != "" {
+		return fmt.Errorf("--pre is not supported by %s; use --label to name the pre-release", bumpType)
+	}
+
+	label := cmd.String("label")
+	meta := cmd.String("meta")
+	isPreserveMeta := cmd.Bool("preserve-meta")
+	isSkipHooks := cmd.Bool("skip-hooks")
+
+	if err := hooks.RunPreReleaseHooksFn(isSkipHooks); err != nil {
+		return err
+	}
+
+	execCtx, err := clix.GetExecutionContext(ctx, cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	if !execCtx.IsSingleModule() {
+		return runMultiModuleBump(ctx, cmd, cfg, execCtx, bumpType, label, meta, isPreserveMeta)
+	}
+
+	return runSingleModuleCompoundBump(ctx, cmd, cfg, execCtx, string(bumpType), level, label, meta, isPreserveMeta, isSkipHooks)
+}
+
+// runSingleModuleCompoundBump handles compound bumps for single-module mode.
+func runSingleModuleCompoundBump(ctx context.Context, cmd *cli.Command, cfg *config.Config, execCtx *clix.ExecutionContext, bumpType, level, label, meta string, isPreserveMeta, isSkipHooks bool) error {
+	if _, err := clix.FromCommandFn(cmd); err != nil {
+		return err
+	}
+
+	release, err := lockVersionFile(execCtx.Path)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	previousVersion, err := semver.ReadVersion(execCtx.Path)
+	if err != nil {
+		return err
+	}
+
+	// Calculate new version
+	newVersion, err := semver.BumpPre(previousVersion, level, label)
+	if err != nil {
+		return err
+	}
+	newVersion.Build = calculateNewBuild(meta, isPreserveMeta, previousVersion.Build)
+
+	// Validate release gates before bumping
+	if err := validateReleaseGate(newVersion, previousVersion, bumpType); err != nil {
+		return err
+	}
+
+	// Validate version policy before bumping
+	if err := validateVersionPolicy(newVersion, previousVersion, bumpType); err != nil {
+		return err
+	}
+
+	// Validate dependency consistency before bumping
+	if err := validateDependencyConsistency(newVersion); err != nil {
+		return err
+	}
+
+	// Validate tag availability before bumping
+	if err := validateTagAvailable(newVersion); err != nil {
+		return err
+	}
+
+	if err := runPreBumpExtensionHooks(ctx, cfg, newVersion.String(), previousVersion.String(), bumpType, isSkipHooks); err != nil {
+		return err
+	}
+
+	if err := semver.SaveVersion(execCtx.Path, newVersion); err != nil {
+		return err
+	}
+
+	// Sync dependency files after updating .version
+	if err := syncDependencies(execCtx.Path, newVersion); err != nil {
+		return err
+	}
+
+	// Generate changelog entry
+	if err := generateChangelogAfterBump(newVersion, previousVersion, bumpType); err != nil {
+		return err
+	}
+
+	// Record audit log entry
+	if err := recordAuditLogEntry(newVersion, previousVersion, bumpType); err != nil {
+		return err
+	}
+
+	if err := runPostBumpExtensionHooks(ctx, cfg, execCtx.Path, previousVersion.String(), bumpType, isSkipHooks); err != nil {
+		return err
+	}
+
+	// Create tag after successful bump
+	return createTagAfterBump(newVersion, bumpType)
+}
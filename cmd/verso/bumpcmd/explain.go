@@ -0,0 +1,581 @@
+package bumpcmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/indaco/verso/internal/changeset"
+	"github.com/indaco/verso/internal/clix"
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/operations"
+	"github.com/indaco/verso/internal/plugins/changelogparser"
+	"github.com/indaco/verso/internal/plugins/commitparser"
+	"github.com/indaco/verso/internal/plugins/commitparser/gitlog"
+	"github.com/indaco/verso/internal/semver"
+	"github.com/indaco/verso/internal/workspace"
+	"github.com/urfave/cli/v3"
+)
+
+// Sources of the bump type chosen by bump auto.
+const (
+	sourceLabel     = "label"
+	sourceChanges   = "changes"
+	sourceChangelog = "changelog"
+	sourceCommits   = "commits"
+	sourceDefault   = "default"
+)
+
+// inferenceExplanation explains how bump auto chooses its bump type.
+type inferenceExplanation struct {
+	Current string `json:"current,omitempty"`
+	Next    string `json:"next,omitempty"`
+	// BumpType is the applied bump: major, minor, patch, or auto when nothing
+	// was inferred (pre-release promotion or patch bump).
+	BumpType string `json:"bump_type"`
+	// Source is what decided the bump: label, changes, changelog, commits or default.
+	Source string `json:"source"`
+	// Reason explains why Source won.
+	Reason string `json:"reason"`
+	// Note describes adjustments to the bump, such as the initial development policy.
+	Note      string                `json:"note,omitempty"`
+	Changes   *changesExplanation   `json:"changes,omitempty"`
+	Changelog *changelogExplanation `json:"changelog,omitempty"`
+	Commits   *commitsExplanation   `json:"commits,omitempty"`
+}
+
+// changesExplanation lists the pending change files.
+type changesExplanation struct {
+	Dir   string              `json:"dir"`
+	Level string              `json:"level"`
+	Files []*changeset.Change `json:"files"`
+}
+
+// changelogExplanation lists the Unreleased changelog entries.
+type changelogExplanation struct {
+	Path     string                           `json:"path"`
+	Priority string                           `json:"priority"`
+	Level    string                           `json:"level,omitempty"`
+	Error    string                           `json:"error,omitempty"`
+	Entries  []changelogparser.ChangelogEntry `json:"entries"`
+}
+
+// commitsExplanation lists the analyzed commits with their classification.
+type commitsExplanation struct {
+	Range   string                        `json:"range"`
+	Level   string                        `json:"level,omitempty"`
+	Error   string                        `json:"error,omitempty"`
+	Commits []commitparser.Classification `json:"commits"`
+}
+
+// runAutoExplain prints how bump auto would choose its bump type, without
+// running hooks or changing any version. Like plan, it never prompts for
+// modules: without --module or --changed, every module is explained.
+func runAutoExplain(ctx context.Context, cmd *cli.Command, cfg *config.Config, label, since, until, disableReason string, changes []*changeset.Change, initialDev, preserveMeta bool) error {
+	switch label {
+	case "", "patch", "minor", "major":
+	default:
+		return cli.Exit("invalid --label: must be 'patch', 'minor', or 'major'", 1)
+	}
+
+	execCtx, err := clix.GetWorkspaceContext(ctx, cmd, cfg)
+	if err != nil {
+		return err
+	}
+	if !execCtx.IsSingleModule() {
+		return runModulesExplain(cmd, explainModules(core.NewOSFileSystem(), execCtx, label, since, until, disableReason, changes, initialDev, preserveMeta))
+	}
+
+	explanation := explainInference(label, disableReason, since, until, changes)
+	if err := explainNextVersion(explanation, execCtx.Path, initialDev, preserveMeta); err != nil {
+		return err
+	}
+
+	if cmd.String("format") == "json" {
+		return printExplanationJSON(explanation)
+	}
+
+	fmt.Print(formatExplanation(explanation))
+	return nil
+}
+
+// runModulesExplain prints the explanations of the modules of a workspace.
+func runModulesExplain(cmd *cli.Command, w *workspaceExplanation) error {
+	if cmd.String("format") == "json" {
+		if err := printExplanationJSON(w); err != nil {
+			return err
+		}
+	} else {
+		fmt.Print(formatWorkspaceExplanation(w))
+	}
+
+	failed := 0
+	for _, m := range w.Modules {
+		if m.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d module(s) could not be explained", failed)
+	}
+	return nil
+}
+
+// printExplanationJSON prints an explanation as indented JSON.
+func printExplanationJSON(v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// explainInference collects the change files, changelog entries and commits
+// bump auto would consider, and resolves them with the same precedence: an
+// explicit label, then pending change files, then the changelog (when its
+// priority is "changelog"), then commits.
+func explainInference(label, disableReason, since, until string, changes []*changeset.Change) *inferenceExplanation {
+	e := &inferenceExplanation{BumpType: "auto", Source: sourceDefault}
+
+	if label != "" {
+		e.BumpType, e.Source = label, sourceLabel
+		e.Reason = fmt.Sprintf("--label %s overrides inference", label)
+		return e
+	}
+	if len(changes) > 0 {
+		level := changeset.HighestLevel(changes)
+		e.Changes = &changesExplanation{Dir: changeset.DefaultDir, Level: level, Files: changes}
+		e.BumpType, e.Source = level, sourceChanges
+		e.Reason = fmt.Sprintf("%d pending change file(s) imply %s; the changelog and commits are not used", len(changes), level)
+		return e
+	}
+	if disableReason != "" {
+		e.Reason = disableReason + "; a pre-release is promoted, otherwise patch is bumped"
+		return e
+	}
+
+	e.Changelog = explainChangelog()
+	e.Commits = explainCommits(since, until)
+
+	changelogFirst := e.Changelog != nil && e.Changelog.Priority == "changelog"
+	switch {
+	case changelogFirst && e.Changelog.Level != "":
+		e.BumpType, e.Source = e.Changelog.Level, sourceChangelog
+		e.Reason = fmt.Sprintf("changelog-parser priority is %q and the Unreleased section implies %s; commits are not used", e.Changelog.Priority, e.Changelog.Level)
+	case e.Commits != nil && e.Commits.Level != "":
+		e.BumpType, e.Source = e.Commits.Level, sourceCommits
+		e.Reason = commitsReason(e.Commits, changelogFirst)
+	default:
+		e.Reason = "nothing inferred; a pre-release is promoted, otherwise patch is bumped"
+	}
+	return e
+}
+
+// commitsReason explains why the commits decided the bump.
+func commitsReason(commits *commitsExplanation, changelogFirst bool) string {
+	var sb strings.Builder
+	if changelogFirst {
+		sb.WriteString("no bump type inferred from the changelog, falling back to commits; ")
+	}
+	for _, c := range commits.Commits {
+		if c.Level == commits.Level {
+			fmt.Fprintf(&sb, "highest commit level is %s, first from %q: %s", commits.Level, c.Subject, c.Reason)
+			return sb.String()
+		}
+	}
+	fmt.Fprintf(&sb, "highest commit level is %s", commits.Level)
+	return sb.String()
+}
+
+// explainChangelog lists the Unreleased entries if the changelog parser is
+// enabled with inference, or returns nil.
+func explainChangelog() *changelogExplanation {
+	parser := changelogparser.GetChangelogParserFn()
+	plugin, ok := parser.(*changelogparser.ChangelogParserPlugin)
+	if !ok || !plugin.IsEnabled() || !plugin.GetConfig().InferBumpType {
+		return nil
+	}
+
+	e := &changelogExplanation{
+		Path:     plugin.GetConfig().Path,
+		Priority: plugin.GetConfig().Priority,
+		Entries:  []changelogparser.ChangelogEntry{},
+	}
+	entries, err := plugin.UnreleasedEntries()
+	if err != nil {
+		e.Error = err.Error()
+		return e
+	}
+	if entries != nil {
+		e.Entries = entries
+	}
+	if len(entries) > 0 {
+		// Entries are ordered from the highest level down
+		e.Level = entries[0].Level
+	}
+	return e
+}
+
+// explainCommits classifies the commits in since..until if the commit parser
+// is registered, or returns nil.
+func explainCommits(since, until string) *commitsExplanation {
+	parser := commitparser.GetCommitParserFn()
+	if parser == nil {
+		return nil
+	}
+
+	e := &commitsExplanation{Range: describeRange(since, until), Commits: []commitparser.Classification{}}
+	commits, err := gitlog.GetCommitsFn(since, until)
+	if err != nil {
+		e.Error = err.Error()
+		return e
+	}
+	classifyCommits(e, parser, commits)
+	return e
+}
+
+// classifyCommits sets the classification of each commit and their level.
+// It returns the error it records.
+func classifyCommits(e *commitsExplanation, parser commitparser.CommitParser, commits []string) error {
+	if explainer, ok := parser.(commitparser.Explainer); ok {
+		classifications, err := explainer.Explain(commits)
+		if err != nil {
+			e.Error = err.Error()
+			return err
+		}
+		e.Commits = classifications
+	}
+
+	level, err := parser.Parse(commits)
+	if err != nil {
+		e.Error = err.Error()
+		return err
+	}
+	e.Level = level
+	return nil
+}
+
+// explainNextVersion fills in the current and next version of the module at path.
+func explainNextVersion(e *inferenceExplanation, path string, initialDev, preserveMeta bool) error {
+	current, err := semver.ReadVersion(path)
+	if err != nil {
+		return fmt.Errorf("failed to read version: %w", err)
+	}
+
+	var next semver.SemVersion
+	switch e.Source {
+	case sourceLabel:
+		next, err = semver.BumpByLabelFunc(current, e.BumpType)
+	case sourceChanges, sourceChangelog, sourceCommits:
+		next, e.Note, err = bumpInferred(current, e.BumpType, initialDev && e.Source != sourceChanges, preserveMeta)
+		if err == nil && current.PreRelease != "" {
+			e.Note = fmt.Sprintf("%s is a pre-release: promoted to its release instead of a %s bump", current.String(), e.BumpType)
+		}
+	default:
+		next, err = semver.BumpNextFunc(current)
+	}
+	if err != nil {
+		return err
+	}
+
+	e.Current, e.Next = current.String(), next.String()
+	return nil
+}
+
+// workspaceExplanation explains how bump auto chooses the bump of each module.
+type workspaceExplanation struct {
+	// Changelog is shared by the modules: a changelog taking precedence
+	// decides the bump of every module.
+	Changelog *changelogExplanation `json:"changelog,omitempty"`
+	Modules   []*moduleExplanation  `json:"modules"`
+}
+
+// moduleExplanation explains the bump of a module; BumpType is "none" for
+// modules left unchanged.
+type moduleExplanation struct {
+	Module string `json:"module"`
+	Group  string `json:"group,omitempty"`
+	inferenceExplanation
+	Error string `json:"error,omitempty"`
+}
+
+// moduleCommits is the explanation of the commits touching a module, and
+// whether any does.
+type moduleCommits struct {
+	explanation *commitsExplanation
+	changed     bool
+}
+
+// explainModules explains bump auto for each selected module and the members
+// of its group, with the same precedence as in multi-module mode: an explicit
+// label, then the change files bumping the module, then a changelog taking
+// precedence (for every module), then the commits touching the module. The
+// members of a group are bumped together by the highest level of any member.
+func explainModules(fs core.FileSystem, execCtx *clix.ExecutionContext, label, since, until, disableReason string, changes []*changeset.Change, initialDev, preserveMeta bool) *workspaceExplanation {
+	w := &workspaceExplanation{}
+	source := sourceCommits
+	switch {
+	case label != "":
+		source = sourceLabel
+	case len(changes) > 0:
+		source = sourceChanges
+	case disableReason != "":
+		source = sourceDefault
+	default:
+		w.Changelog = explainChangelog()
+		if w.Changelog != nil && w.Changelog.Priority == "changelog" && w.Changelog.Level != "" {
+			source = sourceChangelog
+		}
+	}
+
+	commits := map[string]moduleCommits{}
+	inferModule := func(mod *workspace.Module) (string, bool) {
+		if source == sourceChanges {
+			level := changeset.Level(changes, mod.Name)
+			return level, level != ""
+		}
+		c, ok := commits[mod.Name]
+		if !ok {
+			c = explainModuleCommits(mod, since, until)
+			commits[mod.Name] = c
+		}
+		if c.explanation == nil {
+			return "", c.changed
+		}
+		return c.explanation.Level, c.changed
+	}
+
+	modules := workspace.ExpandGroups(execCtx.Modules, execCtx.Groups)
+	w.Modules = make([]*moduleExplanation, 0, len(modules))
+	for _, mod := range modules {
+		m := &moduleExplanation{Module: mod.Name, inferenceExplanation: inferenceExplanation{Source: source}}
+		e := &m.inferenceExplanation
+		w.Modules = append(w.Modules, m)
+
+		members := []*workspace.Module{mod}
+		if g := workspace.GroupOf(execCtx.Groups, mod.Name); g != nil {
+			m.Group, members = g.Name, g.Modules
+		}
+
+		level, changed, initial := "", true, false
+		switch source {
+		case sourceLabel:
+			level = label
+		case sourceChangelog:
+			level, initial = w.Changelog.Level, true
+		case sourceChanges, sourceCommits:
+			changed = false
+			for _, member := range members {
+				memberLevel, memberChanged := inferModule(member)
+				changed = changed || memberChanged
+				if slices.Index(inferenceLevels, memberLevel) > slices.Index(inferenceLevels, level) {
+					level = memberLevel
+				}
+			}
+			initial = source == sourceCommits && level != ""
+		}
+
+		if source == sourceLabel {
+			e.Reason = fmt.Sprintf("--label %s overrides inference", label)
+		} else {
+			e.Reason = planReason(mod, execCtx.Groups, source, level, changed, since, disableReason)
+		}
+		switch source {
+		case sourceChanges:
+			e.Changes = &changesExplanation{Dir: changeset.DefaultDir, Level: level, Files: changesBumping(changes, members)}
+		case sourceCommits:
+			e.Commits = commits[mod.Name].explanation
+		}
+
+		current, err := semver.ReadVersion(mod.Path)
+		if err != nil {
+			m.Error = fmt.Sprintf("failed to read version: %v", err)
+			continue
+		}
+		e.Current = current.String()
+		if !changed {
+			e.BumpType = "none"
+			continue
+		}
+
+		next, note, err := moduleNext(fs, current, level, initial && initialDev, preserveMeta)
+		if err != nil {
+			m.Error = err.Error()
+			continue
+		}
+		e.BumpType, e.Next, e.Note = string(labelBumpType(level)), next.String(), note
+	}
+	return w
+}
+
+// explainModuleCommits classifies the commits touching the module, as
+// tryInferModuleBumpType reads them. Without a commit parser, or if the
+// commits cannot be read, the module counts as changed; if no commit implies
+// a bump, it counts as unchanged.
+func explainModuleCommits(mod *workspace.Module, since, until string) moduleCommits {
+	parser := commitparser.GetCommitParserFn()
+	if parser == nil {
+		return moduleCommits{changed: true}
+	}
+
+	rng := describeRange(since, until)
+	if since == "" {
+		rng = describeRange(mod.Name+" last tag", until)
+	}
+	e := &commitsExplanation{Range: rng, Commits: []commitparser.Classification{}}
+	commits, err := gitlog.GetModuleCommitsFn(mod.Name, mod.Dir, since, until)
+	if err != nil {
+		e.Error = err.Error()
+		return moduleCommits{explanation: e, changed: true}
+	}
+	if len(commits) == 0 {
+		return moduleCommits{explanation: e}
+	}
+	if err := classifyCommits(e, parser, commits); errors.Is(err, commitparser.ErrNoBumpType) {
+		return moduleCommits{explanation: e}
+	}
+	return moduleCommits{explanation: e, changed: true}
+}
+
+// changesBumping returns the change files bumping any of the modules.
+func changesBumping(changes []*changeset.Change, modules []*workspace.Module) []*changeset.Change {
+	files := []*changeset.Change{}
+	for _, change := range changes {
+		for _, mod := range modules {
+			if _, ok := change.Bumps[mod.Name]; ok {
+				files = append(files, change)
+				break
+			}
+		}
+	}
+	return files
+}
+
+// moduleNext returns the version a module bump moves current to: by label,
+// or for an empty label the pre-release promotion or patch bump. The note
+// describes an initial development adjustment.
+func moduleNext(fs core.FileSystem, current semver.SemVersion, label string, initialDev, preserveMeta bool) (semver.SemVersion, string, error) {
+	next, err := operations.NewBumpOperation(fs, labelBumpType(label), "", "", preserveMeta).
+		WithInitialDevelopment(initialDev).
+		Next(current)
+	if err != nil {
+		return semver.SemVersion{}, "", err
+	}
+
+	note := ""
+	if initialDev && label != "" {
+		if adjusted := semver.InitialDevelopmentLabel(current, label); adjusted != label {
+			note = fmt.Sprintf("Initial development (0.x): bumping %s instead of %s", adjusted, label)
+		}
+	}
+	return next, note, nil
+}
+
+// describeRange formats the analyzed commit range.
+func describeRange(since, until string) string {
+	if since == "" {
+		since = "last tag"
+	}
+	if until == "" {
+		until = "HEAD"
+	}
+	return since + ".." + until
+}
+
+// formatExplanation renders an explanation as text.
+func formatExplanation(e *inferenceExplanation) string {
+	var sb strings.Builder
+
+	if c := e.Changes; c != nil {
+		fmt.Fprintf(&sb, "Change files (%s): %s\n", c.Dir, c.Level)
+		for _, change := range c.Files {
+			bumps := make([]string, 0, len(change.Bumps))
+			for _, module := range slices.Sorted(maps.Keys(change.Bumps)) {
+				bumps = append(bumps, module+": "+change.Bumps[module])
+			}
+			fmt.Fprintf(&sb, "  %s (%s)\n", filepath.Base(change.Path), strings.Join(bumps, ", "))
+		}
+		sb.WriteString("\n")
+	}
+
+	if e.Changelog != nil {
+		writeChangelogExplanation(&sb, e.Changelog)
+	}
+
+	if c := e.Commits; c != nil {
+		fmt.Fprintf(&sb, "Commits (%s): %s\n", c.Range, levelOrNone(c.Level))
+		for _, commit := range c.Commits {
+			fmt.Fprintf(&sb, "  %-6s %s\n         %s\n", commit.Level, commit.Subject, commit.Reason)
+		}
+		if c.Error != "" {
+			fmt.Fprintf(&sb, "  (%s)\n", c.Error)
+		}
+		sb.WriteString("\n")
+	}
+
+	fmt.Fprintf(&sb, "Result: %s (from %s)\n", e.BumpType, e.Source)
+	fmt.Fprintf(&sb, "  %s\n", e.Reason)
+	if e.Note != "" {
+		fmt.Fprintf(&sb, "  %s\n", e.Note)
+	}
+	switch {
+	case e.Current != "" && e.Next != "":
+		fmt.Fprintf(&sb, "Version: %s -> %s\n", e.Current, e.Next)
+	case e.Current != "":
+		fmt.Fprintf(&sb, "Version: %s (not bumped)\n", e.Current)
+	}
+	return sb.String()
+}
+
+// writeChangelogExplanation renders the Unreleased changelog entries.
+func writeChangelogExplanation(sb *strings.Builder, c *changelogExplanation) {
+	fmt.Fprintf(sb, "Changelog (%s, Unreleased, priority %s): %s\n", c.Path, c.Priority, levelOrNone(c.Level))
+	for _, entry := range c.Entries {
+		fmt.Fprintf(sb, "  %-6s %s: %s\n", entry.Level, entry.Section, entry.Entry)
+	}
+	if c.Error != "" {
+		fmt.Fprintf(sb, "  (%s)\n", c.Error)
+	}
+	sb.WriteString("\n")
+}
+
+// formatWorkspaceExplanation renders the explanations of the modules as text,
+// one section per module.
+func formatWorkspaceExplanation(w *workspaceExplanation) string {
+	var sb strings.Builder
+
+	if w.Changelog != nil {
+		writeChangelogExplanation(&sb, w.Changelog)
+	}
+
+	for i, m := range w.Modules {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		header := "Module " + m.Module
+		if m.Group != "" {
+			header += " (group " + m.Group + ")"
+		}
+		fmt.Fprintf(&sb, "== %s ==\n", header)
+		sb.WriteString(formatExplanation(&m.inferenceExplanation))
+		if m.Error != "" {
+			fmt.Fprintf(&sb, "Error: %s\n", m.Error)
+		}
+	}
+	return sb.String()
+}
+
+// levelOrNone returns level, or "none" when empty.
+func levelOrNone(level string) string {
+	if level == "" {
+		return "none"
+	}
+	return level
+}
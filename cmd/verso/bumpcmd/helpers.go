@@ -16,6 +16,16 @@ import (
 	"github.com/indaco/verso/internal/semver"
 )
 
+// lockVersionFile holds the version file lock for a whole bump, so concurrent
+// bumps serialize instead of computing the same next version.
+func lockVersionFile(path string) (release func(), err error) {
+	lock, err := semver.LockVersionFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return func() { _ = lock.Release() }, nil
+}
+
 // runPreBumpExtensionHooks runs pre-bump extension hooks if not skipped.
 func runPreBumpExtensionHooks(ctx context.Context, cfg *config.Config, newVersion, prevVersion, bumpType string, skipHooks bool) error {
 	if skipHooks {
@@ -157,6 +167,11 @@ func validateDependencyConsistency(version semver.SemVersion) error {
 		return nil
 	}
 
+	return checkDependencyConsistency(dc, version)
+}
+
+// checkDependencyConsistency checks if the files of dc match the version.
+func checkDependencyConsistency(dc dependencycheck.DependencyChecker, version semver.SemVersion) error {
 	inconsistencies, err := dc.CheckConsistency(version.String())
 	if err != nil {
 		return fmt.Errorf("dependency check failed: %w", err)
@@ -176,8 +191,10 @@ func validateDependencyConsistency(version semver.SemVersion) error {
 }
 
 // syncDependencies updates all configured dependency files to match the new version.
+// The files are synced as a whole under the lock of the version file at path,
+// so concurrent bumps never leave a half-synced set.
 // Returns nil if dependency checker is not enabled or auto-sync is disabled.
-func syncDependencies(version semver.SemVersion) error {
+func syncDependencies(path string, version semver.SemVersion) error {
 	dc := dependencycheck.GetDependencyCheckerFn()
 	if dc == nil {
 		return nil
@@ -188,6 +205,12 @@ func syncDependencies(version semver.SemVersion) error {
 		return nil
 	}
 
+	release, err := lockVersionFile(path)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	if err := dc.SyncVersions(version.String()); err != nil {
 		return fmt.Errorf("failed to sync dependency versions: %w", err)
 	}
@@ -199,6 +222,12 @@ func syncDependencies(version semver.SemVersion) error {
 // generateChangelogAfterBump generates changelog entries if changelog generator is enabled.
 // Returns nil if changelog generator is not enabled.
 func generateChangelogAfterBump(version, previousVersion semver.SemVersion, bumpType string) error {
+	return generateChangelogWithNotes(version, previousVersion, bumpType, nil)
+}
+
+// generateChangelogWithNotes generates changelog entries with release notes,
+// such as the summaries of change files, if changelog generator is enabled.
+func generateChangelogWithNotes(version, previousVersion semver.SemVersion, bumpType string, notes []string) error {
 	cg := changeloggenerator.GetChangelogGeneratorFn()
 	if cg == nil {
 		return nil
@@ -219,7 +248,7 @@ func generateChangelogAfterBump(version, previousVersion semver.SemVersion, bump
 		prevVersionStr = ""
 	}
 
-	if err := cg.GenerateForVersion(versionStr, prevVersionStr, bumpType); err != nil {
+	if err := plugin.WithNotes(notes).GenerateForVersion(versionStr, prevVersionStr, bumpType); err != nil {
 		return fmt.Errorf("failed to generate changelog: %w", err)
 	}
 
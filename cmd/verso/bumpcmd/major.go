@@ -46,7 +46,7 @@ func runBumpMajor(ctx context.Context, cmd *cli.Command, cfg *config.Config) err
 	}
 
 	if !execCtx.IsSingleModule() {
-		return runMultiModuleBump(ctx, cmd, execCtx, operations.BumpMajor, pre, meta, isPreserveMeta)
+		return runMultiModuleBump(ctx, cmd, cfg, execCtx, operations.BumpMajor, pre, meta, isPreserveMeta)
 	}
 
 	return runSingleModuleMajorBump(ctx, cmd, cfg, execCtx, pre, meta, isPreserveMeta, isSkipHooks)
@@ -58,16 +58,22 @@ func runSingleModuleMajorBump(ctx context.Context, cmd *cli.Command, cfg *config
 		return err
 	}
 
+	release, err := lockVersionFile(execCtx.Path)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	previousVersion, err := semver.ReadVersion(execCtx.Path)
 	if err != nil {
 		return err
 	}
 
 	// Calculate new version
-	newVersion := previousVersion
-	newVersion.Major++
-	newVersion.Minor = 0
-	newVersion.Patch = 0
+	newVersion, err := semver.BumpByLabelFunc(previousVersion, "major")
+	if err != nil {
+		return err
+	}
 	newVersion.PreRelease = pre
 	newVersion.Build = calculateNewBuild(meta, isPreserveMeta, previousVersion.Build)
 
@@ -100,7 +106,7 @@ func runSingleModuleMajorBump(ctx context.Context, cmd *cli.Command, cfg *config
 	}
 
 	// Sync dependency files after updating .version
-	if err := syncDependencies(newVersion); err != nil {
+	if err := syncDependencies(execCtx.Path, newVersion); err != nil {
 		return err
 	}
 
@@ -46,7 +46,7 @@ func runBumpMinor(ctx context.Context, cmd *cli.Command, cfg *config.Config) err
 	}
 
 	if !execCtx.IsSingleModule() {
-		return runMultiModuleBump(ctx, cmd, execCtx, operations.BumpMinor, pre, meta, isPreserveMeta)
+		return runMultiModuleBump(ctx, cmd, cfg, execCtx, operations.BumpMinor, pre, meta, isPreserveMeta)
 	}
 
 	return runSingleModuleMinorBump(ctx, cmd, cfg, execCtx, pre, meta, isPreserveMeta, isSkipHooks)
@@ -58,15 +58,22 @@ func runSingleModuleMinorBump(ctx context.Context, cmd *cli.Command, cfg *config
 		return err
 	}
 
+	release, err := lockVersionFile(execCtx.Path)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	previousVersion, err := semver.ReadVersion(execCtx.Path)
 	if err != nil {
 		return err
 	}
 
 	// Calculate new version
-	newVersion := previousVersion
-	newVersion.Minor++
-	newVersion.Patch = 0
+	newVersion, err := semver.BumpByLabelFunc(previousVersion, "minor")
+	if err != nil {
+		return err
+	}
 	newVersion.PreRelease = pre
 	newVersion.Build = calculateNewBuild(meta, isPreserveMeta, previousVersion.Build)
 
@@ -99,7 +106,7 @@ func runSingleModuleMinorBump(ctx context.Context, cmd *cli.Command, cfg *config
 	}
 
 	// Sync dependency files after updating .version
-	if err := syncDependencies(newVersion); err != nil {
+	if err := syncDependencies(execCtx.Path, newVersion); err != nil {
 		return err
 	}
 
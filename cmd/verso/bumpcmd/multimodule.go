@@ -3,8 +3,14 @@ package bumpcmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
 
+	"github.com/indaco/verso/internal/changeset"
 	"github.com/indaco/verso/internal/clix"
+	"github.com/indaco/verso/internal/config"
 	"github.com/indaco/verso/internal/core"
 	"github.com/indaco/verso/internal/operations"
 	"github.com/indaco/verso/internal/workspace"
@@ -15,14 +21,30 @@ import (
 func runMultiModuleBump(
 	ctx context.Context,
 	cmd *cli.Command,
+	cfg *config.Config,
 	execCtx *clix.ExecutionContext,
 	bumpType operations.BumpType,
 	preRelease, metadata string,
 	preserveMetadata bool,
 ) error {
 	fs := core.NewOSFileSystem()
-	operation := operations.NewBumpOperation(fs, bumpType, preRelease, metadata, preserveMetadata)
+	pipeline := bumpPipeline(cfg, cmd)
+	operation := operations.NewBumpOperation(fs, bumpType, preRelease, metadata, preserveMetadata).WithHooks(pipeline)
+	return runMultiModuleOperation(ctx, cmd, execCtx, operation, pipeline, fmt.Sprintf("Bump %s", bumpType))
+}
 
+// runMultiModuleOperation executes an operation on multiple modules and prints
+// the results. Selected modules bring the other members of their group along.
+// Cascaded bumps run the plugin pipeline. If the pipeline is atomic, any
+// failure rolls back every module.
+func runMultiModuleOperation(
+	ctx context.Context,
+	cmd *cli.Command,
+	execCtx *clix.ExecutionContext,
+	operation workspace.Operation,
+	pipeline *modulePipeline,
+	title string,
+) error {
 	// Create executor with options from flags
 	parallel := cmd.Bool("parallel")
 	failFast := cmd.Bool("fail-fast") && !cmd.Bool("continue-on-error")
@@ -30,21 +52,52 @@ func runMultiModuleBump(
 	executor := workspace.NewExecutor(
 		workspace.WithParallel(parallel),
 		workspace.WithFailFast(failFast),
+		workspace.WithJobs(cmd.Int("jobs")),
+		workspace.WithGraph(execCtx.Graph),
 	)
 
+	// Gate every planned bump before the first version is written
+	modules := withGroupMembers(execCtx)
+	if previewer, ok := operation.(operations.BumpPreviewer); ok {
+		if err := gateBumps(execCtx, modules, previewer); err != nil {
+			return err
+		}
+	}
+
 	// Execute the operation on all modules
-	results, err := executor.Run(ctx, execCtx.Modules, operation)
+	results, err := executor.Run(ctx, modules, operation)
 	if err != nil && failFast {
 		// In fail-fast mode, we may have partial results
 		// Fall through to display what we have
 		_ = err
 	}
 
+	// Bump the dependents of bumped modules
+	var cascadeErr error
+	if execCtx.Graph != nil && execCtx.Cascade != "" && execCtx.Cascade != "none" {
+		if pipeline.tx != nil {
+			for _, mod := range execCtx.Graph.Modules() {
+				if cascadeErr = pipeline.tx.Snapshot(execCtx.Graph.ManifestPaths(mod.Name)...); cascadeErr != nil {
+					break
+				}
+			}
+		}
+		if cascadeErr == nil {
+			results, cascadeErr = operations.Cascade(ctx, core.NewOSFileSystem(), execCtx.Graph, execCtx.Groups, results, operations.BumpType(execCtx.Cascade), pipeline)
+		}
+	}
+
+	// Complete or roll back an atomic bump
+	var rollbackErr error
+	if pipeline.tx != nil {
+		rollbackErr = finishTransaction(pipeline, results, cascadeErr)
+	}
+
 	// Format and display results
 	format := cmd.String("format")
 	quiet := cmd.Bool("quiet")
 
-	formatter := workspace.GetFormatter(format, fmt.Sprintf("Bump %s", bumpType))
+	formatter := workspace.GetFormatter(format, title)
 
 	if quiet {
 		// In quiet mode, just show summary
@@ -53,6 +106,14 @@ func runMultiModuleBump(
 		fmt.Println(formatter.FormatResults(results))
 	}
 
+	if rollbackErr != nil {
+		return rollbackErr
+	}
+
+	if cascadeErr != nil {
+		return cascadeErr
+	}
+
 	// Return error if any failures occurred
 	if workspace.HasErrors(results) {
 		return fmt.Errorf("%d module(s) failed", workspace.ErrorCount(results))
@@ -61,6 +122,205 @@ func runMultiModuleBump(
 	return nil
 }
 
+// withGroupMembers returns the selected modules with the members of their
+// groups, so that groups stay in lockstep.
+func withGroupMembers(execCtx *clix.ExecutionContext) []*workspace.Module {
+	modules := workspace.ExpandGroups(execCtx.Modules, execCtx.Groups)
+	for _, g := range execCtx.Groups {
+		var added []string
+		for _, mod := range modules[len(execCtx.Modules):] {
+			if g.Has(mod.Name) {
+				added = append(added, mod.Name)
+			}
+		}
+		if len(added) > 0 {
+			fmt.Fprintf(os.Stderr, "Group %s: also bumping %s\n", g.Name, strings.Join(added, ", "))
+		}
+	}
+	return modules
+}
+
+// gateBumps validates the bumps the operation plans for the modules, and the
+// bumps they cascade to, against the release gate. Modules whose bump cannot
+// be previewed are left to the operation to report.
+func gateBumps(execCtx *clix.ExecutionContext, modules []*workspace.Module, previewer operations.BumpPreviewer) error {
+	var previews []*operations.BumpPreview
+	for _, mod := range modules {
+		if preview, err := previewer.PreviewBump(mod); err == nil && preview != nil {
+			previews = append(previews, preview)
+		}
+	}
+
+	if execCtx.Graph != nil && execCtx.Cascade != "" && execCtx.Cascade != "none" {
+		cascaded, err := operations.PreviewCascade(core.NewOSFileSystem(), execCtx.Graph, execCtx.Groups, previews, operations.BumpType(execCtx.Cascade))
+		if err != nil {
+			return err
+		}
+		previews = append(previews, cascaded...)
+	}
+
+	return checkReleaseGate(previews)
+}
+
+// finishTransaction creates the deferred tags of an atomic bump if every
+// module succeeded. Otherwise it restores the files of every module, marks the
+// results as rolled back and prints the rollback report to stderr.
+func finishTransaction(pipeline *modulePipeline, results []workspace.ExecutionResult, cascadeErr error) error {
+	failed := workspace.ErrorCount(results)
+	cause := cascadeErr
+	if cause == nil && failed == 0 {
+		if cause = pipeline.createPendingTags(); cause == nil {
+			return nil
+		}
+	}
+
+	report, err := pipeline.tx.Rollback()
+	workspace.RollBackResults(results)
+	fmt.Fprint(os.Stderr, report.String())
+	if err != nil {
+		return fmt.Errorf("rollback incomplete: %w", err)
+	}
+
+	if cause != nil {
+		return fmt.Errorf("%w; all changes rolled back", cause)
+	}
+	return fmt.Errorf("%d module(s) failed; all changes rolled back", failed)
+}
+
+// moduleAutoOperation bumps each module by the type inferred from the commits
+// touching its directory. Modules without such commits are left unchanged.
+// The members of a group are bumped together, by the highest type inferred
+// for any of them. With pending change files, the bump types come from the
+// change files instead of the commits.
+type moduleAutoOperation struct {
+	fs           core.FileSystem
+	since        string
+	until        string
+	metadata     string
+	preserveMeta bool
+	initialDev   bool
+	hooks        operations.BumpHooks
+	groups       []*workspace.Group
+	changes      []*changeset.Change
+
+	// Inferences are made once per module and group, and shared by the
+	// previews and the bumps, which may run in parallel.
+	mu          sync.Mutex
+	moduleBumps map[string]moduleInference
+	groupBumps  map[string]moduleInference
+}
+
+// moduleInference is the bump type inferred for a module or group, and
+// whether any commit touches it.
+type moduleInference struct {
+	label   string
+	changed bool
+}
+
+// inferenceLevels orders inferred bump types; an empty type bumps the least.
+var inferenceLevels = []string{"", "patch", "minor", "major"}
+
+// newModuleAutoOperation creates an auto bump with per-module inference. The
+// bump of each module runs hooks.
+func newModuleAutoOperation(fs core.FileSystem, since, until, metadata string, preserveMeta, initialDev bool, hooks operations.BumpHooks, groups []*workspace.Group) *moduleAutoOperation {
+	return &moduleAutoOperation{
+		fs:           fs,
+		since:        since,
+		until:        until,
+		metadata:     metadata,
+		preserveMeta: preserveMeta,
+		initialDev:   initialDev,
+		hooks:        hooks,
+		groups:       groups,
+		moduleBumps:  map[string]moduleInference{},
+		groupBumps:   map[string]moduleInference{},
+	}
+}
+
+// withChanges makes the operation infer bump types from change files.
+func (op *moduleAutoOperation) withChanges(changes []*changeset.Change) *moduleAutoOperation {
+	op.changes = changes
+	return op
+}
+
+// Execute infers the bump type of the module and bumps it.
+func (op *moduleAutoOperation) Execute(ctx context.Context, mod *workspace.Module) error {
+	inferred, changed := op.infer(mod)
+	if !changed {
+		fmt.Fprintf(os.Stderr, "%s: no changes, not bumped\n", mod.Name)
+		return nil
+	}
+	if inferred != "" {
+		fmt.Fprintf(os.Stderr, "%s: inferred bump type: %s\n", mod.Name, inferred)
+	}
+
+	return op.bump(inferred).Execute(ctx, mod)
+}
+
+// PreviewBump returns the bump inferred for the module without writing it,
+// or nil if the module is not bumped.
+func (op *moduleAutoOperation) PreviewBump(mod *workspace.Module) (*operations.BumpPreview, error) {
+	inferred, changed := op.infer(mod)
+	if !changed {
+		return nil, nil
+	}
+	return op.bump(inferred).PreviewBump(mod)
+}
+
+// bump returns the bump of a module by the inferred label. The initial
+// development policy applies to levels inferred from commits, not to the
+// explicit levels of change files.
+func (op *moduleAutoOperation) bump(inferred string) *operations.BumpOperation {
+	return operations.NewBumpOperation(op.fs, labelBumpType(inferred), "", op.metadata, op.preserveMeta).
+		WithInitialDevelopment(inferred != "" && op.initialDev && op.changes == nil).
+		WithHooks(op.hooks)
+}
+
+// infer returns the bump type of the module, or of its group if it has one.
+func (op *moduleAutoOperation) infer(mod *workspace.Module) (label string, changed bool) {
+	op.mu.Lock()
+	defer op.mu.Unlock()
+
+	g := workspace.GroupOf(op.groups, mod.Name)
+	if g == nil {
+		return op.inferModule(mod)
+	}
+
+	bump, ok := op.groupBumps[g.Name]
+	if !ok {
+		for _, member := range g.Modules {
+			label, changed := op.inferModule(member)
+			bump.changed = bump.changed || changed
+			if slices.Index(inferenceLevels, label) > slices.Index(inferenceLevels, bump.label) {
+				bump.label = label
+			}
+		}
+		op.groupBumps[g.Name] = bump
+	}
+	return bump.label, bump.changed
+}
+
+// inferModule returns the bump type of the module from the change files, or
+// from the commits touching its directory. The caller holds op.mu.
+func (op *moduleAutoOperation) inferModule(mod *workspace.Module) (label string, changed bool) {
+	bump, ok := op.moduleBumps[mod.Name]
+	if !ok {
+		if op.changes != nil {
+			bump.label = changeset.Level(op.changes, mod.Name)
+			bump.changed = bump.label != ""
+		} else {
+			bump.label, bump.changed = tryInferModuleBumpTypeFn(mod, op.since, op.until)
+		}
+		op.moduleBumps[mod.Name] = bump
+	}
+	return bump.label, bump.changed
+}
+
+// Name returns the name of this operation.
+func (op *moduleAutoOperation) Name() string {
+	return "bump auto"
+}
+
 // printQuietSummary prints a minimal summary of results.
 func printQuietSummary(results []workspace.ExecutionResult) {
 	success := workspace.SuccessCount(results)
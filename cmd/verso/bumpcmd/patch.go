@@ -46,7 +46,7 @@ func runBumpPatch(ctx context.Context, cmd *cli.Command, cfg *config.Config) err
 	}
 
 	if !execCtx.IsSingleModule() {
-		return runMultiModuleBump(ctx, cmd, execCtx, operations.BumpPatch, pre, meta, isPreserveMeta)
+		return runMultiModuleBump(ctx, cmd, cfg, execCtx, operations.BumpPatch, pre, meta, isPreserveMeta)
 	}
 
 	return runSingleModulePatchBump(ctx, cmd, cfg, execCtx, pre, meta, isPreserveMeta, isSkipHooks)
@@ -58,14 +58,22 @@ func runSingleModulePatchBump(ctx context.Context, cmd *cli.Command, cfg *config
 		return err
 	}
 
+	release, err := lockVersionFile(execCtx.Path)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	previousVersion, err := semver.ReadVersion(execCtx.Path)
 	if err != nil {
 		return err
 	}
 
 	// Calculate new version
-	newVersion := previousVersion
-	newVersion.Patch++
+	newVersion, err := semver.BumpByLabelFunc(previousVersion, "patch")
+	if err != nil {
+		return err
+	}
 	newVersion.PreRelease = pre
 	newVersion.Build = calculateNewBuild(meta, isPreserveMeta, previousVersion.Build)
 
@@ -98,7 +106,7 @@ func runSingleModulePatchBump(ctx context.Context, cmd *cli.Command, cfg *config
 	}
 
 	// Sync dependency files after updating .version
-	if err := syncDependencies(newVersion); err != nil {
+	if err := syncDependencies(execCtx.Path, newVersion); err != nil {
 		return err
 	}
 
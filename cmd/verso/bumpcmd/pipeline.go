@@ -0,0 +1,353 @@
+package bumpcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/indaco/verso/internal/changeset"
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/extensionmgr"
+	"github.com/indaco/verso/internal/operations"
+	"github.com/indaco/verso/internal/plugins/auditlog"
+	"github.com/indaco/verso/internal/plugins/changeloggenerator"
+	"github.com/indaco/verso/internal/plugins/dependencycheck"
+	"github.com/indaco/verso/internal/plugins/tagmanager"
+	"github.com/indaco/verso/internal/semver"
+	"github.com/indaco/verso/internal/workspace"
+	"github.com/urfave/cli/v3"
+)
+
+// modulePipeline runs the plugin lifecycle of single-module bumps for every
+// module of a multi-module bump. Messages go to stderr, prefixed with the
+// module name, so they do not mix with the formatted results.
+type modulePipeline struct {
+	cfg       *config.Config
+	skipHooks bool
+
+	// Modules may run in parallel, but plugins share the changelog, the
+	// audit log and the git repository.
+	mu sync.Mutex
+
+	// tx records the files of each module before they change, if the bump
+	// is atomic. Tags are then created once every module succeeded.
+	tx          *workspace.Transaction
+	pendingTags []pendingTag
+
+	// changes are the pending change files of the bump: their summaries go
+	// to the module changelogs. released lists the modules bumped so far.
+	changes  []*changeset.Change
+	released []string
+}
+
+// pendingTag is a module tag deferred until an atomic bump succeeds.
+type pendingTag struct {
+	module   string
+	version  semver.SemVersion
+	bumpType string
+}
+
+// Ensure modulePipeline implements operations.BumpHooks.
+var _ operations.BumpHooks = (*modulePipeline)(nil)
+
+// newModulePipeline creates the plugin pipeline of a multi-module bump. The
+// bump is atomic if tx is not nil.
+func newModulePipeline(cfg *config.Config, skipHooks bool, tx *workspace.Transaction) *modulePipeline {
+	return &modulePipeline{cfg: cfg, skipHooks: skipHooks, tx: tx}
+}
+
+// bumpPipeline creates the plugin pipeline of a multi-module bump from the
+// command flags.
+func bumpPipeline(cfg *config.Config, cmd *cli.Command) *modulePipeline {
+	var tx *workspace.Transaction
+	if cmd.Bool("atomic") {
+		tx = workspace.NewTransaction(core.NewOSFileSystem())
+	}
+	return newModulePipeline(cfg, cmd.Bool("skip-hooks"), tx)
+}
+
+// PreBump validates the module bump: version policy, dependency consistency
+// and tag availability, then runs pre-bump hooks. The release gate checks
+// every bump beforehand (see checkReleaseGate).
+func (p *modulePipeline) PreBump(ctx context.Context, mod *workspace.Module, bumpType operations.BumpType, previous, next semver.SemVersion) error {
+	label := string(bumpType)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.tx != nil {
+		if err := p.tx.Snapshot(moduleFiles(mod, next)...); err != nil {
+			return err
+		}
+	}
+
+	if err := validateVersionPolicy(next, previous, label); err != nil {
+		return err
+	}
+
+	// Module files must match the version being bumped
+	if dc := moduleDependencyChecker(mod); dc != nil {
+		if err := checkDependencyConsistency(dc, previous); err != nil {
+			return err
+		}
+	}
+
+	if err := validateModuleTagAvailable(mod.Name, next); err != nil {
+		return err
+	}
+
+	if p.skipHooks {
+		return nil
+	}
+	return extensionmgr.RunModulePreBumpHooks(ctx, p.cfg, mod.Name, moduleRelDir(mod), next.String(), previous.String(), label)
+}
+
+// checkReleaseGate validates each previewed bump against the release gate.
+// The gate checks the repository, which the first bump changes, so it runs
+// before any version is written.
+func checkReleaseGate(previews []*operations.BumpPreview) error {
+	for _, preview := range previews {
+		if err := validateReleaseGate(preview.Next, preview.Previous, string(preview.BumpType)); err != nil {
+			return fmt.Errorf("%s: %w", preview.Module.Name, err)
+		}
+	}
+	return nil
+}
+
+// PostBump syncs dependency files, generates the module changelog, records
+// the audit log entry, runs post-bump hooks and creates the module tag.
+func (p *modulePipeline) PostBump(ctx context.Context, mod *workspace.Module, bumpType operations.BumpType, previous, next semver.SemVersion) error {
+	label := string(bumpType)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := syncModuleDependencies(mod, next); err != nil {
+		return err
+	}
+
+	if err := generateModuleChangelog(mod, next, previous, label, changeset.Summaries(p.changes, mod.Name)); err != nil {
+		return err
+	}
+
+	if err := recordModuleAuditLogEntry(mod, next, previous, label); err != nil {
+		return err
+	}
+
+	if !p.skipHooks {
+		prereleasePtr, metadataPtr := extractVersionPointers(next)
+		if err := extensionmgr.RunModulePostBumpHooks(ctx, p.cfg, mod.Name, moduleRelDir(mod), next.String(), previous.String(), label, prereleasePtr, metadataPtr); err != nil {
+			return err
+		}
+	}
+
+	p.released = append(p.released, mod.Name)
+
+	if p.tx != nil {
+		p.pendingTags = append(p.pendingTags, pendingTag{module: mod.Name, version: next, bumpType: label})
+		return nil
+	}
+	_, err := createModuleTag(mod.Name, next, label)
+	return err
+}
+
+// createPendingTags creates the tags deferred by an atomic bump. If the tag
+// manager pushes tags, every tag is created locally first and they are pushed
+// together once all of them exist. If a tag cannot be created or the push
+// fails, the tags created are deleted.
+func (p *modulePipeline) createPendingTags() error {
+	tm, ok := moduleTagManager()
+	if !ok {
+		p.pendingTags = nil
+		return nil
+	}
+	pusher, push := tm.(tagmanager.TagPusher)
+	push = push && pusher.PushesTags()
+
+	var created []string
+	rollback := func(err error) error {
+		for _, name := range created {
+			_ = tagmanager.DeleteTag(name)
+		}
+		return err
+	}
+
+	for _, tag := range p.pendingTags {
+		message := moduleTagMessage(tag.module, tag.version, tag.bumpType)
+		create := tm.CreateModuleTag
+		if push {
+			create = pusher.CreateLocalModuleTag
+		}
+		if err := create(tag.module, tag.version, message); err != nil {
+			return rollback(fmt.Errorf("failed to create tag: %w", err))
+		}
+		name := tm.FormatModuleTagName(tag.module, tag.version)
+		created = append(created, name)
+		fmt.Fprintf(os.Stderr, "%s: created tag %s\n", tag.module, name)
+	}
+
+	if push && len(created) > 0 {
+		if err := pusher.PushTags(created...); err != nil {
+			return rollback(fmt.Errorf("failed to push tags: %w", err))
+		}
+		fmt.Fprintf(os.Stderr, "Pushed %d tag(s)\n", len(created))
+	}
+
+	p.pendingTags = nil
+	return nil
+}
+
+// moduleFiles returns the files the bump of the module to version may
+// change: its version file, synced dependency files, changelogs and the
+// audit log.
+func moduleFiles(mod *workspace.Module, version semver.SemVersion) []string {
+	files := []string{mod.Path}
+
+	if dc := moduleDependencyChecker(mod); dc != nil && dc.GetConfig().AutoSync {
+		for _, file := range dc.GetConfig().Files {
+			files = append(files, file.Path)
+		}
+	}
+
+	if plugin, ok := changeloggenerator.GetChangelogGeneratorFn().(*changeloggenerator.ChangelogGeneratorPlugin); ok && plugin.IsEnabled() {
+		cfg := plugin.GetConfig()
+		files = append(files,
+			filepath.Join(mod.Dir, cfg.ChangelogPath),
+			filepath.Join(mod.Dir, cfg.ChangesDir, "v"+version.String()+".md"),
+		)
+	}
+
+	if plugin, ok := auditlog.GetAuditLogFn().(*auditlog.AuditLogPlugin); ok && plugin.IsEnabled() {
+		files = append(files, plugin.GetConfig().GetPath())
+	}
+
+	return files
+}
+
+// moduleRelDir returns the module directory relative to the workspace root.
+func moduleRelDir(mod *workspace.Module) string {
+	return filepath.Dir(mod.RelPath)
+}
+
+// moduleDependencyChecker returns the dependency checker restricted to the
+// files in the module directory, or nil if it is not enabled.
+func moduleDependencyChecker(mod *workspace.Module) *dependencycheck.DependencyCheckerPlugin {
+	plugin, ok := dependencycheck.GetDependencyCheckerFn().(*dependencycheck.DependencyCheckerPlugin)
+	if !ok || !plugin.IsEnabled() {
+		return nil
+	}
+	return plugin.ForDir(moduleRelDir(mod))
+}
+
+// syncModuleDependencies updates the dependency files of the module to its
+// new version if auto-sync is enabled, under the lock of its version file.
+func syncModuleDependencies(mod *workspace.Module, version semver.SemVersion) error {
+	dc := moduleDependencyChecker(mod)
+	if dc == nil || !dc.GetConfig().AutoSync || len(dc.GetConfig().Files) == 0 {
+		return nil
+	}
+
+	release, err := lockVersionFile(mod.Path)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if err := dc.SyncVersions(version.String()); err != nil {
+		return fmt.Errorf("failed to sync dependency versions: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "%s: synced version to %d dependency file(s)\n", mod.Name, len(dc.GetConfig().Files))
+	return nil
+}
+
+// validateModuleTagAvailable checks if a tag can be created for the module version.
+func validateModuleTagAvailable(module string, version semver.SemVersion) error {
+	tm, ok := moduleTagManager()
+	if !ok {
+		return nil
+	}
+	return tm.ValidateModuleTagAvailable(module, version)
+}
+
+// createModuleTag creates the git tag of the module version if tag manager
+// is enabled, and returns its name.
+func createModuleTag(module string, version semver.SemVersion, bumpType string) (string, error) {
+	tm, ok := moduleTagManager()
+	if !ok {
+		return "", nil
+	}
+
+	if err := tm.CreateModuleTag(module, version, moduleTagMessage(module, version, bumpType)); err != nil {
+		return "", fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	name := tm.FormatModuleTagName(module, version)
+	fmt.Fprintf(os.Stderr, "%s: created tag %s\n", module, name)
+	return name, nil
+}
+
+// moduleTagMessage returns the message of the module release tag.
+func moduleTagMessage(module string, version semver.SemVersion, bumpType string) string {
+	return fmt.Sprintf("Release %s %s (%s bump)", module, version.String(), bumpType)
+}
+
+// moduleTagManager returns the tag manager if it is enabled and tags modules.
+func moduleTagManager() (tagmanager.ModuleTagManager, bool) {
+	tm := tagmanager.GetTagManagerFn()
+	if plugin, ok := tm.(*tagmanager.TagManagerPlugin); ok && !plugin.IsEnabled() {
+		return nil, false
+	}
+	mtm, ok := tm.(tagmanager.ModuleTagManager)
+	return mtm, ok
+}
+
+// generateModuleChangelog generates the module changelog from the commits
+// touching its directory since its last tag, and from release notes.
+func generateModuleChangelog(mod *workspace.Module, version, previousVersion semver.SemVersion, bumpType string, notes []string) error {
+	cg := changeloggenerator.GetChangelogGeneratorFn()
+	plugin, ok := cg.(*changeloggenerator.ChangelogGeneratorPlugin)
+	if !ok || !plugin.IsEnabled() {
+		return nil
+	}
+
+	versionStr := "v" + version.String()
+	if err := plugin.WithNotes(notes).GenerateForModule(mod.Dir, versionStr, modulePreviousTag(mod), bumpType); err != nil {
+		return fmt.Errorf("failed to generate changelog: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "%s: generated changelog for %s\n", mod.Name, versionStr)
+	return nil
+}
+
+// modulePreviousTag returns the last tag of the module, or of the repository
+// if the module has none, or an empty string if there are no tags.
+func modulePreviousTag(mod *workspace.Module) string {
+	prevTag, err := changeloggenerator.GetLatestModuleTagFn(mod.Name)
+	if err != nil {
+		// Modules without tags start from the last repository tag
+		if prevTag, err = changeloggenerator.GetLatestTagFn(); err != nil {
+			prevTag = ""
+		}
+	}
+	return prevTag
+}
+
+// recordModuleAuditLogEntry records the module bump to the audit log if enabled.
+func recordModuleAuditLogEntry(mod *workspace.Module, version, previousVersion semver.SemVersion, bumpType string) error {
+	al := auditlog.GetAuditLogFn()
+	plugin, ok := al.(*auditlog.AuditLogPlugin)
+	if !ok || !plugin.IsEnabled() {
+		return nil
+	}
+
+	return al.RecordEntry(&auditlog.Entry{
+		Module:          mod.Name,
+		PreviousVersion: previousVersion.String(),
+		NewVersion:      version.String(),
+		BumpType:        bumpType,
+	})
+}
@@ -0,0 +1,370 @@
+package bumpcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/indaco/verso/internal/changeset"
+	"github.com/indaco/verso/internal/clix"
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/operations"
+	"github.com/indaco/verso/internal/plugins/changeloggenerator"
+	"github.com/indaco/verso/internal/plugins/dependencycheck"
+	"github.com/indaco/verso/internal/plugins/tagmanager"
+	"github.com/indaco/verso/internal/semver"
+	"github.com/indaco/verso/internal/workspace"
+	"github.com/urfave/cli/v3"
+)
+
+// sourceCascade is the source of the bumps of modules depending on bumped
+// modules.
+const sourceCascade = "cascade"
+
+// PlanCmd returns the "plan" command. It lives with the bump commands to
+// preview "bump auto" with the same inference.
+func PlanCmd(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "plan",
+		Usage: "Preview the version changes of the next release",
+		UsageText: `verso plan [--since ref] [--until ref] [--module name] [--format text|table|json]
+
+Runs the inference of "verso bump auto" (change files, changelog, commits) for every module,
+without writing anything. Each module is listed with its next version, the reason, the tags
+to create, the dependency files to sync and its changelog entry.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "since",
+				Usage: "Start commit/tag for bump inference (default: last tag of each module)",
+			},
+			&cli.StringFlag{
+				Name:  "until",
+				Usage: "End commit/tag for bump inference (default: HEAD)",
+			},
+			&cli.StringFlag{
+				Name:    "module",
+				Aliases: []string{"m"},
+				Usage:   "Plan a specific module by name",
+			},
+			&cli.StringFlag{
+				Name:  "format",
+				Usage: "Output format: text, json, table",
+				Value: "text",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runPlan(ctx, cmd, cfg)
+		},
+	}
+}
+
+// runPlan prints the release plan of the workspace, or of the single module.
+func runPlan(ctx context.Context, cmd *cli.Command, cfg *config.Config) error {
+	since := cmd.String("since")
+	until := cmd.String("until")
+	disableReason := inferenceDisabledReason(cfg, false)
+	initialDev := cfg == nil || cfg.InitialDev.GetEnabled()
+
+	fs := core.NewOSFileSystem()
+	var changes []*changeset.Change
+	if changesEnabled(false) {
+		var err error
+		if changes, err = changeset.Load(fs, changeset.DefaultDir); err != nil {
+			return err
+		}
+	}
+
+	execCtx, err := clix.GetWorkspaceContext(ctx, cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	var entries []workspace.PlanEntry
+	if execCtx.IsSingleModule() {
+		entries = []workspace.PlanEntry{planSingleModule(execCtx.Path, since, until, disableReason, changes, initialDev)}
+	} else {
+		entries = planModules(fs, execCtx, since, until, disableReason, changes, initialDev)
+	}
+
+	fmt.Println(workspace.GetFormatter(cmd.String("format"), "Release plan").FormatPlan(entries))
+
+	failed := 0
+	for _, entry := range entries {
+		if entry.Error != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d module(s) could not be planned", failed)
+	}
+	return nil
+}
+
+// planSingleModule plans the bump of the version file at path, as bump auto
+// would infer it.
+func planSingleModule(path, since, until, disableReason string, changes []*changeset.Change, initialDev bool) workspace.PlanEntry {
+	name := path
+	if cwd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(cwd, path); err == nil {
+			name = rel
+		}
+	}
+	entry := workspace.PlanEntry{Module: &workspace.Module{Name: name, Path: path, Dir: "."}}
+
+	e := explainInference("", disableReason, since, until, changes)
+	if err := explainNextVersion(e, path, initialDev, false); err != nil {
+		entry.Error = err
+		return entry
+	}
+	entry.CurrentVersion, entry.NextVersion = e.Current, e.Next
+	entry.BumpType, entry.Source, entry.Reason, entry.Note = e.BumpType, e.Source, e.Reason, e.Note
+
+	next, err := semver.ParseVersion(e.Next)
+	if err != nil {
+		entry.Error = err
+		return entry
+	}
+
+	if tm := tagmanager.GetTagManagerFn(); tm != nil {
+		if plugin, ok := tm.(*tagmanager.TagManagerPlugin); !ok || plugin.IsEnabled() {
+			entry.Tags = []string{tm.FormatTagName(next)}
+		}
+	}
+	if plugin, ok := dependencycheck.GetDependencyCheckerFn().(*dependencycheck.DependencyCheckerPlugin); ok && plugin.IsEnabled() {
+		entry.Files = syncedFiles(plugin)
+	}
+	if plugin, ok := changeloggenerator.GetChangelogGeneratorFn().(*changeloggenerator.ChangelogGeneratorPlugin); ok && plugin.IsEnabled() {
+		prevTag, err := changeloggenerator.GetLatestTagFn()
+		if err != nil {
+			prevTag = ""
+		}
+		if entry.Changelog, err = plugin.WithNotes(changeset.Summaries(changes, "")).PreviewForVersion("v"+next.String(), prevTag); err != nil {
+			entry.Error = fmt.Errorf("failed to preview changelog: %w", err)
+		}
+	}
+	return entry
+}
+
+// planModules plans the bump of each selected module, with the members of
+// their groups, as bump auto would infer it: from the change files, from a
+// changelog taking precedence, or from the commits touching each module.
+// Dependents of bumped modules follow the configured cascade.
+func planModules(fs core.FileSystem, execCtx *clix.ExecutionContext, since, until, disableReason string, changes []*changeset.Change, initialDev bool) []workspace.PlanEntry {
+	op := newModuleAutoOperation(fs, since, until, "", false, initialDev, nil, execCtx.Groups)
+	changelogLevel := ""
+	switch {
+	case len(changes) > 0:
+		op.withChanges(changes)
+	case disableReason == "":
+		changelogLevel = tryInferBumpTypeFromChangelogParserPluginFn()
+	}
+
+	modules := workspace.ExpandGroups(execCtx.Modules, execCtx.Groups)
+	entries := make([]workspace.PlanEntry, 0, len(modules))
+	for _, mod := range modules {
+		entry := workspace.PlanEntry{Module: mod}
+		current, err := semver.ReadVersion(mod.Path)
+		if err != nil {
+			entry.Error = fmt.Errorf("failed to read version: %w", err)
+			entries = append(entries, entry)
+			continue
+		}
+		entry.CurrentVersion = current.String()
+
+		var label string
+		var changed, initial bool
+		switch {
+		case len(changes) > 0:
+			label, changed = op.infer(mod)
+			entry.Source = sourceChanges
+		case disableReason != "":
+			changed = true
+			entry.Source = sourceDefault
+		case changelogLevel != "":
+			label, changed = changelogLevel, true
+			entry.Source, initial = sourceChangelog, true
+		default:
+			label, changed = op.infer(mod)
+			entry.Source, initial = sourceCommits, label != ""
+		}
+		entry.Reason = planReason(mod, execCtx.Groups, entry.Source, label, changed, since, disableReason)
+		if changed {
+			planBump(fs, &entry, current, label, initial && initialDev)
+		}
+		entries = append(entries, entry)
+	}
+
+	entries = planCascade(fs, execCtx, entries)
+
+	for i := range entries {
+		if entries[i].Bumped() {
+			planModuleRelease(&entries[i], changes)
+		}
+	}
+	return entries
+}
+
+// planBump sets the bump of entry from current by label. An empty label
+// promotes a pre-release or bumps patch.
+func planBump(fs core.FileSystem, entry *workspace.PlanEntry, current semver.SemVersion, label string, initialDev bool) {
+	next, note, err := moduleNext(fs, current, label, initialDev, false)
+	if err != nil {
+		entry.Error = err
+		return
+	}
+	entry.NextVersion, entry.BumpType = next.String(), string(labelBumpType(label))
+	if note != "" {
+		entry.Note = note
+	}
+}
+
+// planReason explains the planned bump of the module, or why it is not
+// bumped.
+func planReason(mod *workspace.Module, groups []*workspace.Group, source, label string, changed bool, since, disableReason string) string {
+	subject := mod.Name
+	if g := workspace.GroupOf(groups, mod.Name); g != nil {
+		subject = "group " + g.Name
+	}
+
+	switch source {
+	case sourceChanges:
+		if !changed {
+			return fmt.Sprintf("no change file bumps %s", subject)
+		}
+		return fmt.Sprintf("change files bump %s by %s", subject, label)
+	case sourceDefault:
+		return disableReason + "; a pre-release is promoted, otherwise patch is bumped"
+	case sourceChangelog:
+		return fmt.Sprintf("the changelog Unreleased section implies %s for every module", label)
+	}
+
+	rng := "since its last tag"
+	if since != "" {
+		rng = "since " + since
+	}
+	switch {
+	case !changed:
+		return fmt.Sprintf("no commits touching %s %s imply a bump", subject, rng)
+	case label == "":
+		return fmt.Sprintf("commits touching %s %s imply no bump; a pre-release is promoted, otherwise patch is bumped", subject, rng)
+	default:
+		return fmt.Sprintf("commits touching %s %s imply %s", subject, rng, label)
+	}
+}
+
+// planCascade plans the cascaded bumps of the modules depending on bumped
+// modules and of the other members of their groups, adding the modules that
+// are not planned yet.
+func planCascade(fs core.FileSystem, execCtx *clix.ExecutionContext, entries []workspace.PlanEntry) []workspace.PlanEntry {
+	level := operations.BumpType(execCtx.Cascade)
+	if execCtx.Graph == nil || labelBumpType(execCtx.Cascade) != level {
+		return entries // no cascade, or "none"
+	}
+
+	index := map[string]int{}
+	bumped := map[string]operations.BumpType{}
+	for i, entry := range entries {
+		index[entry.Module.Name] = i
+		if entry.Bumped() {
+			bumped[entry.Module.Name] = operations.ChangeLevel(entry.CurrentVersion, entry.NextVersion)
+		}
+	}
+	targets, err := operations.CascadeTargets(execCtx.Graph, execCtx.Groups, bumped, level)
+	if err != nil {
+		return entries
+	}
+	order, err := execCtx.Graph.Order()
+	if err != nil {
+		return entries
+	}
+
+	for _, mod := range order {
+		targetLevel, ok := targets[mod.Name]
+		if !ok {
+			continue
+		}
+
+		i, ok := index[mod.Name]
+		if !ok {
+			current, err := semver.ReadVersion(mod.Path)
+			if err != nil {
+				continue
+			}
+			entries = append(entries, workspace.PlanEntry{Module: mod, CurrentVersion: current.String()})
+			i = len(entries) - 1
+			index[mod.Name] = i
+		}
+
+		entry := &entries[i]
+		if entry.Error != nil {
+			continue
+		}
+		current, err := semver.ParseVersion(entry.CurrentVersion)
+		if err != nil {
+			continue
+		}
+		entry.Source, entry.Note = sourceCascade, ""
+		entry.Reason = cascadeReason(execCtx, mod, bumped, targets)
+		planBump(fs, entry, current, string(targetLevel), false)
+	}
+	return entries
+}
+
+// cascadeReason explains the cascaded bump of the module: it depends on a
+// bumped module, or it is in the group of one that does.
+func cascadeReason(execCtx *clix.ExecutionContext, mod *workspace.Module, bumped, targets map[string]operations.BumpType) string {
+	var deps []string
+	for _, dep := range execCtx.Graph.DependsOn(mod.Name) {
+		_, isBumped := bumped[dep]
+		_, isTarget := targets[dep]
+		if isBumped || isTarget {
+			deps = append(deps, dep)
+		}
+	}
+	if len(deps) > 0 {
+		return fmt.Sprintf("depends on %s, bumped in this release", strings.Join(deps, ", "))
+	}
+	if g := workspace.GroupOf(execCtx.Groups, mod.Name); g != nil {
+		return fmt.Sprintf("group %s is bumped by the cascade", g.Name)
+	}
+	return "bumped by the cascade"
+}
+
+// planModuleRelease fills in the tags, synced dependency files and changelog
+// entry of the module release.
+func planModuleRelease(entry *workspace.PlanEntry, changes []*changeset.Change) {
+	mod := entry.Module
+	next, err := semver.ParseVersion(entry.NextVersion)
+	if err != nil {
+		entry.Error = err
+		return
+	}
+
+	if tm, ok := moduleTagManager(); ok {
+		entry.Tags = []string{tm.FormatModuleTagName(mod.Name, next)}
+	}
+	if dc := moduleDependencyChecker(mod); dc != nil {
+		entry.Files = syncedFiles(dc)
+	}
+	if plugin, ok := changeloggenerator.GetChangelogGeneratorFn().(*changeloggenerator.ChangelogGeneratorPlugin); ok && plugin.IsEnabled() {
+		if entry.Changelog, err = plugin.WithNotes(changeset.Summaries(changes, mod.Name)).PreviewForModule(mod.Dir, "v"+next.String(), modulePreviousTag(mod)); err != nil {
+			entry.Error = fmt.Errorf("failed to preview changelog: %w", err)
+		}
+	}
+}
+
+// syncedFiles returns the dependency files dc syncs on release, if auto-sync
+// is enabled.
+func syncedFiles(dc *dependencycheck.DependencyCheckerPlugin) []string {
+	if !dc.GetConfig().AutoSync {
+		return nil
+	}
+	files := make([]string, 0, len(dc.GetConfig().Files))
+	for _, file := range dc.GetConfig().Files {
+		files = append(files, file.Path)
+	}
+	return files
+}
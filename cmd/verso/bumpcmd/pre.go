@@ -16,13 +16,17 @@ func preCmd(cfg *config.Config) *cli.Command {
 	return &cli.Command{
 		Name:      "pre",
 		Usage:     "Increment pre-release version (e.g., rc.1 -> rc.2)",
-		UsageText: "verso bump pre [--label name] [--meta data] [--preserve-meta] [--skip-hooks]",
+		UsageText: "verso bump pre [--label name | --next-channel] [--meta data] [--preserve-meta] [--skip-hooks]",
 		Flags: []cli.Flag{
 			&cli.StringFlag{
 				Name:    "label",
 				Aliases: []string{"l"},
 				Usage:   "Pre-release label (e.g., alpha, beta, rc). If omitted, increments existing pre-release",
 			},
+			&cli.BoolFlag{
+				Name:  "next-channel",
+				Usage: "Advance to the next pre-release channel (e.g., alpha.3 -> beta.1)",
+			},
 			&cli.BoolFlag{
 				Name:  "skip-hooks",
 				Usage: "Skip pre-release hooks",
@@ -40,6 +44,11 @@ func runBumpPre(ctx context.Context, cmd *cli.Command, cfg *config.Config) error
 	meta := cmd.String("meta")
 	isPreserveMeta := cmd.Bool("preserve-meta")
 	isSkipHooks := cmd.Bool("skip-hooks")
+	isNextChannel := cmd.Bool("next-channel")
+
+	if isNextChannel && label != "" {
+		return fmt.Errorf("--next-channel cannot be combined with --label")
+	}
 
 	if err := hooks.RunPreReleaseHooksFn(isSkipHooks); err != nil {
 		return err
@@ -54,15 +63,21 @@ func runBumpPre(ctx context.Context, cmd *cli.Command, cfg *config.Config) error
 		return fmt.Errorf("pre-release bump not yet supported for multi-module mode")
 	}
 
-	return runSingleModulePreBump(ctx, cmd, cfg, execCtx, label, meta, isPreserveMeta, isSkipHooks)
+	return runSingleModulePreBump(ctx, cmd, cfg, execCtx, label, meta, isPreserveMeta, isSkipHooks, isNextChannel)
 }
 
 // runSingleModulePreBump handles pre-release bump for single-module mode.
-func runSingleModulePreBump(ctx context.Context, cmd *cli.Command, cfg *config.Config, execCtx *clix.ExecutionContext, label, meta string, isPreserveMeta, isSkipHooks bool) error {
+func runSingleModulePreBump(ctx context.Context, cmd *cli.Command, cfg *config.Config, execCtx *clix.ExecutionContext, label, meta string, isPreserveMeta, isSkipHooks, isNextChannel bool) error {
 	if _, err := clix.FromCommandFn(cmd); err != nil {
 		return err
 	}
 
+	release, err := lockVersionFile(execCtx.Path)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	previousVersion, err := semver.ReadVersion(execCtx.Path)
 	if err != nil {
 		return err
@@ -70,7 +85,13 @@ func runSingleModulePreBump(ctx context.Context, cmd *cli.Command, cfg *config.C
 
 	// Calculate new version
 	newVersion := previousVersion
-	if label != "" {
+	if isNextChannel {
+		next, err := semver.NextChannel(previousVersion.PreRelease, cfg.PreRelease.GetChannels())
+		if err != nil {
+			return err
+		}
+		newVersion.PreRelease = next
+	} else if label != "" {
 		newVersion.PreRelease = semver.IncrementPreRelease(previousVersion.PreRelease, label)
 	} else {
 		if previousVersion.PreRelease == "" {
@@ -105,12 +126,12 @@ func runSingleModulePreBump(ctx context.Context, cmd *cli.Command, cfg *config.C
 		return err
 	}
 
-	if err := semver.UpdatePreRelease(execCtx.Path, label, meta, isPreserveMeta); err != nil {
+	if err := semver.SaveVersion(execCtx.Path, newVersion); err != nil {
 		return err
 	}
 
 	// Sync dependency files after updating .version
-	if err := syncDependencies(newVersion); err != nil {
+	if err := syncDependencies(execCtx.Path, newVersion); err != nil {
 		return err
 	}
 
@@ -58,7 +58,7 @@ func runBumpRelease(ctx context.Context, cmd *cli.Command, cfg *config.Config) e
 		// The BumpOperation will handle preserve-meta correctly
 		meta = ""
 	}
-	return runMultiModuleBump(ctx, cmd, execCtx, operations.BumpRelease, "", meta, isPreserveMeta)
+	return runMultiModuleBump(ctx, cmd, cfg, execCtx, operations.BumpRelease, "", meta, isPreserveMeta)
 }
 
 // runSingleModuleRelease handles the single-module release operation.
@@ -67,6 +67,12 @@ func runSingleModuleRelease(cmd *cli.Command, path string, isPreserveMeta bool)
 		return err
 	}
 
+	release, err := lockVersionFile(path)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	previousVersion, err := semver.ReadVersion(path)
 	if err != nil {
 		return fmt.Errorf("failed to read version: %w", err)
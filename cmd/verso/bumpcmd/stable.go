@@ -0,0 +1,129 @@
+package bumpcmd
+
+import (
+	"context"
+
+	"github.com/indaco/verso/internal/clix"
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/hooks"
+	"github.com/indaco/verso/internal/operations"
+	"github.com/indaco/verso/internal/semver"
+	"github.com/urfave/cli/v3"
+)
+
+// stableCmd returns the "stable" subcommand.
+func stableCmd(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "stable",
+		Usage:     "Graduate from initial development to 1.0.0 (e.g. 0.9.3 -> 1.0.0)",
+		UsageText: "verso bump stable [--pre label] [--meta data] [--preserve-meta] [--skip-hooks] [--all] [--module name]",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "skip-hooks",
+				Usage: "Skip pre-release hooks",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runBumpStable(ctx, cmd, cfg)
+		},
+	}
+}
+
+// runBumpStable graduates a 0.x version to 1.0.0.
+func runBumpStable(ctx context.Context, cmd *cli.Command, cfg *config.Config) error {
+	pre := cmd.String("pre")
+	meta := cmd.String("meta")
+	isPreserveMeta := cmd.Bool("preserve-meta")
+	isSkipHooks := cmd.Bool("skip-hooks")
+
+	if err := hooks.RunPreReleaseHooksFn(isSkipHooks); err != nil {
+		return err
+	}
+
+	execCtx, err := clix.GetExecutionContext(ctx, cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	if !execCtx.IsSingleModule() {
+		return runMultiModuleBump(ctx, cmd, cfg, execCtx, operations.BumpStable, pre, meta, isPreserveMeta)
+	}
+
+	return runSingleModuleStableBump(ctx, cmd, cfg, execCtx, pre, meta, isPreserveMeta, isSkipHooks)
+}
+
+// runSingleModuleStableBump handles the stable bump for single-module mode.
+func runSingleModuleStableBump(ctx context.Context, cmd *cli.Command, cfg *config.Config, execCtx *clix.ExecutionContext, pre, meta string, isPreserveMeta, isSkipHooks bool) error {
+	if _, err := clix.FromCommandFn(cmd); err != nil {
+		return err
+	}
+
+	release, err := lockVersionFile(execCtx.Path)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	previousVersion, err := semver.ReadVersion(execCtx.Path)
+	if err != nil {
+		return err
+	}
+
+	// Calculate new version
+	newVersion, err := semver.BumpStable(previousVersion)
+	if err != nil {
+		return err
+	}
+	newVersion.PreRelease = pre
+	newVersion.Build = calculateNewBuild(meta, isPreserveMeta, previousVersion.Build)
+
+	// Validate release gates before bumping
+	if err := validateReleaseGate(newVersion, previousVersion, "stable"); err != nil {
+		return err
+	}
+
+	// Validate version policy before bumping
+	if err := validateVersionPolicy(newVersion, previousVersion, "stable"); err != nil {
+		return err
+	}
+
+	// Validate dependency consistency before bumping
+	if err := validateDependencyConsistency(newVersion); err != nil {
+		return err
+	}
+
+	// Validate tag availability before bumping
+	if err := validateTagAvailable(newVersion); err != nil {
+		return err
+	}
+
+	if err := runPreBumpExtensionHooks(ctx, cfg, newVersion.String(), previousVersion.String(), "stable", isSkipHooks); err != nil {
+		return err
+	}
+
+	if err := semver.SaveVersion(execCtx.Path, newVersion); err != nil {
+		return err
+	}
+
+	// Sync dependency files after updating .version
+	if err := syncDependencies(execCtx.Path, newVersion); err != nil {
+		return err
+	}
+
+	// Generate changelog entry
+	if err := generateChangelogAfterBump(newVersion, previousVersion, "stable"); err != nil {
+		return err
+	}
+
+	// Record audit log entry
+	if err := recordAuditLogEntry(newVersion, previousVersion, "stable"); err != nil {
+		return err
+	}
+
+	if err := runPostBumpExtensionHooks(ctx, cfg, execCtx.Path, previousVersion.String(), "stable", isSkipHooks); err != nil {
+		return err
+	}
+
+	// Create tag after successful bump
+	return createTagAfterBump(newVersion, "stable")
+}
@@ -0,0 +1,187 @@
+package changecmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/indaco/verso/internal/changeset"
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/tui"
+	"github.com/indaco/verso/internal/workspace"
+	"github.com/urfave/cli/v3"
+)
+
+// Function variables for testability.
+var (
+	isInteractiveFn = tui.IsInteractive
+	newPrompterFn   = func(modules []*workspace.Module) changePrompter { return tui.NewModulePrompt(modules) }
+)
+
+// changePrompter selects the modules of a change and asks for their bump
+// levels and a summary.
+type changePrompter interface {
+	tui.Prompter
+	tui.ChangePrompter
+}
+
+// addCmd returns the "add" subcommand.
+func addCmd(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "add",
+		Usage: "Record a release intent in " + changeset.DefaultDir,
+		UsageText: `verso change add [--bump module=level ...] [--summary text]
+
+Without --bump, modules and bump levels are selected interactively.
+In a single-module repository, --bump takes a level only (e.g. --bump minor).
+The next "verso bump auto" applies the pending change files and deletes them.`,
+		Flags: []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:  "bump",
+				Usage: "Bump of a module as module=level (patch, minor, major); repeatable",
+			},
+			&cli.StringFlag{
+				Name:  "summary",
+				Usage: "Summary of the change, for the changelog",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runChangeAdd(cmd, cfg)
+		},
+	}
+}
+
+// runChangeAdd writes a change file from the flags, prompting for what they
+// leave out when interactive.
+func runChangeAdd(cmd *cli.Command, cfg *config.Config) error {
+	modules, err := changeModules(cfg)
+	if err != nil {
+		return err
+	}
+	var prompter changePrompter
+	interactive := isInteractiveFn()
+	if interactive {
+		prompter = newPrompterFn(modules)
+	}
+
+	var bumps map[string]string
+	switch {
+	case len(cmd.StringSlice("bump")) > 0:
+		bumps, err = parseBumps(cmd.StringSlice("bump"), modules)
+	case interactive:
+		bumps, err = promptBumps(prompter, modules)
+	default:
+		return errors.New("no bumps given: use --bump module=level, or run interactively")
+	}
+	if err != nil {
+		return err
+	}
+
+	summary := cmd.String("summary")
+	if summary == "" && interactive {
+		if summary, err = prompter.PromptSummary(); err != nil {
+			return err
+		}
+	}
+
+	change := &changeset.Change{Bumps: bumps, Summary: summary}
+	if err := changeset.Write(core.NewOSFileSystem(), changeset.DefaultDir, change); err != nil {
+		return err
+	}
+
+	fmt.Printf("Created change file %s\n", change.Path)
+	return nil
+}
+
+// changeModules returns the modules of the workspace, or nil in a
+// single-module repository.
+func changeModules(cfg *config.Config) ([]*workspace.Module, error) {
+	if cfg != nil && cfg.Path != "" && cfg.Path != ".version" {
+		return nil, nil
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	detector := workspace.NewDetector(core.NewOSFileSystem(), cfg)
+	detected, err := detector.DetectContext(cwd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect workspace context: %w", err)
+	}
+	if detected.Mode != workspace.MultiModule {
+		return nil, nil
+	}
+
+	modules, err := detector.DiscoverModules(cwd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover modules: %w", err)
+	}
+	return modules, nil
+}
+
+// parseBumps parses module=level flags. Without modules, a flag is a level
+// of the single module.
+func parseBumps(flags []string, modules []*workspace.Module) (map[string]string, error) {
+	bumps := map[string]string{}
+	for _, flag := range flags {
+		module, level, ok := strings.Cut(flag, "=")
+		if !ok {
+			if modules != nil {
+				return nil, fmt.Errorf("invalid --bump %q: want module=level", flag)
+			}
+			module, level = changeset.RootModule, flag
+		}
+		if modules != nil && !slices.ContainsFunc(modules, func(m *workspace.Module) bool { return m.Name == module }) {
+			return nil, fmt.Errorf("invalid --bump %q: module %q not found", flag, module)
+		}
+		switch level {
+		case "patch", "minor", "major":
+		default:
+			return nil, fmt.Errorf("invalid --bump %q: level must be 'patch', 'minor', or 'major'", flag)
+		}
+		bumps[module] = level
+	}
+	return bumps, nil
+}
+
+// promptBumps asks for the modules of the change and their bump levels.
+func promptBumps(prompter changePrompter, modules []*workspace.Module) (map[string]string, error) {
+	names := []string{changeset.RootModule}
+	if modules != nil {
+		selection, err := prompter.PromptModuleSelection(modules)
+		if err != nil {
+			return nil, fmt.Errorf("module selection failed: %w", err)
+		}
+		if selection.Canceled {
+			return nil, errors.New("operation canceled by user")
+		}
+
+		names = selection.Modules
+		if selection.All {
+			names = nil
+			for _, mod := range modules {
+				names = append(names, mod.Name)
+			}
+		}
+	}
+
+	bumps := make(map[string]string, len(names))
+	for _, name := range names {
+		label := name
+		if name == changeset.RootModule {
+			label = "the version"
+		}
+		level, err := prompter.PromptBumpLevel(label)
+		if err != nil {
+			return nil, err
+		}
+		bumps[name] = level
+	}
+	return bumps, nil
+}
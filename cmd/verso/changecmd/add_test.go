@@ -0,0 +1,166 @@
+package changecmd
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/indaco/verso/internal/changeset"
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/testutils"
+	"github.com/indaco/verso/internal/tui"
+	"github.com/indaco/verso/internal/workspace"
+	"github.com/urfave/cli/v3"
+)
+
+// setupWorkspace creates a .version file for each module, or a single root
+// .version file without modules.
+func setupWorkspace(t *testing.T, modules ...string) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+	if len(modules) == 0 {
+		testutils.WriteTempVersionFile(t, tmpDir, "1.0.0")
+	}
+	for _, name := range modules {
+		dir := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		testutils.WriteTempVersionFile(t, dir, "1.0.0")
+	}
+	return tmpDir
+}
+
+// readChanges loads the change files written in dir.
+func readChanges(t *testing.T, dir string) []*changeset.Change {
+	t.Helper()
+	changes, err := changeset.Load(core.NewOSFileSystem(), filepath.Join(dir, changeset.DefaultDir))
+	if err != nil {
+		t.Fatalf("failed to load change files: %v", err)
+	}
+	return changes
+}
+
+func TestCLI_ChangeAdd(t *testing.T) {
+	origInteractive := isInteractiveFn
+	origPrompter := newPrompterFn
+	defer func() {
+		isInteractiveFn = origInteractive
+		newPrompterFn = origPrompter
+	}()
+
+	tests := []struct {
+		name        string
+		modules     []string
+		args        []string
+		interactive bool
+		prompter    *tui.MockPrompter
+		wantBumps   map[string]string
+		wantSummary string
+	}{
+		{
+			name:        "flags in a workspace",
+			modules:     []string{"api", "web"},
+			args:        []string{"--bump", "api=minor", "--bump", "web=patch", "--summary", "Add search."},
+			wantBumps:   map[string]string{"api": "minor", "web": "patch"},
+			wantSummary: "Add search.",
+		},
+		{
+			name:      "level only in a single-module repository",
+			args:      []string{"--bump", "major"},
+			wantBumps: map[string]string{changeset.RootModule: "major"},
+		},
+		{
+			name:        "interactive selection",
+			modules:     []string{"api", "web"},
+			interactive: true,
+			prompter: &tui.MockPrompter{
+				SelectionResult: tui.SelectedModules([]string{"web"}),
+				BumpLevels:      map[string]string{"web": "minor"},
+				SummaryResult:   "Dark mode.",
+			},
+			wantBumps:   map[string]string{"web": "minor"},
+			wantSummary: "Dark mode.",
+		},
+		{
+			name:        "interactive single module with summary flag",
+			args:        []string{"--summary", "Fix the parser."},
+			interactive: true,
+			prompter:    &tui.MockPrompter{BumpLevels: map[string]string{"the version": "patch"}},
+			wantBumps:   map[string]string{changeset.RootModule: "patch"},
+			wantSummary: "Fix the parser.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := setupWorkspace(t, tt.modules...)
+			isInteractiveFn = func() bool { return tt.interactive }
+			newPrompterFn = func([]*workspace.Module) changePrompter {
+				if tt.prompter == nil {
+					t.Fatal("unexpected prompt")
+				}
+				return tt.prompter
+			}
+
+			cfg := &config.Config{Path: ".version"}
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+			output, err := testutils.CaptureStdout(func() {
+				testutils.RunCLITest(t, appCli, append([]string{"verso", "change", "add"}, tt.args...), tmpDir)
+			})
+			if err != nil {
+				t.Fatalf("failed to capture stdout: %v", err)
+			}
+			if !strings.Contains(output, "Created change file "+changeset.DefaultDir) {
+				t.Errorf("unexpected output %q", output)
+			}
+
+			changes := readChanges(t, tmpDir)
+			if len(changes) != 1 {
+				t.Fatalf("expected 1 change file, got %d", len(changes))
+			}
+			if !maps.Equal(changes[0].Bumps, tt.wantBumps) {
+				t.Errorf("Bumps = %v, want %v", changes[0].Bumps, tt.wantBumps)
+			}
+			if changes[0].Summary != tt.wantSummary {
+				t.Errorf("Summary = %q, want %q", changes[0].Summary, tt.wantSummary)
+			}
+		})
+	}
+}
+
+func TestCLI_ChangeAdd_Errors(t *testing.T) {
+	origInteractive := isInteractiveFn
+	defer func() { isInteractiveFn = origInteractive }()
+	isInteractiveFn = func() bool { return false }
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"no bumps", nil, "no bumps given"},
+		{"level without module", []string{"--bump", "minor"}, "want module=level"},
+		{"unknown module", []string{"--bump", "docs=minor"}, `module "docs" not found`},
+		{"invalid level", []string{"--bump", "api=huge"}, "level must be"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := setupWorkspace(t, "api", "web")
+			cfg := &config.Config{Path: ".version"}
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+			err := testutils.RunCLITestAllowError(t, appCli, append([]string{"verso", "change", "add"}, tt.args...), tmpDir)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if changes := readChanges(t, tmpDir); len(changes) != 0 {
+				t.Errorf("expected no change file, got %d", len(changes))
+			}
+		})
+	}
+}
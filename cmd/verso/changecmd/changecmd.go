@@ -0,0 +1,19 @@
+// Package changecmd provides commands for recording release intents as
+// change files.
+package changecmd
+
+import (
+	"github.com/indaco/verso/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+// Run returns the parent "change" command with its subcommands.
+func Run(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "change",
+		Usage: "Record release intents as change files",
+		Commands: []*cli.Command{
+			addCmd(cfg),
+		},
+	}
+}
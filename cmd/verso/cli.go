@@ -5,11 +5,13 @@ import (
 	"fmt"
 
 	"github.com/indaco/verso/cmd/verso/bumpcmd"
+	"github.com/indaco/verso/cmd/verso/changecmd"
 	"github.com/indaco/verso/cmd/verso/doctorcmd"
 	"github.com/indaco/verso/cmd/verso/extensioncmd"
 	"github.com/indaco/verso/cmd/verso/initcmd"
 	"github.com/indaco/verso/cmd/verso/modulescmd"
 	"github.com/indaco/verso/cmd/verso/precmd"
+	"github.com/indaco/verso/cmd/verso/satisfiescmd"
 	"github.com/indaco/verso/cmd/verso/setcmd"
 	"github.com/indaco/verso/cmd/verso/showcmd"
 	"github.com/indaco/verso/internal/config"
@@ -37,7 +39,7 @@ func newCLI(cfg *config.Config) *cli.Command {
 			&cli.BoolFlag{
 				Name:    "strict",
 				Aliases: []string{"no-auto-init"},
-				Usage:   "Fail if .version file is missing (disable auto-initialization)",
+				Usage:   "Fail if .version file is missing or not strict SemVer 2.0.0 (disable auto-initialization)",
 			},
 			&cli.BoolFlag{
 				Name:        "no-color",
@@ -53,8 +55,11 @@ func newCLI(cfg *config.Config) *cli.Command {
 			showcmd.Run(cfg),
 			setcmd.Run(cfg),
 			bumpcmd.Run(cfg),
+			changecmd.Run(cfg),
+			bumpcmd.PlanCmd(cfg),
+			satisfiescmd.Run(cfg),
 			precmd.Run(),
-			doctorcmd.Run(),
+			doctorcmd.Run(cfg),
 			initcmd.Run(),
 			extensioncmd.Run(),
 			modulescmd.Run(),
@@ -2,31 +2,126 @@ package doctorcmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
 
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
 	"github.com/indaco/verso/internal/semver"
+	"github.com/indaco/verso/internal/workspace"
 	"github.com/urfave/cli/v3"
 )
 
-// Run returns the "pre" command.
-func Run() *cli.Command {
+// Run returns the "doctor" command.
+func Run(cfg *config.Config) *cli.Command {
 	return &cli.Command{
 		Name:    "doctor",
 		Aliases: []string{"validate"},
-		Usage:   "Validate the .version file",
+		Usage:   "Validate the .version file and workspace groups",
 		Action: func(ctx context.Context, cmd *cli.Command) error {
-			return runDoctorCmd(cmd)
+			return runDoctorCmd(cmd, cfg)
 		},
 	}
 }
 
-// runDoctorCmd checks that the .version file is valid.
-func runDoctorCmd(cmd *cli.Command) error {
+// runDoctorCmd checks that the .version file holds a valid SemVer 2.0.0 version.
+// Parsing is always strict so that versions rejected by package registries
+// (leading zeros, empty identifiers, "v" prefix) are reported.
+// If workspace groups are configured, it also checks that the members of each
+// group share a version; the .version file is then optional.
+func runDoctorCmd(cmd *cli.Command, cfg *config.Config) error {
+	hasGroups := cfg != nil && cfg.Workspace != nil && len(cfg.Workspace.Groups) > 0
+
 	path := cmd.String("path")
-	_, err := semver.ReadVersion(path)
+	if _, statErr := os.Stat(path); !hasGroups || !errors.Is(statErr, fs.ErrNotExist) {
+		if _, err := semver.ReadVersionStrict(path); err != nil {
+			return fmt.Errorf("invalid version file at %s: %w", path, err)
+		}
+		fmt.Printf("Valid version file at %s\n", path)
+	}
+
+	if hasGroups {
+		return checkGroups(cfg)
+	}
+	return nil
+}
+
+// checkGroups reports the groups whose members have drifted apart, and the
+// configured members that are not found in the workspace. Disabled members
+// are left out of their group and only listed.
+func checkGroups(cfg *config.Config) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	detector := workspace.NewDetector(core.NewOSFileSystem(), cfg)
+	modules, err := detector.DiscoverModules(cwd)
 	if err != nil {
-		return fmt.Errorf("invalid version file at %s: %w", path, err)
+		return fmt.Errorf("failed to discover modules: %w", err)
+	}
+
+	disabled := map[string]bool{}
+	for _, mc := range cfg.Workspace.Modules {
+		if !mc.IsEnabled() {
+			disabled[mc.Name] = true
+		}
+	}
+	modules = slices.DeleteFunc(modules, func(mod *workspace.Module) bool { return disabled[mod.Name] })
+
+	groups, err := workspace.BuildGroups(modules, cfg)
+	if err != nil {
+		return fmt.Errorf("invalid workspace groups: %w", err)
+	}
+
+	problems := 0
+	for i, g := range groups {
+		var missing, skipped []string
+		for _, name := range cfg.Workspace.Groups[i].Modules {
+			switch {
+			case g.Has(name):
+			case disabled[name]:
+				skipped = append(skipped, name)
+			default:
+				missing = append(missing, name)
+			}
+		}
+		if len(skipped) > 0 {
+			fmt.Printf("Group %s: disabled module(s) not checked: %s\n", g.Name, strings.Join(skipped, ", "))
+		}
+
+		switch {
+		case len(missing) > 0:
+			problems++
+			fmt.Printf("Group %s: module(s) not found: %s\n", g.Name, strings.Join(missing, ", "))
+		case g.Drifted():
+			problems++
+			fmt.Printf("Group %s: versions drifted apart\n", g.Name)
+			for _, mod := range g.Modules {
+				fmt.Printf("  %-12s %s\n", mod.Name, versionOrInvalid(mod))
+			}
+		case len(g.Modules) == 0:
+			fmt.Printf("Group %s: no enabled modules\n", g.Name)
+		default:
+			fmt.Printf("Group %s: %d module(s) at %s\n", g.Name, len(g.Modules), versionOrInvalid(g.Modules[0]))
+		}
+	}
+
+	if problems > 0 {
+		return fmt.Errorf("%d workspace group(s) out of sync", problems)
 	}
-	fmt.Printf("Valid version file at %s\n", path)
 	return nil
 }
+
+// versionOrInvalid returns the module version, or "invalid" if it could not
+// be read.
+func versionOrInvalid(mod *workspace.Module) string {
+	if mod.CurrentVersion == "" {
+		return "invalid"
+	}
+	return mod.CurrentVersion
+}
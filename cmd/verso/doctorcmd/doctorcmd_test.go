@@ -3,6 +3,7 @@ package doctorcmd
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -18,7 +19,7 @@ func TestCLI_ValidateCommand_ValidCases(t *testing.T) {
 
 	// Prepare and run the CLI command
 	cfg := &config.Config{Path: versionPath}
-	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run()})
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
 
 	tests := []struct {
 		name           string
@@ -62,6 +63,10 @@ func TestCLI_ValidateCommand_Errors(t *testing.T) {
 	}{
 		{"invalid version string", "not-a-version", "invalid version"},
 		{"invalid build metadata", "1.0.0+inv@lid-meta", "invalid version"},
+		{"leading zero in major", "01.2.3", "position 1: leading zero in major version"},
+		{"leading zero in pre-release", "1.2.3-rc.01", "position 10: leading zero in numeric pre-release identifier"},
+		{"empty pre-release identifier", "1.2.3-rc..1", "position 10: empty pre-release identifier"},
+		{"v prefix", "v1.2.3", "is not part of a semantic version"},
 	}
 
 	for _, tt := range tests {
@@ -72,7 +77,7 @@ func TestCLI_ValidateCommand_Errors(t *testing.T) {
 
 			// Prepare and run the CLI command
 			cfg := &config.Config{Path: versionPath}
-			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run()})
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
 
 			err := appCli.Run(context.Background(), []string{"verso", "doctor"})
 			if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
@@ -81,3 +86,91 @@ func TestCLI_ValidateCommand_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestCLI_DoctorCommand_Groups(t *testing.T) {
+	disabled := false
+	tests := []struct {
+		name          string
+		versions      map[string]string
+		modules       []config.ModuleConfig
+		groupModules  []string
+		expectedOut   []string
+		expectedError string
+	}{
+		{
+			name:         "members in sync",
+			versions:     map[string]string{"sdk": "1.2.0", "cli": "1.2.0"},
+			groupModules: []string{"sdk", "cli"},
+			expectedOut:  []string{"Group platform: 2 module(s) at 1.2.0"},
+		},
+		{
+			name:          "members drifted apart",
+			versions:      map[string]string{"sdk": "1.2.0", "cli": "1.1.0"},
+			groupModules:  []string{"sdk", "cli"},
+			expectedOut:   []string{"Group platform: versions drifted apart", "cli          1.1.0"},
+			expectedError: "1 workspace group(s) out of sync",
+		},
+		{
+			name:          "member not found",
+			versions:      map[string]string{"sdk": "1.2.0", "cli": "1.2.0"},
+			groupModules:  []string{"sdk", "server"},
+			expectedOut:   []string{"Group platform: module(s) not found: server"},
+			expectedError: "1 workspace group(s) out of sync",
+		},
+		{
+			name:     "disabled member is not checked",
+			versions: map[string]string{"sdk": "1.2.0", "cli": "1.1.0"},
+			modules: []config.ModuleConfig{
+				{Name: "sdk", Path: "sdk/.version"},
+				{Name: "cli", Path: "cli/.version", Enabled: &disabled},
+			},
+			groupModules: []string{"sdk", "cli"},
+			expectedOut:  []string{"Group platform: disabled module(s) not checked: cli", "Group platform: 1 module(s) at 1.2.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for name, version := range tt.versions {
+				dir := filepath.Join(tmpDir, name)
+				if err := os.MkdirAll(dir, 0755); err != nil {
+					t.Fatal(err)
+				}
+				testutils.WriteTempVersionFile(t, dir, version)
+			}
+
+			cfg := &config.Config{
+				Path: ".version",
+				Workspace: &config.WorkspaceConfig{
+					Modules: tt.modules,
+					Groups:  []config.GroupConfig{{Name: "platform", Modules: tt.groupModules}},
+				},
+			}
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+			var runErr error
+			output, err := testutils.CaptureStdout(func() {
+				runErr = testutils.RunCLITestAllowError(t, appCli, []string{"verso", "doctor"}, tmpDir)
+			})
+			if err != nil {
+				t.Fatalf("Failed to capture stdout: %v", err)
+			}
+
+			if tt.expectedError == "" && runErr != nil {
+				t.Fatalf("unexpected error: %v", runErr)
+			}
+			if tt.expectedError != "" && (runErr == nil || !strings.Contains(runErr.Error(), tt.expectedError)) {
+				t.Fatalf("expected error containing %q, got: %v", tt.expectedError, runErr)
+			}
+			if strings.Contains(output, "Valid version file") {
+				t.Errorf("expected the missing root .version file to be skipped, got %q", output)
+			}
+			for _, want := range tt.expectedOut {
+				if !strings.Contains(output, want) {
+					t.Errorf("expected output to contain %q, got %q", want, output)
+				}
+			}
+		})
+	}
+}
@@ -24,6 +24,14 @@ func MultiModuleFlags() []cli.Flag {
 			Name:  "pattern",
 			Usage: "Operate on modules matching glob pattern (e.g., 'services/*')",
 		},
+		&cli.BoolFlag{
+			Name:  "changed",
+			Usage: "Operate only on modules with commits since their last tag",
+		},
+		&cli.StringFlag{
+			Name:  "changed-since",
+			Usage: "Operate only on modules with commits since the given ref (implies --changed)",
+		},
 		&cli.BoolFlag{
 			Name:    "yes",
 			Aliases: []string{"y"},
@@ -37,6 +45,10 @@ func MultiModuleFlags() []cli.Flag {
 			Name:  "parallel",
 			Usage: "Execute operations in parallel across modules",
 		},
+		&cli.IntFlag{
+			Name:  "jobs",
+			Usage: "Maximum number of modules processed at the same time with --parallel (0 = no limit)",
+		},
 		&cli.BoolFlag{
 			Name:  "fail-fast",
 			Usage: "Stop execution on first error",
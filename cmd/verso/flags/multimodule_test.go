@@ -20,9 +20,12 @@ func TestMultiModuleFlags(t *testing.T) {
 		"module":            false,
 		"modules":           false,
 		"pattern":           false,
+		"changed":           false,
+		"changed-since":     false,
 		"yes":               false,
 		"non-interactive":   false,
 		"parallel":          false,
+		"jobs":              false,
 		"fail-fast":         false,
 		"continue-on-error": false,
 		"quiet":             false,
@@ -70,6 +73,11 @@ func TestMultiModuleFlags_FlagTypes(t *testing.T) {
 		t.Error("Expected 'modules' to be a StringSliceFlag")
 	}
 
+	// Check --jobs is an IntFlag
+	if _, ok := flagMap["jobs"].(*cli.IntFlag); !ok {
+		t.Error("Expected 'jobs' to be an IntFlag")
+	}
+
 	// Check --format has default value "text"
 	if f, ok := flagMap["format"].(*cli.StringFlag); ok {
 		if f.Value != "text" {
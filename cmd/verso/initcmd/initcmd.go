@@ -13,7 +13,14 @@ func Run() *cli.Command {
 	return &cli.Command{
 		Name:      "init",
 		Usage:     "Initialize a .version file (auto-detects Git tag or starts from 0.1.0)",
-		UsageText: "verso init",
+		UsageText: "verso init [--format plain|yaml|json]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "format",
+				Usage: "Version file format: plain, or yaml/json to record release metadata next to the version",
+				Value: string(semver.FormatPlain),
+			},
+		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			return runInitCmd(cmd)
 		},
@@ -21,22 +28,46 @@ func Run() *cli.Command {
 }
 
 // runInitCmd initializes a .version file if not present.
+// With a structured --format, a new file is written as YAML or JSON and an
+// existing plain file is converted.
 func runInitCmd(cmd *cli.Command) error {
 	path := cmd.String("path")
 
+	format := semver.VersionFileFormat(cmd.String("format"))
+	switch format {
+	case semver.FormatPlain, semver.FormatYAML, semver.FormatJSON:
+	default:
+		return fmt.Errorf("invalid format %q: expected %q, %q or %q", format, semver.FormatPlain, semver.FormatYAML, semver.FormatJSON)
+	}
+
 	created, err := semver.InitializeVersionFileWithFeedback(path)
 	if err != nil {
 		return err
 	}
 
-	version, err := semver.ReadVersion(path)
+	file, err := semver.ReadVersionFile(path)
 	if err != nil {
 		return fmt.Errorf("failed to read version file at %s: %w", path, err)
 	}
 
-	if created {
-		fmt.Printf("Initialized %s with version %s\n", path, version.String())
-	} else {
+	converted := false
+	if format != semver.FormatPlain && file.Format != format {
+		if file.IsStructured() {
+			return fmt.Errorf("version file at %s is already %s; convert it manually", path, file.Format)
+		}
+		file.Format = format
+		if err := semver.SaveVersionFile(path, file); err != nil {
+			return fmt.Errorf("failed to write version file at %s: %w", path, err)
+		}
+		converted = !created
+	}
+
+	switch {
+	case created:
+		fmt.Printf("Initialized %s with version %s\n", path, file.Version.String())
+	case converted:
+		fmt.Printf("Converted %s to %s format\n", path, format)
+	default:
 		fmt.Printf("Version file already exists at %s\n", path)
 	}
 	return nil
@@ -163,3 +163,75 @@ func TestCLI_Command_InitializeVersionFilePermissionErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestCLI_InitCommand_StructuredFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"yaml", "version: 0.1.0\n"},
+		{"json", "{\n  \"version\": \"0.1.0\"\n}\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			tmp := t.TempDir()
+			versionPath := filepath.Join(tmp, ".version")
+
+			cfg := &config.Config{Path: versionPath}
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run()})
+
+			output, err := testutils.CaptureStdout(func() {
+				testutils.RunCLITest(t, appCli, []string{"verso", "init", "--format", tt.format}, tmp)
+			})
+			if err != nil {
+				t.Fatalf("Failed to capture stdout: %v", err)
+			}
+
+			if got := testutils.ReadFile(t, versionPath); got != tt.want {
+				t.Errorf("expected file content %q, got %q", tt.want, got)
+			}
+			expected := fmt.Sprintf("Initialized %s with version 0.1.0", versionPath)
+			if strings.TrimSpace(output) != expected {
+				t.Errorf("expected output %q, got %q", expected, output)
+			}
+		})
+	}
+}
+
+func TestCLI_InitCommand_ConvertsPlainFile(t *testing.T) {
+	tmp := t.TempDir()
+	testutils.WriteTempVersionFile(t, tmp, "1.2.3")
+	versionPath := filepath.Join(tmp, ".version")
+
+	cfg := &config.Config{Path: versionPath}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run()})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "init", "--format", "yaml"}, tmp)
+	})
+	if err != nil {
+		t.Fatalf("Failed to capture stdout: %v", err)
+	}
+
+	if got := testutils.ReadFile(t, versionPath); got != "version: 1.2.3\n" {
+		t.Errorf("expected converted YAML file, got %q", got)
+	}
+	expected := fmt.Sprintf("Converted %s to yaml format", versionPath)
+	if strings.TrimSpace(output) != expected {
+		t.Errorf("expected output %q, got %q", expected, output)
+	}
+}
+
+func TestCLI_InitCommand_InvalidFormat(t *testing.T) {
+	tmp := t.TempDir()
+	versionPath := filepath.Join(tmp, ".version")
+
+	cfg := &config.Config{Path: versionPath}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run()})
+
+	err := appCli.Run(context.Background(), []string{"verso", "init", "--path", versionPath, "--format", "toml"})
+	if err == nil || !strings.Contains(err.Error(), `invalid format "toml"`) {
+		t.Errorf("expected invalid format error, got %v", err)
+	}
+}
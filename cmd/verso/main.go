@@ -9,6 +9,7 @@ import (
 	"github.com/indaco/verso/internal/config"
 	"github.com/indaco/verso/internal/hooks"
 	"github.com/indaco/verso/internal/plugins"
+	"github.com/indaco/verso/internal/semver"
 )
 
 func main() {
@@ -32,6 +33,10 @@ func runCLI(args []string) error {
 		cfg.Path = ".version"
 	}
 
+	if err := configureVersionScheme(cfg); err != nil {
+		return err
+	}
+
 	plugins.RegisterBuiltinPlugins(cfg)
 
 	if err := hooks.LoadPreReleaseHooksFromConfigFn(cfg); err != nil {
@@ -41,3 +46,13 @@ func runCLI(args []string) error {
 	app := newCLI(cfg)
 	return app.Run(context.Background(), args)
 }
+
+// configureVersionScheme activates the version scheme selected in the config.
+func configureVersionScheme(cfg *config.Config) error {
+	scheme, err := semver.NewScheme(cfg.Scheme, cfg.CalVer.GetFormat())
+	if err != nil {
+		return fmt.Errorf("invalid version scheme: %w", err)
+	}
+	semver.SetDefaultScheme(scheme)
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/indaco/verso/internal/config"
 	"github.com/indaco/verso/internal/hooks"
+	"github.com/indaco/verso/internal/semver"
 )
 
 func TestRunMain_ShowVersion(t *testing.T) {
@@ -167,3 +168,49 @@ func TestRunMain_LoadPreReleaseHooksError(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func TestRunMain_InvalidVersionScheme(t *testing.T) {
+	tmp := t.TempDir()
+
+	configPath := filepath.Join(tmp, ".verso.yaml")
+	if err := os.WriteFile(configPath, []byte("scheme: calver\ncalver:\n  format: MM.YYYY.MICRO\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(tmp); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := os.Chdir(origDir); err != nil {
+			t.Fatalf("failed to restore working directory: %v", err)
+		}
+	})
+
+	err = runCLI([]string{"verso", "show"})
+	if err == nil {
+		t.Fatal("expected error for invalid calver format, got nil")
+	}
+	if !strings.Contains(err.Error(), "invalid version scheme") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestConfigureVersionScheme_CalVer(t *testing.T) {
+	defer semver.SetDefaultScheme(semver.DefaultScheme())()
+
+	cfg := &config.Config{Scheme: "calver", CalVer: &config.CalVerConfig{Format: "YY.0M.MICRO"}}
+	if err := configureVersionScheme(cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if semver.DefaultScheme().Name() != semver.SchemeCalVer {
+		t.Errorf("expected calver scheme, got %q", semver.DefaultScheme().Name())
+	}
+	if got := (semver.SemVersion{Major: 26, Minor: 1, Patch: 0}).String(); got != "26.01.0" {
+		t.Errorf("expected padded calver output, got %q", got)
+	}
+}
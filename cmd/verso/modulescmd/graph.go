@@ -0,0 +1,73 @@
+package modulescmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/workspace"
+	"github.com/urfave/cli/v3"
+)
+
+// graphCmd returns the "graph" subcommand for showing module dependencies.
+func graphCmd() *cli.Command {
+	return &cli.Command{
+		Name:  "graph",
+		Usage: "Show the dependency graph of modules in workspace",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "format",
+				Usage: "Output format (text, dot, mermaid)",
+				Value: "text",
+			},
+		},
+		Action: runGraph,
+	}
+}
+
+func runGraph(ctx context.Context, cmd *cli.Command) error {
+	cfg, err := config.LoadConfigFn()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	fs := core.NewOSFileSystem()
+	detector := workspace.NewDetector(fs, cfg)
+
+	modules, err := detector.DiscoverModules(cwd)
+	if err != nil {
+		return fmt.Errorf("failed to discover modules: %w", err)
+	}
+
+	if len(modules) == 0 {
+		fmt.Println("No modules found in workspace")
+		return nil
+	}
+
+	graph, err := workspace.BuildGraph(fs, modules, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to build module dependency graph: %w", err)
+	}
+
+	switch format := cmd.String("format"); format {
+	case "text":
+		fmt.Print(graph.FormatText())
+	case "dot":
+		fmt.Print(graph.FormatDOT())
+	case "mermaid":
+		fmt.Print(graph.FormatMermaid())
+	default:
+		return fmt.Errorf("unsupported format %q (want text, dot or mermaid)", format)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package modulescmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/testutils"
+)
+
+func TestRunGraph(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"api/.version":            "1.0.0",
+		"api/package.json":        `{"name": "@repo/api", "dependencies": {"@repo/shared": "^1.0.0"}}`,
+		"shared-lib/.version":     "1.0.0",
+		"shared-lib/package.json": `{"name": "@repo/shared"}`,
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	originalLoadConfig := config.LoadConfigFn
+	defer func() { config.LoadConfigFn = originalLoadConfig }()
+	config.LoadConfigFn = func() (*config.Config, error) { return nil, nil }
+
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	defer func() { _ = os.Chdir(origDir) }()
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("failed to change directory: %v", err)
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"text", "api -> shared-lib"},
+		{"dot", `"api" -> "shared-lib";`},
+		{"mermaid", "api --> shared_lib"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			output, err := testutils.CaptureStdout(func() {
+				if err := graphCmd().Run(context.Background(), []string{"graph", "--format", tt.format}); err != nil {
+					t.Errorf("runGraph failed: %v", err)
+				}
+			})
+			if err != nil {
+				t.Fatalf("failed to capture stdout: %v", err)
+			}
+			if !strings.Contains(output, tt.want) {
+				t.Errorf("expected output to contain %q, got:\n%s", tt.want, output)
+			}
+		})
+	}
+
+	if err := graphCmd().Run(context.Background(), []string{"graph", "--format", "svg"}); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/indaco/verso/internal/clix"
 	"github.com/indaco/verso/internal/config"
 	"github.com/indaco/verso/internal/core"
 	"github.com/indaco/verso/internal/workspace"
@@ -29,6 +30,14 @@ func listCmd() *cli.Command {
 				Usage: "Output format (text, json)",
 				Value: "text",
 			},
+			&cli.BoolFlag{
+				Name:  "changed",
+				Usage: "List only modules with commits since their last tag",
+			},
+			&cli.StringFlag{
+				Name:  "changed-since",
+				Usage: "List only modules with commits since the given ref (implies --changed)",
+			},
 		},
 		Action: runList,
 	}
@@ -61,6 +70,13 @@ func runList(ctx context.Context, cmd *cli.Command) error {
 		return nil
 	}
 
+	if cmd.Bool("changed") || cmd.String("changed-since") != "" {
+		modules, err = clix.FilterChangedModules(modules, cmd.String("changed-since"))
+		if err != nil {
+			return err
+		}
+	}
+
 	format := cmd.String("format")
 	verbose := cmd.Bool("verbose")
 
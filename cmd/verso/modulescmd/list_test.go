@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/plugins/commitparser/gitlog"
 	"github.com/indaco/verso/internal/testutils"
 	"github.com/indaco/verso/internal/workspace"
 	"github.com/urfave/cli/v3"
@@ -887,3 +888,50 @@ func TestRunList_DiscoverModulesError(t *testing.T) {
 		_ = runList(ctx, mockCmd)
 	})
 }
+
+func TestRunList_Changed(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, name := range []string{"api", "web"} {
+		dir := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("failed to create module directory: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, ".version"), []byte("1.0.0"), 0644); err != nil {
+			t.Fatalf("failed to create version file: %v", err)
+		}
+	}
+
+	originalLoadConfig := config.LoadConfigFn
+	originalHasChanges := gitlog.HasModuleChangesFn
+	defer func() {
+		config.LoadConfigFn = originalLoadConfig
+		gitlog.HasModuleChangesFn = originalHasChanges
+	}()
+
+	config.LoadConfigFn = func() (*config.Config, error) { return &config.Config{}, nil }
+	gitlog.HasModuleChangesFn = func(name, dir, since string) (bool, error) {
+		return name == "api", nil
+	}
+
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	defer func() { _ = os.Chdir(origDir) }()
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("failed to change directory: %v", err)
+	}
+
+	output, err := testutils.CaptureStdout(func() {
+		if err := listCmd().Run(context.Background(), []string{"list", "--changed"}); err != nil {
+			t.Errorf("runList failed: %v", err)
+		}
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+
+	if !strings.Contains(output, "Found 1 module(s)") || !strings.Contains(output, "api") || strings.Contains(output, "web") {
+		t.Errorf("expected only the changed module api, got: %q", output)
+	}
+}
@@ -14,6 +14,7 @@ func Run() *cli.Command {
 		Commands: []*cli.Command{
 			listCmd(),
 			discoverCmd(),
+			graphCmd(),
 		},
 	}
 }
@@ -44,6 +44,7 @@ func TestRun_HasSubcommands(t *testing.T) {
 	expectedSubcommands := map[string]bool{
 		"list":     true,
 		"discover": true,
+		"graph":    true,
 	}
 
 	foundSubcommands := make(map[string]bool)
@@ -3,6 +3,7 @@ package precmd
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/indaco/verso/internal/clix"
 	"github.com/indaco/verso/internal/semver"
@@ -42,6 +43,12 @@ func runPreCmd(cmd *cli.Command) error {
 		return err
 	}
 
+	lock, err := semver.LockVersionFile(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = lock.Release() }()
+
 	version, err := semver.ReadVersion(path)
 	if err != nil {
 		return fmt.Errorf("failed to read version: %w", err)
@@ -51,6 +58,7 @@ func runPreCmd(cmd *cli.Command) error {
 		version.PreRelease = semver.IncrementPreRelease(version.PreRelease, label)
 	} else {
 		if version.PreRelease == "" {
+			fmt.Fprintf(os.Stderr, "No pre-release in %s; bumping patch (use 'verso bump preminor' or 'premajor' for other levels)\n", version.String())
 			version.Patch++
 		}
 		version.PreRelease = label
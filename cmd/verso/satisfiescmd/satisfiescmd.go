@@ -0,0 +1,127 @@
+package satisfiescmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/indaco/verso/cmd/verso/flags"
+	"github.com/indaco/verso/internal/clix"
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/operations"
+	"github.com/indaco/verso/internal/semver"
+	"github.com/indaco/verso/internal/workspace"
+	"github.com/urfave/cli/v3"
+)
+
+// Run returns the "satisfies" command.
+func Run(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "satisfies",
+		Usage:     "Check whether the current version satisfies a constraint",
+		UsageText: `verso satisfies "<constraint>" [version] [--all] [--module name]`,
+		Flags:     flags.MultiModuleFlags(),
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runSatisfiesCmd(ctx, cmd, cfg)
+		},
+	}
+}
+
+// runSatisfiesCmd checks the current version (or an explicit version argument)
+// against the given constraint and exits non-zero on mismatch.
+func runSatisfiesCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error {
+	if cmd.Args().Len() < 1 {
+		return cli.Exit("missing required constraint argument", 1)
+	}
+
+	constraints, err := semver.ParseConstraints(cmd.Args().Get(0))
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	// An explicit version argument bypasses the version file entirely.
+	if raw := strings.TrimSpace(cmd.Args().Get(1)); raw != "" {
+		version, err := semver.ParseVersion(raw)
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("invalid version %q: %v", raw, err), 1)
+		}
+		return checkVersion(cmd, version, constraints)
+	}
+
+	execCtx, err := clix.GetExecutionContext(ctx, cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	if execCtx.IsSingleModule() {
+		return runSingleModuleSatisfies(cmd, execCtx.Path, constraints)
+	}
+
+	return runMultiModuleSatisfies(ctx, cmd, execCtx, constraints)
+}
+
+// runSingleModuleSatisfies checks the version stored at path.
+func runSingleModuleSatisfies(cmd *cli.Command, path string, constraints *semver.Constraints) error {
+	if _, err := clix.FromCommandFn(cmd); err != nil {
+		return err
+	}
+
+	version, err := semver.ReadVersion(path)
+	if err != nil {
+		return fmt.Errorf("failed to read version file at %s: %w", path, err)
+	}
+
+	return checkVersion(cmd, version, constraints)
+}
+
+// checkVersion prints the result of the check and returns an exit error on mismatch.
+func checkVersion(cmd *cli.Command, version semver.SemVersion, constraints *semver.Constraints) error {
+	if !constraints.Check(version) {
+		return cli.Exit(fmt.Sprintf("%s does not satisfy %q", version.String(), constraints.String()), 1)
+	}
+
+	if !cmd.Bool("quiet") {
+		fmt.Printf("%s satisfies %q\n", version.String(), constraints.String())
+	}
+	return nil
+}
+
+// runMultiModuleSatisfies checks every selected module against the constraint.
+func runMultiModuleSatisfies(ctx context.Context, cmd *cli.Command, execCtx *clix.ExecutionContext, constraints *semver.Constraints) error {
+	fs := core.NewOSFileSystem()
+	operation := operations.NewSatisfiesOperation(fs, constraints)
+
+	// Checks never stop early so every mismatch is reported.
+	executor := workspace.NewExecutor(
+		workspace.WithParallel(cmd.Bool("parallel")),
+		workspace.WithFailFast(false),
+		workspace.WithJobs(cmd.Int("jobs")),
+	)
+
+	results, _ := executor.Run(ctx, execCtx.Modules, operation)
+
+	if cmd.Bool("quiet") {
+		printQuietSummary(results)
+	} else {
+		formatter := workspace.GetFormatter(cmd.String("format"), fmt.Sprintf("Constraint %q", constraints.String()))
+		fmt.Println(formatter.FormatResults(results))
+	}
+
+	if workspace.HasErrors(results) {
+		return cli.Exit(fmt.Sprintf("%d module(s) do not satisfy %q", workspace.ErrorCount(results), constraints.String()), 1)
+	}
+
+	return nil
+}
+
+// printQuietSummary prints a minimal summary of results.
+func printQuietSummary(results []workspace.ExecutionResult) {
+	success := workspace.SuccessCount(results)
+	errors := workspace.ErrorCount(results)
+	if errors > 0 {
+		fmt.Printf("Completed: %d satisfied, %d failed\n", success, errors)
+	} else {
+		fmt.Printf("Success: %d module(s) satisfy the constraint\n", success)
+	}
+}
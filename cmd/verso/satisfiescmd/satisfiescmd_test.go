@@ -0,0 +1,204 @@
+package satisfiescmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/testutils"
+	"github.com/urfave/cli/v3"
+)
+
+func TestCLI_SatisfiesCommand_Match(t *testing.T) {
+	tmpDir := t.TempDir()
+	testutils.WriteTempVersionFile(t, tmpDir, "1.4.2")
+	versionPath := filepath.Join(tmpDir, ".version")
+
+	cfg := &config.Config{Path: versionPath}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "satisfies", "^1.2"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("Failed to capture stdout: %v", err)
+	}
+
+	expected := `1.4.2 satisfies "^1.2"`
+	if output != expected {
+		t.Errorf("expected output %q, got %q", expected, output)
+	}
+}
+
+func TestCLI_SatisfiesCommand_Mismatch(t *testing.T) {
+	if os.Getenv("TEST_VERSO_SATISFIES_MISMATCH") == "1" {
+		tmp := t.TempDir()
+		testutils.WriteTempVersionFile(t, tmp, "2.0.0")
+		versionPath := filepath.Join(tmp, ".version")
+
+		cfg := &config.Config{Path: versionPath}
+		appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+		err := appCli.Run(context.Background(), []string{"verso", "satisfies", ">=1.0.0 <2.0.0", "--path", versionPath})
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1) // expected non-zero exit
+		}
+		os.Exit(0) // should not happen
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestCLI_SatisfiesCommand_Mismatch")
+	cmd.Env = append(os.Environ(), "TEST_VERSO_SATISFIES_MISMATCH=1")
+	output, err := cmd.CombinedOutput()
+
+	if err == nil {
+		t.Fatal("expected non-zero exit status")
+	}
+
+	expected := `2.0.0 does not satisfy ">=1.0.0 <2.0.0"`
+	if !strings.Contains(string(output), expected) {
+		t.Errorf("expected output to contain %q, got %q", expected, string(output))
+	}
+}
+
+func TestCLI_SatisfiesCommand_ExplicitVersion(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := &config.Config{Path: filepath.Join(tmpDir, ".version")}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "satisfies", "~1.2.3", "1.2.9"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("Failed to capture stdout: %v", err)
+	}
+	if !strings.Contains(output, "1.2.9 satisfies") {
+		t.Errorf("unexpected output: %q", output)
+	}
+
+	if _, err := os.Stat(cfg.Path); !os.IsNotExist(err) {
+		t.Errorf("expected version file not to be created")
+	}
+}
+
+func TestCLI_SatisfiesCommand_Quiet(t *testing.T) {
+	tmpDir := t.TempDir()
+	testutils.WriteTempVersionFile(t, tmpDir, "1.0.0")
+
+	cfg := &config.Config{Path: filepath.Join(tmpDir, ".version")}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "satisfies", "--quiet", "1.x"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("Failed to capture stdout: %v", err)
+	}
+	if output != "" {
+		t.Errorf("expected no output in quiet mode, got %q", output)
+	}
+}
+
+func TestCLI_SatisfiesCommand_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{"missing constraint", []string{"verso", "satisfies"}, "missing required constraint argument"},
+		{"invalid constraint", []string{"verso", "satisfies", ">=foo"}, "invalid constraint"},
+		{"invalid explicit version", []string{"verso", "satisfies", "^1", "bad"}, `invalid version "bad"`},
+	}
+
+	if idx := os.Getenv("TEST_VERSO_SATISFIES_ERROR"); idx != "" {
+		tmp := t.TempDir()
+		testutils.WriteTempVersionFile(t, tmp, "1.0.0")
+
+		cfg := &config.Config{Path: filepath.Join(tmp, ".version")}
+		appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+		i, _ := strconv.Atoi(idx)
+		err := appCli.Run(context.Background(), tests[i].args)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1) // expected non-zero exit
+		}
+		os.Exit(0) // should not happen
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command(os.Args[0], "-test.run=TestCLI_SatisfiesCommand_Errors$")
+			cmd.Env = append(os.Environ(), "TEST_VERSO_SATISFIES_ERROR="+strconv.Itoa(i))
+			output, err := cmd.CombinedOutput()
+
+			if err == nil {
+				t.Fatal("expected non-zero exit status")
+			}
+			if !strings.Contains(string(output), tt.expected) {
+				t.Errorf("expected output to contain %q, got %q", tt.expected, string(output))
+			}
+		})
+	}
+}
+
+func TestCLI_SatisfiesCommand_MultiModule(t *testing.T) {
+	if os.Getenv("TEST_VERSO_SATISFIES_MULTI") != "1" {
+		cmd := exec.Command(os.Args[0], "-test.run=TestCLI_SatisfiesCommand_MultiModule")
+		cmd.Env = append(os.Environ(), "TEST_VERSO_SATISFIES_MULTI=1")
+		output, err := cmd.CombinedOutput()
+
+		if err == nil {
+			t.Fatal("expected non-zero exit status because module-b does not satisfy ^1.0")
+		}
+		for _, expected := range []string{"module-a", "module-b", "1 module(s) do not satisfy"} {
+			if !strings.Contains(string(output), expected) {
+				t.Errorf("expected output to contain %q, got %q", expected, string(output))
+			}
+		}
+		return
+	}
+
+	tmpDir := t.TempDir()
+
+	moduleA := filepath.Join(tmpDir, "module-a")
+	moduleB := filepath.Join(tmpDir, "module-b")
+	if err := os.MkdirAll(moduleA, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(moduleB, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.WriteTempVersionFile(t, moduleA, "1.0.0")
+	testutils.WriteTempVersionFile(t, moduleB, "2.0.0")
+
+	enabled := true
+	recursive := true
+	maxDepth := 10
+	cfg := &config.Config{
+		Path: ".version",
+		Workspace: &config.WorkspaceConfig{
+			Discovery: &config.DiscoveryConfig{
+				Enabled:   &enabled,
+				Recursive: &recursive,
+				MaxDepth:  &maxDepth,
+			},
+		},
+	}
+
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	err := testutils.RunCLITestAllowError(t, appCli, []string{"verso", "satisfies", "^1.0", "--all"}, tmpDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1) // expected non-zero exit
+	}
+	os.Exit(0) // should not happen
+}
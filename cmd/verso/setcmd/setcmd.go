@@ -51,13 +51,20 @@ func runSetCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error
 	meta := cmd.String("meta")
 
 	// Parse and validate the version first
-	version, err := semver.ParseVersion(raw)
+	version, err := parseVersionArg(raw, cmd.Bool("strict"))
 	if err != nil {
 		return fmt.Errorf("invalid version: %w", err)
 	}
 	version.PreRelease = pre
 	version.Build = meta
 
+	// In strict mode the composed version must also be exact
+	if cmd.Bool("strict") && (pre != "" || meta != "") {
+		if _, err := semver.DefaultScheme().ParseStrict(version.String()); err != nil {
+			return fmt.Errorf("invalid version: %w", err)
+		}
+	}
+
 	// Get execution context to determine single vs multi-module mode
 	execCtx, err := clix.GetExecutionContext(ctx, cmd, cfg)
 	if err != nil {
@@ -73,6 +80,15 @@ func runSetCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error
 	return runMultiModuleSet(ctx, cmd, execCtx, version.String())
 }
 
+// parseVersionArg parses the version argument, using the strict parser of the
+// active scheme in strict mode.
+func parseVersionArg(raw string, strict bool) (semver.SemVersion, error) {
+	if strict {
+		return semver.DefaultScheme().ParseStrict(raw)
+	}
+	return semver.ParseVersion(raw)
+}
+
 // runSingleModuleSet handles the single-module set operation.
 func runSingleModuleSet(path string, version semver.SemVersion) error {
 	if err := semver.SaveVersion(path, version); err != nil {
@@ -95,6 +111,7 @@ func runMultiModuleSet(ctx context.Context, cmd *cli.Command, execCtx *clix.Exec
 	executor := workspace.NewExecutor(
 		workspace.WithParallel(parallel),
 		workspace.WithFailFast(failFast),
+		workspace.WithJobs(cmd.Int("jobs")),
 	)
 
 	// Execute the operation on all modules
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/semver"
 	"github.com/indaco/verso/internal/testutils"
 	"github.com/urfave/cli/v3"
 )
@@ -61,6 +62,58 @@ func TestCLI_SetVersionCommand_InvalidFormat(t *testing.T) {
 	}
 }
 
+func TestCLI_SetVersionCommand_StrictRejectsNonSpecVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{"leading zero", []string{"verso", "--strict", "set", "01.2.3"}, "leading zero in major version"},
+		{"v prefix", []string{"verso", "--strict", "set", "v1.2.3"}, "is not part of a semantic version"},
+		{"empty pre-release identifier", []string{"verso", "--strict", "set", "1.2.3", "--pre", "rc..1"}, "empty pre-release identifier"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			versionPath := filepath.Join(tmpDir, ".version")
+
+			cfg := &config.Config{Path: versionPath}
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+			err := appCli.Run(context.Background(), tt.args)
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error to contain %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestCLI_SetVersionCommand_StrictUsesScheme(t *testing.T) {
+	scheme, err := semver.NewCalVerScheme("YYYY.0M.MICRO", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer semver.SetDefaultScheme(scheme)()
+
+	tmpDir := t.TempDir()
+	cfg := &config.Config{Path: filepath.Join(tmpDir, ".version")}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	testutils.RunCLITest(t, appCli, []string{"verso", "--strict", "set", "2026.03.1"}, tmpDir)
+	if got := testutils.ReadTempVersionFile(t, tmpDir); got != "2026.03.1" {
+		t.Errorf("expected %q, got %q", "2026.03.1", got)
+	}
+
+	err = appCli.Run(context.Background(), []string{"verso", "--strict", "set", "2026.3.1"})
+	if err == nil || !strings.Contains(err.Error(), "does not match calver format") {
+		t.Errorf("expected a calver format error, got %v", err)
+	}
+}
+
 func TestCLI_SetVersionCommand_MissingArgument(t *testing.T) {
 	if os.Getenv("TEST_VERSO_SET_MISSING_ARG") == "1" {
 		tmp := t.TempDir()
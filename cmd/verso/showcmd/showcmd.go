@@ -9,6 +9,7 @@ import (
 	"github.com/indaco/verso/internal/config"
 	"github.com/indaco/verso/internal/core"
 	"github.com/indaco/verso/internal/operations"
+	"github.com/indaco/verso/internal/plugins/tagmanager"
 	"github.com/indaco/verso/internal/semver"
 	"github.com/indaco/verso/internal/workspace"
 	"github.com/urfave/cli/v3"
@@ -19,8 +20,13 @@ func Run(cfg *config.Config) *cli.Command {
 	return &cli.Command{
 		Name:      "show",
 		Usage:     "Display current version",
-		UsageText: "verso show [--all] [--module name] [--format text|json|table]",
-		Flags:     flags.MultiModuleFlags(),
+		UsageText: "verso show [--dev] [--all] [--module name] [--format text|json|table]",
+		Flags: append([]cli.Flag{
+			&cli.BoolFlag{
+				Name:  "dev",
+				Usage: "Show a development version derived from commits since the last release tag",
+			},
+		}, flags.MultiModuleFlags()...),
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			return runShowCmd(ctx, cmd, cfg)
 		},
@@ -37,9 +43,16 @@ func runShowCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error
 
 	// Handle single-module mode
 	if execCtx.IsSingleModule() {
+		if cmd.Bool("dev") {
+			return runSingleModuleDevShow(ctx, cmd, cfg, execCtx.Path)
+		}
 		return runSingleModuleShow(cmd, execCtx.Path)
 	}
 
+	if cmd.Bool("dev") {
+		return fmt.Errorf("--dev is not supported in multi-module mode")
+	}
+
 	// Handle multi-module mode
 	return runMultiModuleShow(ctx, cmd, execCtx)
 }
@@ -59,6 +72,35 @@ func runSingleModuleShow(cmd *cli.Command, path string) error {
 	return nil
 }
 
+// runSingleModuleDevShow prints the development version of a single module.
+func runSingleModuleDevShow(ctx context.Context, cmd *cli.Command, cfg *config.Config, path string) error {
+	if _, err := clix.FromCommandFn(cmd); err != nil {
+		return err
+	}
+
+	// Release tags are named by the tag manager, enabled or not
+	tm := tagmanager.GetTagManagerFn()
+	if tm == nil {
+		tmCfg := tagmanager.DefaultConfig()
+		if cfg.Plugins != nil && cfg.Plugins.TagManager != nil {
+			tmCfg.Prefix = cfg.Plugins.TagManager.GetPrefix()
+		}
+		tm = tagmanager.NewTagManager(tmCfg)
+	}
+	opts := semver.DevVersionOptions{
+		FormatTag: tm.FormatTagName,
+		Template:  cfg.DevVersion.GetTemplate(),
+	}
+
+	version, err := semver.DevVersion(ctx, path, opts)
+	if err != nil {
+		return fmt.Errorf("failed to compute development version for %s: %w", path, err)
+	}
+
+	fmt.Println(version.String())
+	return nil
+}
+
 // runMultiModuleShow handles the multi-module show operation.
 func runMultiModuleShow(ctx context.Context, cmd *cli.Command, execCtx *clix.ExecutionContext) error {
 	fs := core.NewOSFileSystem()
@@ -71,6 +113,7 @@ func runMultiModuleShow(ctx context.Context, cmd *cli.Command, execCtx *clix.Exe
 	executor := workspace.NewExecutor(
 		workspace.WithParallel(parallel),
 		workspace.WithFailFast(failFast),
+		workspace.WithJobs(cmd.Int("jobs")),
 	)
 
 	// Execute the operation on all modules
@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/semver"
 	"github.com/indaco/verso/internal/testutils"
 	"github.com/urfave/cli/v3"
 )
@@ -512,3 +514,66 @@ func TestCLI_ShowCommand_MultiModule_SuccessQuiet(t *testing.T) {
 		t.Errorf("expected quiet success summary with 2 modules, got: %q", output)
 	}
 }
+
+func TestCLI_ShowCommand_Dev(t *testing.T) {
+	tmpDir := t.TempDir()
+	testutils.WriteTempVersionFile(t, tmpDir, "1.2.3")
+	versionPath := filepath.Join(tmpDir, ".version")
+
+	git := &semver.MockGitTagReader{Worktree: semver.WorktreeState{Tag: "release-1.2.3", Commits: 7, Hash: "3f2a1c9", Dirty: true}}
+	restore := semver.SetDefaultManager(semver.NewVersionManager(core.NewOSFileSystem(), git))
+	defer restore()
+
+	cfg := &config.Config{
+		Path:    versionPath,
+		Plugins: &config.PluginConfig{TagManager: &config.TagManagerConfig{Prefix: "release-"}},
+	}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "show", "--dev"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("Failed to capture stdout: %v", err)
+	}
+
+	if output != "1.2.4-dev.7+g3f2a1c9.dirty" {
+		t.Errorf("expected output '1.2.4-dev.7+g3f2a1c9.dirty', got %q", output)
+	}
+
+	// Custom template from config
+	cfg.DevVersion = &config.DevVersionConfig{Template: "snapshot.{{.Commits}}"}
+	appCli = testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	output, err = testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"verso", "show", "--dev"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("Failed to capture stdout: %v", err)
+	}
+
+	if output != "1.2.4-snapshot.7" {
+		t.Errorf("expected output '1.2.4-snapshot.7', got %q", output)
+	}
+}
+
+func TestCLI_ShowCommand_Dev_GitError(t *testing.T) {
+	tmpDir := t.TempDir()
+	testutils.WriteTempVersionFile(t, tmpDir, "1.2.3")
+	versionPath := filepath.Join(tmpDir, ".version")
+
+	git := &semver.MockGitTagReader{Err: fmt.Errorf("not a git repository")}
+	restore := semver.SetDefaultManager(semver.NewVersionManager(core.NewOSFileSystem(), git))
+	defer restore()
+
+	cfg := &config.Config{Path: versionPath}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	err := appCli.Run(context.Background(), []string{"verso", "show", "--dev"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "failed to compute development version") {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
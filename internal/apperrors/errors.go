@@ -91,3 +91,19 @@ func (e *HookError) Error() string {
 func (e *HookError) Unwrap() error {
 	return e.Err
 }
+
+// LockError indicates that a file is locked by another process.
+type LockError struct {
+	Path     string
+	LockPath string
+	Holder   string
+}
+
+func (e *LockError) Error() string {
+	holder := ""
+	if e.Holder != "" {
+		holder = fmt.Sprintf(" (%s)", e.Holder)
+	}
+	return fmt.Sprintf("%s is locked by another verso process%s; retry later, or remove %s if no other process is running",
+		e.Path, holder, e.LockPath)
+}
@@ -109,3 +109,12 @@ func TestHookError(t *testing.T) {
 		t.Error("expected errors.Is to match inner error")
 	}
 }
+
+func TestLockError(t *testing.T) {
+	err := &LockError{Path: ".version", LockPath: ".version.lock", Holder: "pid 42 on ci-runner"}
+	expected := ".version is locked by another verso process (pid 42 on ci-runner); retry later, or remove .version.lock if no other process is running"
+
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
@@ -0,0 +1,267 @@
+// Package changeset reads and writes change files: pending release intents
+// recorded by contributors with "verso change add" and consumed by
+// "verso bump auto".
+//
+// A change file is a Markdown file with a front matter mapping module names
+// to bump levels, followed by a summary:
+//
+//	---
+//	api: minor
+//	web: patch
+//	---
+//
+//	Add search to the API and the web client.
+//
+// In a single-module repository the module is named ".".
+package changeset
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/indaco/verso/internal/core"
+)
+
+// DefaultDir is the directory of pending change files, relative to the
+// repository root.
+const DefaultDir = ".changes/pending"
+
+// RootModule is the module name of a single-module repository.
+const RootModule = "."
+
+// Bump levels of a change, from the lowest.
+var levels = []string{"patch", "minor", "major"}
+
+// Function variables for testability.
+var (
+	nowFn = time.Now
+)
+
+// Change is a pending release intent.
+type Change struct {
+	// Path is the path of the change file.
+	Path string `json:"path"`
+
+	// Bumps maps module names to bump levels (patch, minor or major).
+	Bumps map[string]string `json:"bumps"`
+
+	// Summary describes the change, for the changelog.
+	Summary string `json:"summary"`
+}
+
+// Parse parses the content of a change file.
+func Parse(data []byte) (*Change, error) {
+	content := strings.ReplaceAll(string(data), "\r\n", "\n")
+	rest, ok := strings.CutPrefix(content, "---\n")
+	if !ok {
+		return nil, errors.New("missing front matter")
+	}
+	frontMatter, summary, ok := strings.Cut(rest, "\n---")
+	if !ok {
+		return nil, errors.New("unterminated front matter")
+	}
+
+	c := &Change{Bumps: map[string]string{}, Summary: strings.TrimSpace(summary)}
+	for line := range strings.SplitSeq(frontMatter, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		module, level, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid line %q: want <module>: <level>", line)
+		}
+		module = strings.Trim(strings.TrimSpace(module), `"'`)
+		level = strings.Trim(strings.TrimSpace(level), `"'`)
+		if !slices.Contains(levels, level) {
+			return nil, fmt.Errorf("invalid bump level %q for %s (want patch, minor or major)", level, module)
+		}
+		c.Bumps[module] = level
+	}
+	if len(c.Bumps) == 0 {
+		return nil, errors.New("no module bumps")
+	}
+	return c, nil
+}
+
+// Format renders the change as the content of a change file. Modules are
+// sorted by name.
+func (c *Change) Format() []byte {
+	var sb strings.Builder
+	sb.WriteString("---\n")
+	for _, module := range slices.Sorted(maps.Keys(c.Bumps)) {
+		name := module
+		if name == RootModule {
+			name = `"."`
+		}
+		fmt.Fprintf(&sb, "%s: %s\n", name, c.Bumps[module])
+	}
+	sb.WriteString("---\n")
+	if c.Summary != "" {
+		fmt.Fprintf(&sb, "\n%s\n", strings.TrimSpace(c.Summary))
+	}
+	return []byte(sb.String())
+}
+
+// Load reads the change files in dir, oldest first. A missing
+// directory holds no changes.
+func Load(fs core.FileSystem, dir string) ([]*Change, error) {
+	entries, err := fs.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read change files: %w", err)
+	}
+
+	var changes []*Change
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data, err := fs.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read change file: %w", err)
+		}
+		c, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid change file %s: %w", path, err)
+		}
+		c.Path = path
+		changes = append(changes, c)
+	}
+	// File names start with their creation time
+	slices.SortFunc(changes, func(a, b *Change) int {
+		return strings.Compare(strings.TrimSuffix(a.Path, ".md"), strings.TrimSuffix(b.Path, ".md"))
+	})
+	return changes, nil
+}
+
+// Write creates a change file in dir, named after the current time and the
+// summary, and sets c.Path.
+func Write(fs core.FileSystem, dir string, c *Change) error {
+	if err := fs.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	base := nowFn().Format("20060102-150405")
+	if slug := slugify(c.Summary); slug != "" {
+		base += "-" + slug
+	}
+	for i := 1; ; i++ {
+		name := base + ".md"
+		if i > 1 {
+			name = fmt.Sprintf("%s-%d.md", base, i)
+		}
+		path := filepath.Join(dir, name)
+		err := fs.CreateExclusive(path, c.Format(), 0644)
+		if errors.Is(err, os.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write change file: %w", err)
+		}
+		c.Path = path
+		return nil
+	}
+}
+
+// Remove deletes the files of changes.
+func Remove(fs core.FileSystem, changes []*Change) error {
+	var errs []error
+	for _, c := range changes {
+		if err := fs.Remove(c.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, fmt.Errorf("failed to remove change file: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Consume removes the bumps of the released modules from changes. Change
+// files left without bumps are deleted, the others are rewritten with the
+// remaining modules. It returns the number of deleted files.
+func Consume(fs core.FileSystem, changes []*Change, released []string) (int, error) {
+	var consumed []*Change
+	var errs []error
+	for _, c := range changes {
+		n := len(c.Bumps)
+		for _, module := range released {
+			delete(c.Bumps, module)
+		}
+		switch {
+		case len(c.Bumps) == 0:
+			consumed = append(consumed, c)
+		case len(c.Bumps) < n:
+			if err := fs.WriteFile(c.Path, c.Format(), 0644); err != nil {
+				errs = append(errs, fmt.Errorf("failed to update change file: %w", err))
+			}
+		}
+	}
+	if err := Remove(fs, consumed); err != nil {
+		errs = append(errs, err)
+	}
+	return len(consumed), errors.Join(errs...)
+}
+
+// Level returns the highest bump level of the named module in changes, or
+// an empty string if no change bumps it.
+func Level(changes []*Change, module string) string {
+	best := ""
+	for _, c := range changes {
+		if level, ok := c.Bumps[module]; ok && higher(level, best) {
+			best = level
+		}
+	}
+	return best
+}
+
+// HighestLevel returns the highest bump level of any module in changes, or
+// an empty string if there are no changes.
+func HighestLevel(changes []*Change) string {
+	best := ""
+	for _, c := range changes {
+		for _, level := range c.Bumps {
+			if higher(level, best) {
+				best = level
+			}
+		}
+	}
+	return best
+}
+
+// Summaries returns the summaries of the changes bumping the named module.
+// An empty module selects every change.
+func Summaries(changes []*Change, module string) []string {
+	var summaries []string
+	for _, c := range changes {
+		if _, ok := c.Bumps[module]; (ok || module == "") && c.Summary != "" {
+			summaries = append(summaries, c.Summary)
+		}
+	}
+	return summaries
+}
+
+// higher reports whether level is a higher bump than other.
+func higher(level, other string) bool {
+	return slices.Index(levels, level) > slices.Index(levels, other)
+}
+
+// slugRe matches runs of characters not allowed in file names.
+var slugRe = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify returns the first words of the summary as a file name fragment.
+func slugify(summary string) string {
+	words := strings.Fields(strings.ToLower(summary))
+	if len(words) > 5 {
+		words = words[:5]
+	}
+	return strings.Trim(slugRe.ReplaceAllString(strings.Join(words, "-"), "-"), "-")
+}
@@ -0,0 +1,184 @@
+package changeset
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/indaco/verso/internal/core"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantBumps   map[string]string
+		wantSummary string
+		wantErr     string
+	}{
+		{
+			name:        "modules and summary",
+			content:     "---\napi: minor\n\"web\": patch\n---\n\nAdd search.\n\nWith details.\n",
+			wantBumps:   map[string]string{"api": "minor", "web": "patch"},
+			wantSummary: "Add search.\n\nWith details.",
+		},
+		{
+			name:      "root module without summary",
+			content:   "---\n\".\": major\n---\n",
+			wantBumps: map[string]string{".": "major"},
+		},
+		{
+			name:        "windows line endings",
+			content:     "---\r\napi: patch\r\n---\r\nFix.\r\n",
+			wantBumps:   map[string]string{"api": "patch"},
+			wantSummary: "Fix.",
+		},
+		{name: "missing front matter", content: "api: minor\n", wantErr: "missing front matter"},
+		{name: "unterminated front matter", content: "---\napi: minor\n", wantErr: "unterminated front matter"},
+		{name: "invalid level", content: "---\napi: huge\n---\n", wantErr: `invalid bump level "huge" for api`},
+		{name: "invalid line", content: "---\napi\n---\n", wantErr: `invalid line "api"`},
+		{name: "no bumps", content: "---\n# nothing\n---\n", wantErr: "no module bumps"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse([]byte(tt.content))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Parse() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !maps.Equal(c.Bumps, tt.wantBumps) {
+				t.Errorf("Bumps = %v, want %v", c.Bumps, tt.wantBumps)
+			}
+			if c.Summary != tt.wantSummary {
+				t.Errorf("Summary = %q, want %q", c.Summary, tt.wantSummary)
+			}
+		})
+	}
+}
+
+func TestWriteAndLoad(t *testing.T) {
+	origNow := nowFn
+	defer func() { nowFn = origNow }()
+	nowFn = func() time.Time { return time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC) }
+
+	fs := core.NewMockFileSystem()
+	first := &Change{Bumps: map[string]string{"web": "patch", "api": "minor"}, Summary: "Add search to the API!"}
+	second := &Change{Bumps: map[string]string{"api": "minor"}, Summary: "Add search to the API!"}
+	for _, c := range []*Change{first, second} {
+		if err := Write(fs, DefaultDir, c); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	if want := ".changes/pending/20260301-103000-add-search-to-the-api.md"; first.Path != want {
+		t.Errorf("Path = %q, want %q", first.Path, want)
+	}
+	if want := ".changes/pending/20260301-103000-add-search-to-the-api-2.md"; second.Path != want {
+		t.Errorf("Path = %q, want %q", second.Path, want)
+	}
+	if data, _ := fs.ReadFile(first.Path); string(data) != "---\napi: minor\nweb: patch\n---\n\nAdd search to the API!\n" {
+		t.Errorf("content = %q", data)
+	}
+
+	changes, err := Load(fs, DefaultDir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(changes) != 2 || changes[0].Path != first.Path || !maps.Equal(changes[0].Bumps, first.Bumps) {
+		t.Fatalf("Load() = %+v", changes)
+	}
+
+	if err := Remove(fs, changes); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if changes, _ := Load(fs, DefaultDir); len(changes) != 0 {
+		t.Errorf("expected no changes after Remove(), got %d", len(changes))
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	changes, err := Load(core.NewMockFileSystem(), "/missing")
+	if err != nil || changes != nil {
+		t.Errorf("Load() of an empty directory = %v, %v", changes, err)
+	}
+
+	fs := core.NewMockFileSystem()
+	fs.SetFile("/repo/.changes/pending/bad.md", []byte("no front matter"))
+	if _, err := Load(fs, "/repo/.changes/pending"); err == nil || !strings.Contains(err.Error(), "bad.md") {
+		t.Errorf("Load() error = %v, want the invalid file named", err)
+	}
+
+	fs = core.NewMockFileSystem()
+	fs.SetFile("/repo/.changes/pending/a.md", []byte("---\napi: patch\n---\n"))
+	fs.RemoveErr = errors.New("permission denied")
+	changes, _ = Load(fs, "/repo/.changes/pending")
+	if err := Remove(fs, changes); err == nil {
+		t.Error("Remove() expected error")
+	}
+}
+
+func TestConsume(t *testing.T) {
+	fs := core.NewMockFileSystem()
+	both := &Change{Path: "/p/a.md", Bumps: map[string]string{"api": "minor", "web": "patch"}, Summary: "Search."}
+	api := &Change{Path: "/p/b.md", Bumps: map[string]string{"api": "patch"}}
+	docs := &Change{Path: "/p/c.md", Bumps: map[string]string{"docs": "patch"}}
+	for _, c := range []*Change{both, api, docs} {
+		fs.SetFile(c.Path, c.Format())
+	}
+
+	removed, err := Consume(fs, []*Change{both, api, docs}, []string{"api"})
+	if err != nil {
+		t.Fatalf("Consume() error = %v", err)
+	}
+	if removed != 1 {
+		t.Errorf("Consume() removed %d files, want 1", removed)
+	}
+	if _, err := fs.ReadFile("/p/b.md"); err == nil {
+		t.Error("expected the fully consumed change file to be removed")
+	}
+	if data, _ := fs.ReadFile("/p/a.md"); string(data) != "---\nweb: patch\n---\n\nSearch.\n" {
+		t.Errorf("partially consumed change file = %q", data)
+	}
+	if data, _ := fs.ReadFile("/p/c.md"); string(data) != string(docs.Format()) {
+		t.Errorf("untouched change file = %q", data)
+	}
+}
+
+func TestLevels(t *testing.T) {
+	changes := []*Change{
+		{Bumps: map[string]string{"api": "patch", "web": "minor"}, Summary: "First."},
+		{Bumps: map[string]string{"api": "major"}, Summary: "Second."},
+		{Bumps: map[string]string{"docs": "patch"}},
+	}
+
+	for module, want := range map[string]string{"api": "major", "web": "minor", "docs": "patch", "cli": ""} {
+		if got := Level(changes, module); got != want {
+			t.Errorf("Level(%s) = %q, want %q", module, got, want)
+		}
+	}
+	if got := HighestLevel(changes); got != "major" {
+		t.Errorf("HighestLevel() = %q, want major", got)
+	}
+	if got := HighestLevel(nil); got != "" {
+		t.Errorf("HighestLevel(nil) = %q, want empty", got)
+	}
+
+	if got := Summaries(changes, "api"); !slices.Equal(got, []string{"First.", "Second."}) {
+		t.Errorf("Summaries(api) = %v", got)
+	}
+	if got := Summaries(changes, "web"); !slices.Equal(got, []string{"First."}) {
+		t.Errorf("Summaries(web) = %v", got)
+	}
+	if got := Summaries(changes, ""); len(got) != 2 {
+		t.Errorf("Summaries() = %v, want every summary", got)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/indaco/verso/internal/apperrors"
 	"github.com/indaco/verso/internal/config"
 	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/plugins/commitparser/gitlog"
 	"github.com/indaco/verso/internal/semver"
 	"github.com/indaco/verso/internal/tui"
 	"github.com/indaco/verso/internal/workspace"
@@ -25,6 +26,8 @@ func fromCommand(cmd *cli.Command) (bool, error) {
 
 // GetOrInitVersionFile initializes the version file at the given path
 // or checks for its existence based on the strict flag.
+// In strict mode the existing file must also hold an exact SemVer 2.0.0 version
+// (see semver.ParseVersionStrict).
 // It returns true if the file was created, false if it already existed.
 // Returns a typed error (*apperrors.VersionFileNotFoundError) instead of cli.Exit.
 func GetOrInitVersionFile(path string, strict bool) (bool, error) {
@@ -32,6 +35,9 @@ func GetOrInitVersionFile(path string, strict bool) (bool, error) {
 		if _, err := os.Stat(path); err != nil {
 			return false, &apperrors.VersionFileNotFoundError{Path: path}
 		}
+		if _, err := semver.ReadVersionStrict(path); err != nil {
+			return false, fmt.Errorf("invalid version file at %s: %w", path, err)
+		}
 		return false, nil
 	}
 
@@ -102,6 +108,18 @@ type ExecutionContext struct {
 	// Selection contains the TUI selection result for multi-module mode.
 	// Used to determine if user selected all or specific modules.
 	Selection tui.Selection
+
+	// Graph holds the dependencies between all modules of the workspace,
+	// selected or not. Nil for single-module mode.
+	Graph *workspace.Graph
+
+	// Cascade is the bump applied to the dependents of bumped modules
+	// (patch, minor or major), or "none".
+	Cascade string
+
+	// Groups holds the lockstep groups of the workspace, with all their
+	// members, selected or not. Nil for single-module mode.
+	Groups []*workspace.Group
 }
 
 // IsSingleModule returns true if this is single-module execution.
@@ -118,7 +136,7 @@ func (ec *ExecutionContext) IsMultiModule() bool {
 // It follows this logic:
 //  1. If --path flag provided -> single-module mode
 //  2. If .verso.yaml has explicit path (not default) -> single-module mode
-//  3. If --all or --module flags -> multi-module mode (skip TUI)
+//  3. If --all, --module, --changed or --changed-since flags -> multi-module mode (skip TUI)
 //  4. Detect context using workspace.Detector
 //  5. If MultiModule detected and interactive -> show TUI prompt
 //  6. If MultiModule detected and non-interactive (CI or --yes) -> auto-select all
@@ -127,6 +145,19 @@ func (ec *ExecutionContext) IsMultiModule() bool {
 // The cmd parameter provides access to CLI flags.
 // The cfg parameter provides workspace configuration.
 func GetExecutionContext(ctx context.Context, cmd *cli.Command, cfg *config.Config) (*ExecutionContext, error) {
+	return getExecutionContext(ctx, cmd, cfg, true)
+}
+
+// GetWorkspaceContext determines the execution context like
+// GetExecutionContext, but never prompts: without --module or --changed, every
+// module of a workspace is selected. It suits commands that only report.
+func GetWorkspaceContext(ctx context.Context, cmd *cli.Command, cfg *config.Config) (*ExecutionContext, error) {
+	return getExecutionContext(ctx, cmd, cfg, false)
+}
+
+// getExecutionContext determines the execution context, showing the module
+// selection prompt if prompt is true and the session is interactive.
+func getExecutionContext(ctx context.Context, cmd *cli.Command, cfg *config.Config, prompt bool) (*ExecutionContext, error) {
 	// Check if --path flag is provided
 	if cmd.IsSet("path") {
 		path := cmd.String("path")
@@ -148,10 +179,11 @@ func GetExecutionContext(ctx context.Context, cmd *cli.Command, cfg *config.Conf
 	// Check for multi-module flags
 	hasAll := cmd.Bool("all")
 	hasModule := cmd.IsSet("module")
+	hasChanged := isChangedSelection(cmd)
 
 	// If explicit multi-module flags are set, detect modules
-	if hasAll || hasModule {
-		return getMultiModuleContext(ctx, cmd, cfg, true)
+	if hasAll || hasModule || hasChanged {
+		return getMultiModuleContext(ctx, cmd, cfg, true, prompt)
 	}
 
 	// Detect workspace context
@@ -178,7 +210,7 @@ func GetExecutionContext(ctx context.Context, cmd *cli.Command, cfg *config.Conf
 
 	case workspace.MultiModule:
 		// Multiple modules found, determine if we should prompt
-		return getMultiModuleContext(ctx, cmd, cfg, false)
+		return getMultiModuleContext(ctx, cmd, cfg, false, prompt)
 
 	case workspace.NoModules:
 		// No modules found, fall back to default path
@@ -198,7 +230,7 @@ func GetExecutionContext(ctx context.Context, cmd *cli.Command, cfg *config.Conf
 
 // getMultiModuleContext handles multi-module execution context setup.
 // It discovers modules, filters based on flags, and optionally shows TUI.
-func getMultiModuleContext(ctx context.Context, cmd *cli.Command, cfg *config.Config, skipDetection bool) (*ExecutionContext, error) {
+func getMultiModuleContext(ctx context.Context, cmd *cli.Command, cfg *config.Config, skipDetection, prompt bool) (*ExecutionContext, error) {
 	cwd, err := os.Getwd()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get working directory: %w", err)
@@ -217,6 +249,17 @@ func getMultiModuleContext(ctx context.Context, cmd *cli.Command, cfg *config.Co
 		return nil, fmt.Errorf("no modules found in workspace")
 	}
 
+	graph, err := workspace.BuildGraph(fs, modules, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build module dependency graph: %w", err)
+	}
+	cascade := cascadeLevel(cfg)
+
+	groups, err := workspace.BuildGroups(modules, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid workspace groups: %w", err)
+	}
+
 	// Filter modules based on --module flag
 	if cmd.IsSet("module") {
 		moduleName := cmd.String("module")
@@ -226,8 +269,20 @@ func getMultiModuleContext(ctx context.Context, cmd *cli.Command, cfg *config.Co
 		}
 	}
 
+	// Filter modules based on --changed and --changed-since flags
+	if isChangedSelection(cmd) {
+		modules, err = FilterChangedModules(modules, cmd.String("changed-since"))
+		if err != nil {
+			return nil, err
+		}
+		if len(modules) == 0 {
+			fmt.Fprintln(os.Stderr, "No modules changed since their last release")
+		}
+	}
+
 	// Check if we should skip TUI prompt
-	shouldPrompt := tui.IsInteractive() && !cmd.Bool("yes") && !cmd.Bool("non-interactive") && !cmd.Bool("all")
+	shouldPrompt := prompt && tui.IsInteractive() && !cmd.Bool("yes") && !cmd.Bool("non-interactive") && !cmd.Bool("all") &&
+		!isChangedSelection(cmd)
 
 	if shouldPrompt {
 		// Show TUI module selection
@@ -250,6 +305,9 @@ func getMultiModuleContext(ctx context.Context, cmd *cli.Command, cfg *config.Co
 			Mode:      MultiModuleMode,
 			Modules:   modules,
 			Selection: selection,
+			Graph:     graph,
+			Cascade:   cascade,
+			Groups:    groups,
 		}, nil
 	}
 
@@ -258,9 +316,43 @@ func getMultiModuleContext(ctx context.Context, cmd *cli.Command, cfg *config.Co
 		Mode:      MultiModuleMode,
 		Modules:   modules,
 		Selection: tui.AllModules(),
+		Graph:     graph,
+		Cascade:   cascade,
+		Groups:    groups,
 	}, nil
 }
 
+// cascadeLevel returns the configured bump cascade level.
+func cascadeLevel(cfg *config.Config) string {
+	if cfg == nil || cfg.Workspace == nil {
+		return "none"
+	}
+	return cfg.Workspace.Dependencies.GetCascade()
+}
+
+// isChangedSelection reports whether the --changed or --changed-since flag selects modules.
+func isChangedSelection(cmd *cli.Command) bool {
+	return cmd.Bool("changed") || cmd.String("changed-since") != ""
+}
+
+// FilterChangedModules filters modules to those whose directory has commits
+// since ref. An empty ref selects commits since each module's last tag
+// ("<name>/v1.2.3", "<name>@1.2.3" or "<name>-v1.2.3"), falling back to the
+// last tag of the repository.
+func FilterChangedModules(modules []*workspace.Module, ref string) ([]*workspace.Module, error) {
+	filtered := []*workspace.Module{}
+	for _, mod := range modules {
+		changed, err := gitlog.HasModuleChangesFn(mod.Name, mod.Dir, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to detect changes in module %s: %w", mod.Name, err)
+		}
+		if changed {
+			filtered = append(filtered, mod)
+		}
+	}
+	return filtered, nil
+}
+
 // filterModulesByName filters modules to only include the one with the given name.
 func filterModulesByName(modules []*workspace.Module, name string) []*workspace.Module {
 	for _, mod := range modules {
@@ -40,6 +40,19 @@ func TestGetOrInitVersionFile(t *testing.T) {
 		}
 	})
 
+	t.Run("strict=true and file not spec-exact", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		tmpFile := testutils.WriteTempVersionFile(t, tmpDir, "1.2.3-rc.01")
+
+		_, err := getOrInitVersionFile(tmpFile, true)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if !strings.Contains(err.Error(), "leading zero in numeric pre-release identifier") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
 	t.Run("strict=false and initialization succeeds", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		targetPath := filepath.Join(tmpDir, ".version")
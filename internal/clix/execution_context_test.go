@@ -2,10 +2,13 @@ package clix
 
 import (
 	"context"
+	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/plugins/commitparser/gitlog"
 	"github.com/indaco/verso/internal/workspace"
 	"github.com/urfave/cli/v3"
 )
@@ -616,6 +619,46 @@ func TestGetExecutionContext_AllFlag(t *testing.T) {
 	}
 }
 
+func TestGetWorkspaceContext(t *testing.T) {
+	tmpDir := t.TempDir()
+	for name, version := range map[string]string{"module-a": "1.0.0", "module-b": "2.0.0"} {
+		if err := os.MkdirAll(tmpDir+"/"+name, 0755); err != nil {
+			t.Fatalf("failed to create %s dir: %v", name, err)
+		}
+		if err := os.WriteFile(tmpDir+"/"+name+"/.version", []byte(version), 0644); err != nil {
+			t.Fatalf("failed to write %s version: %v", name, err)
+		}
+	}
+
+	cmd := &cli.Command{
+		Name: "test",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "path"},
+			&cli.StringFlag{Name: "module"},
+		},
+	}
+
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	defer func() { _ = os.Chdir(origDir) }()
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("failed to change to tmpDir: %v", err)
+	}
+
+	execCtx, err := GetWorkspaceContext(context.Background(), cmd, &config.Config{})
+	if err != nil {
+		t.Fatalf("GetWorkspaceContext() error = %v", err)
+	}
+	if execCtx.Mode != MultiModuleMode {
+		t.Errorf("Mode = %v, want MultiModuleMode", execCtx.Mode)
+	}
+	if len(execCtx.Modules) != 2 || !execCtx.Selection.All {
+		t.Errorf("expected every module to be selected, got %d (all = %v)", len(execCtx.Modules), execCtx.Selection.All)
+	}
+}
+
 func TestGetExecutionContext_ModuleFlag(t *testing.T) {
 	// This test is difficult to implement without a full CLI context
 	// as cmd.IsSet() requires the flag to be actually set via CLI parsing.
@@ -752,7 +795,7 @@ func TestGetMultiModuleContext_NoModulesFound(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	_, ctxErr := getMultiModuleContext(ctx, cmd, cfg, false)
+	_, ctxErr := getMultiModuleContext(ctx, cmd, cfg, false, true)
 	if ctxErr == nil {
 		t.Fatal("expected error for no modules found")
 	}
@@ -811,7 +854,7 @@ func TestGetMultiModuleContext_NonInteractive(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	execCtx, err := getMultiModuleContext(ctx, cmd, cfg, false)
+	execCtx, err := getMultiModuleContext(ctx, cmd, cfg, false, true)
 	if err != nil {
 		t.Fatalf("getMultiModuleContext() error = %v", err)
 	}
@@ -842,3 +885,94 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestFilterChangedModules(t *testing.T) {
+	original := gitlog.HasModuleChangesFn
+	defer func() { gitlog.HasModuleChangesFn = original }()
+
+	var gotRefs []string
+	gitlog.HasModuleChangesFn = func(name, dir, since string) (bool, error) {
+		gotRefs = append(gotRefs, since)
+		return name == "api", nil
+	}
+
+	modules := []*workspace.Module{
+		{Name: "api", Dir: "/repo/api"},
+		{Name: "web", Dir: "/repo/web"},
+	}
+
+	filtered, err := FilterChangedModules(modules, "main")
+	if err != nil {
+		t.Fatalf("FilterChangedModules() error = %v", err)
+	}
+	if len(filtered) != 1 || filtered[0].Name != "api" {
+		t.Errorf("FilterChangedModules() = %v, want [api]", filtered)
+	}
+	for _, ref := range gotRefs {
+		if ref != "main" {
+			t.Errorf("expected ref %q to be passed, got %q", "main", ref)
+		}
+	}
+
+	gitlog.HasModuleChangesFn = func(name, dir, since string) (bool, error) {
+		return false, errors.New("not a git repository")
+	}
+	if _, err := FilterChangedModules(modules, ""); err == nil {
+		t.Error("FilterChangedModules() expected error, got nil")
+	}
+}
+
+func TestGetExecutionContext_Changed(t *testing.T) {
+	original := gitlog.HasModuleChangesFn
+	defer func() { gitlog.HasModuleChangesFn = original }()
+	gitlog.HasModuleChangesFn = func(name, dir, since string) (bool, error) {
+		return name == "web" && since == "v1.0.0", nil
+	}
+
+	tmpDir := t.TempDir()
+	for _, name := range []string{"api", "web"} {
+		dir := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, ".version"), []byte("1.0.0"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.Chdir(origDir) }()
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatal(err)
+	}
+
+	var execCtx *ExecutionContext
+	cmd := &cli.Command{
+		Name: "test",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "path"},
+			&cli.BoolFlag{Name: "all"},
+			&cli.StringFlag{Name: "module"},
+			&cli.BoolFlag{Name: "changed"},
+			&cli.StringFlag{Name: "changed-since"},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			var err error
+			execCtx, err = GetExecutionContext(ctx, cmd, &config.Config{Path: ".version"})
+			return err
+		},
+	}
+
+	if err := cmd.Run(context.Background(), []string{"test", "--changed-since", "v1.0.0"}); err != nil {
+		t.Fatalf("GetExecutionContext() error = %v", err)
+	}
+	if !execCtx.IsMultiModule() {
+		t.Fatalf("expected multi-module mode, got %v", execCtx.Mode)
+	}
+	if len(execCtx.Modules) != 1 || execCtx.Modules[0].Name != "web" {
+		t.Errorf("expected only the changed module web, got %v", execCtx.Modules)
+	}
+}
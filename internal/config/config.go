@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 
 	"github.com/goccy/go-yaml"
+	"github.com/indaco/verso/internal/semver"
 )
 
 type PluginConfig struct {
-	CommitParser       bool                      `yaml:"commit-parser"`
+	CommitParser       *CommitParserConfig       `yaml:"commit-parser,omitempty"`
 	TagManager         *TagManagerConfig         `yaml:"tag-manager,omitempty"`
 	VersionValidator   *VersionValidatorConfig   `yaml:"version-validator,omitempty"`
 	DependencyCheck    *DependencyCheckConfig    `yaml:"dependency-check,omitempty"`
@@ -20,6 +22,70 @@ type PluginConfig struct {
 	AuditLog           *AuditLogConfig           `yaml:"audit-log,omitempty"`
 }
 
+// CommitParserConfig holds configuration for the commit parser plugin.
+// It can also be written as a bool ("commit-parser: true") to only toggle it.
+// A block is enabled unless it sets "enabled: false".
+type CommitParserConfig struct {
+	// Enabled controls whether the plugin is active (default: true for a block).
+	Enabled bool `yaml:"enabled"`
+
+	// Convention is the commit message convention: conventional (default),
+	// angular, gitmoji or regex.
+	Convention string `yaml:"convention,omitempty"`
+
+	// Pattern is the subject regex of the regex convention, with named groups
+	// "type" (required), "scope", "breaking" and "description".
+	Pattern string `yaml:"pattern,omitempty"`
+
+	// Types maps commit types to bump levels: major, minor, patch or none.
+	// Entries extend the convention's built-in mapping (e.g. feat: minor, fix: patch).
+	Types map[string]string `yaml:"types,omitempty"`
+
+	// Scopes maps commit scopes to bump levels, overriding Types.
+	Scopes map[string]string `yaml:"scopes,omitempty"`
+
+	// Default is the bump level for types not listed in Types (default: "none").
+	Default string `yaml:"default,omitempty"`
+
+	// IgnoreScopes lists scopes whose commits never affect the bump,
+	// including breaking changes.
+	IgnoreScopes []string `yaml:"ignore-scopes,omitempty"`
+
+	// History selects the analyzed commits: all (default, merge commits
+	// skipped), first-parent (pull request merges analyzed through their
+	// body) or squash (only pull request squash commits, e.g. "feat: x (#12)").
+	History string `yaml:"history,omitempty"`
+}
+
+// IsEnabled reports whether the commit parser is configured and enabled.
+func (c *CommitParserConfig) IsEnabled() bool {
+	return c != nil && c.Enabled
+}
+
+// UnmarshalYAML accepts either a bool or a configuration block. A block
+// enables the parser unless it sets "enabled: false".
+func (c *CommitParserConfig) UnmarshalYAML(unmarshal func(any) error) error {
+	var enabled bool
+	if err := unmarshal(&enabled); err == nil {
+		*c = CommitParserConfig{Enabled: enabled}
+		return nil
+	}
+
+	type plain CommitParserConfig
+	*c = CommitParserConfig{Enabled: true}
+	return unmarshal((*plain)(c))
+}
+
+// MarshalYAML writes the bool shorthand when only Enabled is set.
+func (c *CommitParserConfig) MarshalYAML() (any, error) {
+	if c.Convention == "" && c.Pattern == "" && len(c.Types) == 0 && len(c.Scopes) == 0 &&
+		c.Default == "" && len(c.IgnoreScopes) == 0 && c.History == "" {
+		return c.Enabled, nil
+	}
+	type plain CommitParserConfig
+	return (*plain)(c), nil
+}
+
 // TagManagerConfig holds configuration for the tag manager plugin.
 type TagManagerConfig struct {
 	// Enabled controls whether the plugin is active.
@@ -117,6 +183,9 @@ type DependencyFileConfig struct {
 
 	// Pattern is the regex pattern for "regex" format.
 	Pattern string `yaml:"pattern,omitempty"`
+
+	// Dialect is the ecosystem version syntax: semver (default), pep440, maven, nuget
+	Dialect string `yaml:"dialect,omitempty"`
 }
 
 // ChangelogParserConfig holds configuration for the changelog parser plugin.
@@ -347,6 +416,9 @@ type ModuleConfig struct {
 
 	// Enabled controls whether this module is active (default: true).
 	Enabled *bool `yaml:"enabled,omitempty"`
+
+	// DependsOn lists the names of the modules this module depends on.
+	DependsOn []string `yaml:"depends-on,omitempty"`
 }
 
 // WorkspaceConfig configures multi-module/monorepo behavior.
@@ -356,10 +428,121 @@ type WorkspaceConfig struct {
 
 	// Modules explicitly defines modules (overrides discovery if non-empty).
 	Modules []ModuleConfig `yaml:"modules,omitempty"`
+
+	// Dependencies configures dependencies between modules.
+	Dependencies *DependenciesConfig `yaml:"dependencies,omitempty"`
+
+	// Groups lists sets of modules versioned in lockstep.
+	Groups []GroupConfig `yaml:"groups,omitempty"`
+}
+
+// GroupConfig defines a fixed group: modules that always share a version.
+// Bumping any member bumps every member, and inferred bumps apply the highest
+// bump inferred for a member to all of them.
+type GroupConfig struct {
+	// Name is the group identifier.
+	Name string `yaml:"name"`
+
+	// Modules lists the names of the member modules.
+	Modules []string `yaml:"modules"`
+}
+
+// DependenciesConfig configures dependencies between modules and how bumps
+// cascade to dependent modules.
+type DependenciesConfig struct {
+	// Detect enables detecting dependencies from go.mod and package.json
+	// files, in addition to depends-on (default: true).
+	Detect *bool `yaml:"detect,omitempty"`
+
+	// Cascade is the bump applied to the dependents of a bumped module, whose
+	// references to it are updated: none (default), patch, minor or major.
+	Cascade string `yaml:"cascade,omitempty"`
+}
+
+// GetDetect returns the detect setting with default true.
+func (c *DependenciesConfig) GetDetect() bool {
+	if c == nil || c.Detect == nil {
+		return true
+	}
+	return *c.Detect
+}
+
+// GetCascade returns the cascade level with default "none".
+func (c *DependenciesConfig) GetCascade() string {
+	if c == nil || c.Cascade == "" {
+		return "none"
+	}
+	return c.Cascade
+}
+
+// CalVerConfig configures the calendar versioning scheme.
+type CalVerConfig struct {
+	// Format is the CalVer format string (default: "YYYY.MM.MICRO").
+	// Supported segments: YYYY, YY, 0Y, MM, 0M, WW, 0W, MICRO, PATCH.
+	Format string `yaml:"format,omitempty"`
+}
+
+// GetFormat returns the format with default "YYYY.MM.MICRO".
+func (c *CalVerConfig) GetFormat() string {
+	if c == nil || c.Format == "" {
+		return "YYYY.MM.MICRO"
+	}
+	return c.Format
+}
+
+// DevVersionConfig configures development versions ("verso show --dev").
+type DevVersionConfig struct {
+	// Template is a Go template rendering "pre-release[+build]".
+	// Fields: .Version, .PreRelease, .Tag, .Commits, .Hash, .Dirty.
+	// Default: "{{if .PreRelease}}{{.PreRelease}}.{{end}}dev.{{.Commits}}+g{{.Hash}}{{if .Dirty}}.dirty{{end}}"
+	Template string `yaml:"template,omitempty"`
+}
+
+// GetTemplate returns the template, or empty to use the default.
+func (c *DevVersionConfig) GetTemplate() string {
+	if c == nil {
+		return ""
+	}
+	return c.Template
+}
+
+// PreReleaseConfig configures pre-release channels.
+type PreReleaseConfig struct {
+	// Channels is the ordered channel progression, e.g. [alpha, beta, rc].
+	// Default: semver.DefaultChannels ([alpha, beta, rc])
+	Channels []string `yaml:"channels,omitempty"`
+}
+
+// GetChannels returns the channel progression, or the default when unset.
+func (c *PreReleaseConfig) GetChannels() []string {
+	if c == nil || len(c.Channels) == 0 {
+		return slices.Clone(semver.DefaultChannels)
+	}
+	return c.Channels
+}
+
+// InitialDevelopmentConfig configures bump inference while the major version is 0.
+type InitialDevelopmentConfig struct {
+	// Enabled maps inferred bumps down one level while the major version is 0:
+	// breaking changes bump minor and features bump patch. Default: true.
+	Enabled *bool `yaml:"enabled,omitempty"`
+}
+
+// GetEnabled returns the enabled setting with default true.
+func (c *InitialDevelopmentConfig) GetEnabled() bool {
+	if c == nil || c.Enabled == nil {
+		return true
+	}
+	return *c.Enabled
 }
 
 type Config struct {
 	Path            string                            `yaml:"path"`
+	Scheme          string                            `yaml:"scheme,omitempty"`
+	CalVer          *CalVerConfig                     `yaml:"calver,omitempty"`
+	DevVersion      *DevVersionConfig                 `yaml:"dev-version,omitempty"`
+	PreRelease      *PreReleaseConfig                 `yaml:"pre-release,omitempty"`
+	InitialDev      *InitialDevelopmentConfig         `yaml:"initial-development,omitempty"`
 	Plugins         *PluginConfig                     `yaml:"plugins,omitempty"`
 	Extensions      []ExtensionConfig                 `yaml:"extensions,omitempty"`
 	PreReleaseHooks []map[string]PreReleaseHookConfig `yaml:"pre-release-hooks,omitempty"`
@@ -402,7 +585,7 @@ func loadConfig() (*Config, error) {
 	}
 
 	if cfg.Plugins == nil {
-		cfg.Plugins = &PluginConfig{CommitParser: true}
+		cfg.Plugins = &PluginConfig{CommitParser: &CommitParserConfig{Enabled: true}}
 	}
 
 	return &cfg, nil
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/goccy/go-yaml"
@@ -935,7 +937,7 @@ workspace:
 			check: func(t *testing.T, cfg *Config) {
 				t.Helper()
 				checkExtensionCount(t, cfg, 1)
-				if cfg.Plugins == nil || !cfg.Plugins.CommitParser {
+				if cfg.Plugins == nil || !cfg.Plugins.CommitParser.IsEnabled() {
 					t.Error("expected plugins.commit-parser to be true")
 				}
 				if cfg.Workspace == nil {
@@ -1016,7 +1018,7 @@ func TestSaveConfig_WithExtensions(t *testing.T) {
 			cfg: &Config{
 				Path: "custom.version",
 				Plugins: &PluginConfig{
-					CommitParser: true,
+					CommitParser: &CommitParserConfig{Enabled: true},
 				},
 				Extensions: []ExtensionConfig{
 					{
@@ -1205,7 +1207,7 @@ plugins:
 				if cfg.Path != ".version" {
 					t.Errorf("expected path to be '.version', got %q", cfg.Path)
 				}
-				if cfg.Plugins == nil || !cfg.Plugins.CommitParser {
+				if cfg.Plugins == nil || !cfg.Plugins.CommitParser.IsEnabled() {
 					t.Error("expected plugins.commit-parser to be true")
 				}
 				if cfg.Workspace != nil {
@@ -1416,3 +1418,185 @@ func TestChangelogGeneratorConfig_GetMode(t *testing.T) {
 		})
 	}
 }
+
+func TestCalVerConfig_GetFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *CalVerConfig
+		expected string
+	}{
+		{"nil config returns default", nil, "YYYY.MM.MICRO"},
+		{"empty format returns default", &CalVerConfig{}, "YYYY.MM.MICRO"},
+		{"custom format returns custom", &CalVerConfig{Format: "YY.0M.PATCH"}, "YY.0M.PATCH"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.GetFormat()
+			if result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestDevVersionConfig_GetTemplate(t *testing.T) {
+	var nilCfg *DevVersionConfig
+	if got := nilCfg.GetTemplate(); got != "" {
+		t.Errorf("expected empty template for nil config, got %q", got)
+	}
+
+	cfg := &DevVersionConfig{Template: "snapshot.{{.Commits}}"}
+	if got := cfg.GetTemplate(); got != "snapshot.{{.Commits}}" {
+		t.Errorf("expected custom template, got %q", got)
+	}
+}
+
+func TestPreReleaseConfig_GetChannels(t *testing.T) {
+	var nilCfg *PreReleaseConfig
+	if got := nilCfg.GetChannels(); !reflect.DeepEqual(got, []string{"alpha", "beta", "rc"}) {
+		t.Errorf("expected default channels for nil config, got %v", got)
+	}
+
+	cfg := &PreReleaseConfig{Channels: []string{"dev", "qa", "rc"}}
+	if got := cfg.GetChannels(); !reflect.DeepEqual(got, []string{"dev", "qa", "rc"}) {
+		t.Errorf("expected custom channels, got %v", got)
+	}
+}
+
+func TestInitialDevelopmentConfig_GetEnabled(t *testing.T) {
+	disabled := false
+	tests := []struct {
+		name     string
+		config   *InitialDevelopmentConfig
+		expected bool
+	}{
+		{"nil config defaults to enabled", nil, true},
+		{"unset defaults to enabled", &InitialDevelopmentConfig{}, true},
+		{"explicitly disabled", &InitialDevelopmentConfig{Enabled: &disabled}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.GetEnabled(); got != tt.expected {
+				t.Errorf("GetEnabled() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCommitParserConfig_YAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  *CommitParserConfig
+	}{
+		{"bool shorthand enabled", "commit-parser: true\n", &CommitParserConfig{Enabled: true}},
+		{"bool shorthand disabled", "commit-parser: false\n", &CommitParserConfig{Enabled: false}},
+		{
+			name:  "block without enabled",
+			input: "commit-parser:\n  convention: angular\n",
+			want:  &CommitParserConfig{Enabled: true, Convention: "angular"},
+		},
+		{
+			name:  "block disabled",
+			input: "commit-parser:\n  enabled: false\n  convention: angular\n",
+			want:  &CommitParserConfig{Enabled: false, Convention: "angular"},
+		},
+		{
+			name:  "regex convention",
+			input: "commit-parser:\n  enabled: true\n  convention: regex\n  pattern: '^(?P<type>\\w+):'\n",
+			want:  &CommitParserConfig{Enabled: true, Convention: "regex", Pattern: `^(?P<type>\w+):`},
+		},
+		{
+			name: "block",
+			input: `commit-parser:
+  enabled: true
+  types:
+    perf: patch
+    docs: none
+  scopes:
+    deps: patch
+  default: patch
+  ignore-scopes: [release]
+  history: first-parent
+`,
+			want: &CommitParserConfig{
+				Enabled:      true,
+				Types:        map[string]string{"perf": "patch", "docs": "none"},
+				Scopes:       map[string]string{"deps": "patch"},
+				Default:      "patch",
+				IgnoreScopes: []string{"release"},
+				History:      "first-parent",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var plugins PluginConfig
+			if err := yaml.UnmarshalWithOptions([]byte(tt.input), &plugins, yaml.Strict()); err != nil {
+				t.Fatalf("unmarshal failed: %v", err)
+			}
+			if !reflect.DeepEqual(plugins.CommitParser, tt.want) {
+				t.Errorf("got %+v, want %+v", plugins.CommitParser, tt.want)
+			}
+
+			out, err := yaml.Marshal(&plugins)
+			if err != nil {
+				t.Fatalf("marshal failed: %v", err)
+			}
+			var roundTrip PluginConfig
+			if err := yaml.Unmarshal(out, &roundTrip); err != nil {
+				t.Fatalf("unmarshal of %q failed: %v", out, err)
+			}
+			if !reflect.DeepEqual(roundTrip.CommitParser, tt.want) {
+				t.Errorf("round trip got %+v, want %+v", roundTrip.CommitParser, tt.want)
+			}
+		})
+	}
+
+	if out, _ := yaml.Marshal(&PluginConfig{CommitParser: &CommitParserConfig{Enabled: true}}); !strings.Contains(string(out), "commit-parser: true") {
+		t.Errorf("expected bool shorthand when only enabled is set, got %q", out)
+	}
+
+	var plugins PluginConfig
+	if err := yaml.UnmarshalWithOptions([]byte("commit-parser:\n  enabled: true\n  typo: x\n"), &plugins, yaml.Strict()); err == nil {
+		t.Error("expected unknown field error in strict mode")
+	}
+}
+
+func TestCommitParserConfig_IsEnabled(t *testing.T) {
+	var nilCfg *CommitParserConfig
+	if nilCfg.IsEnabled() {
+		t.Error("expected nil config to be disabled")
+	}
+	if !(&CommitParserConfig{Enabled: true}).IsEnabled() {
+		t.Error("expected enabled config")
+	}
+}
+
+func TestDependenciesConfig_Getters(t *testing.T) {
+	disabled := false
+	tests := []struct {
+		name          string
+		config        *DependenciesConfig
+		expectDetect  bool
+		expectCascade string
+	}{
+		{"nil config uses defaults", nil, true, "none"},
+		{"unset uses defaults", &DependenciesConfig{}, true, "none"},
+		{"custom values", &DependenciesConfig{Detect: &disabled, Cascade: "minor"}, false, "minor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.GetDetect(); got != tt.expectDetect {
+				t.Errorf("GetDetect() = %v, want %v", got, tt.expectDetect)
+			}
+			if got := tt.config.GetCascade(); got != tt.expectCascade {
+				t.Errorf("GetCascade() = %q, want %q", got, tt.expectCascade)
+			}
+		})
+	}
+}
@@ -19,6 +19,15 @@
 //	plugins:
 //	  commit-parser: true
 //
+//	  # Or, to map more commit types and scopes to bump levels:
+//	  # commit-parser:
+//	  #   enabled: true
+//	  #   convention: conventional # or angular, gitmoji, regex (with pattern)
+//	  #   types: {perf: patch, docs: none}
+//	  #   scopes: {api: minor}
+//	  #   default: none
+//	  #   ignore-scopes: [ci]
+//
 //	# Extension configuration
 //	# Extensions are external scripts that hook into version lifecycle events.
 //	# See docs/EXTENSIONS.md for details on creating extensions.
@@ -60,6 +69,18 @@
 //	    - name: module-b
 //	      path: ./services/module-b/.version
 //	      enabled: false   # Disable this module
+//	      depends-on:      # Modules this module depends on
+//	        - module-a
+//
+//	  # Dependencies between modules
+//	  dependencies:
+//	    detect: true       # Detect dependencies from go.mod and package.json
+//	    cascade: none      # Bump dependents of bumped modules: none, patch, minor, major
+//
+//	  # Modules versioned in lockstep
+//	  groups:
+//	    - name: platform
+//	      modules: [module-a, module-b]
 //
 // Discovery is zero-config by default. The following patterns are excluded:
 // node_modules, .git, vendor, tmp, build, dist, .cache, __pycache__
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"errors"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -19,7 +20,20 @@ func (f *OSFileSystem) ReadFile(path string) ([]byte, error) {
 }
 
 func (f *OSFileSystem) WriteFile(path string, data []byte, perm fs.FileMode) error {
-	return os.WriteFile(path, data, perm)
+	return WriteFileAtomic(path, data, perm)
+}
+
+func (f *OSFileSystem) CreateExclusive(path string, data []byte, perm fs.FileMode) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+		return err
+	}
+	return file.Close()
 }
 
 func (f *OSFileSystem) Stat(path string) (fs.FileInfo, error) {
@@ -46,3 +60,51 @@ func (f *OSFileSystem) ReadDir(path string) ([]fs.DirEntry, error) {
 func EnsureParentDir(fs FileSystem, path string, perm fs.FileMode) error {
 	return fs.MkdirAll(filepath.Dir(path), perm)
 }
+
+// WriteFileAtomic writes data to a temporary file in the same directory and
+// renames it over path, so a crash never leaves a truncated file behind.
+//
+// Like os.WriteFile, it fails if an existing file is not writable, and an
+// existing file keeps its permissions. Symlinks are followed.
+func WriteFileAtomic(path string, data []byte, perm fs.FileMode) (err error) {
+	if resolved, evalErr := filepath.EvalSymlinks(path); evalErr == nil {
+		path = resolved
+	}
+
+	if info, statErr := os.Stat(path); statErr == nil {
+		// Refuse to replace a file we could not overwrite in place.
+		existing, openErr := os.OpenFile(path, os.O_WRONLY, 0)
+		if openErr != nil {
+			return openErr
+		}
+		_ = existing.Close()
+		perm = info.Mode().Perm()
+	} else if !errors.Is(statErr, fs.ErrNotExist) {
+		return statErr
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Chmod(perm); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
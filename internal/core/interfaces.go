@@ -13,8 +13,14 @@ type FileSystem interface {
 	ReadFile(path string) ([]byte, error)
 
 	// WriteFile writes data to the file at path with the given permissions.
+	// Implementations should replace the file atomically, so readers never
+	// observe a partially written file.
 	WriteFile(path string, data []byte, perm fs.FileMode) error
 
+	// CreateExclusive creates a new file at path with the given data.
+	// It fails with an error matching fs.ErrExist if the file already exists.
+	CreateExclusive(path string, data []byte, perm fs.FileMode) error
+
 	// Stat returns file info for the path.
 	Stat(path string) (fs.FileInfo, error)
 
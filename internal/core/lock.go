@@ -0,0 +1,164 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/indaco/verso/internal/apperrors"
+)
+
+// LockSuffix is appended to a file path to name its lock file (e.g., ".version.lock").
+const LockSuffix = ".lock"
+
+// breakSuffix is appended to a lock file path to name the lock taken while
+// breaking a stale lock.
+const breakSuffix = ".break"
+
+var (
+	// LockTimeout is how long AcquireLock waits for another process to release a lock.
+	LockTimeout = 10 * time.Second
+
+	// LockStaleAfter is the age after which a lock file is considered abandoned
+	// (e.g., left behind by a crashed process) and is broken.
+	LockStaleAfter = 10 * time.Minute
+
+	// lockRetryInterval is the delay between attempts to create the lock file.
+	lockRetryInterval = 50 * time.Millisecond
+)
+
+// heldLocks counts the locks held by this process, keyed by lock path, so
+// nested read-modify-write cycles on the same file do not deadlock.
+var (
+	heldLocksMu sync.Mutex
+	heldLocks   = map[string]int{}
+)
+
+// FileLock is an advisory lock on a file, held by creating a lock file next to it.
+// Locks are reentrant within a process.
+type FileLock struct {
+	fs       FileSystem
+	lockPath string
+	once     sync.Once
+}
+
+// AcquireLock locks path for a read-modify-write cycle. If another process
+// holds the lock, it retries until LockTimeout and then fails with an
+// *apperrors.LockError. The parent directory of path is created if missing,
+// so the first write of a file is locked too.
+func AcquireLock(fsys FileSystem, path string) (*FileLock, error) {
+	lockPath := LockPath(path)
+	lock := &FileLock{fs: fsys, lockPath: lockPath}
+
+	heldLocksMu.Lock()
+	if heldLocks[lockPath] > 0 {
+		heldLocks[lockPath]++
+		heldLocksMu.Unlock()
+		return lock, nil
+	}
+	heldLocksMu.Unlock()
+
+	deadline := time.Now().Add(LockTimeout)
+	madeDir := false
+	for {
+		err := fsys.CreateExclusive(lockPath, []byte(lockHolder()), 0600)
+		if err == nil {
+			heldLocksMu.Lock()
+			heldLocks[lockPath]++
+			heldLocksMu.Unlock()
+			return lock, nil
+		}
+
+		switch {
+		case errors.Is(err, fs.ErrNotExist) && !madeDir:
+			if err := EnsureParentDir(fsys, lockPath, 0755); err != nil {
+				return nil, fmt.Errorf("failed to create lock file %s: %w", lockPath, err)
+			}
+			madeDir = true
+			continue
+		case !errors.Is(err, fs.ErrExist):
+			return nil, fmt.Errorf("failed to create lock file %s: %w", lockPath, err)
+		}
+
+		if breakStaleLock(fsys, lockPath) {
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			holder, _ := fsys.ReadFile(lockPath)
+			return nil, &apperrors.LockError{Path: path, LockPath: lockPath, Holder: strings.TrimSpace(string(holder))}
+		}
+		time.Sleep(lockRetryInterval)
+	}
+}
+
+// breakStaleLock removes the lock file at lockPath if it is stale, and reports
+// whether it did. Processes breaking the same lock take turns through a second
+// lock file, and the age of the lock is checked again under it: a process that
+// saw the stale lock late finds the lock re-created by the first one, and
+// leaves it alone.
+func breakStaleLock(fsys FileSystem, lockPath string) bool {
+	if !isStaleLock(fsys, lockPath) {
+		return false
+	}
+
+	breakPath := lockPath + breakSuffix
+	if err := fsys.CreateExclusive(breakPath, []byte(lockHolder()), 0600); err != nil {
+		// Another process is breaking the lock, unless it crashed doing so.
+		if isStaleLock(fsys, breakPath) {
+			_ = fsys.Remove(breakPath)
+		}
+		return false
+	}
+	defer func() { _ = fsys.Remove(breakPath) }()
+
+	if !isStaleLock(fsys, lockPath) {
+		return false
+	}
+	return fsys.Remove(lockPath) == nil
+}
+
+// isStaleLock reports whether the lock file at path exists and is older than
+// LockStaleAfter.
+func isStaleLock(fsys FileSystem, path string) bool {
+	info, err := fsys.Stat(path)
+	return err == nil && time.Since(info.ModTime()) > LockStaleAfter
+}
+
+// Release releases the lock. The lock file is removed when the outermost
+// holder in this process releases it. Calling Release more than once is safe.
+func (l *FileLock) Release() error {
+	if l.fs == nil {
+		return nil
+	}
+
+	var err error
+	l.once.Do(func() {
+		heldLocksMu.Lock()
+		defer heldLocksMu.Unlock()
+
+		heldLocks[l.lockPath]--
+		if heldLocks[l.lockPath] > 0 {
+			return
+		}
+		delete(heldLocks, l.lockPath)
+		err = l.fs.Remove(l.lockPath)
+	})
+	return err
+}
+
+// lockHolder describes the current process for the lock file content.
+func lockHolder() string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("pid %d on %s since %s", os.Getpid(), host, time.Now().Format(time.RFC3339))
+}
+
+// LockPath returns the lock file path for path.
+func LockPath(path string) string {
+	return filepath.Clean(path) + LockSuffix
+}
@@ -0,0 +1,244 @@
+package core
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/indaco/verso/internal/apperrors"
+)
+
+func withLockTimings(t *testing.T, timeout, staleAfter time.Duration) {
+	t.Helper()
+	origTimeout, origStale, origRetry := LockTimeout, LockStaleAfter, lockRetryInterval
+	LockTimeout, LockStaleAfter, lockRetryInterval = timeout, staleAfter, time.Millisecond
+	t.Cleanup(func() {
+		LockTimeout, LockStaleAfter, lockRetryInterval = origTimeout, origStale, origRetry
+	})
+}
+
+func TestAcquireLock(t *testing.T) {
+	fsys := NewOSFileSystem()
+	path := t.TempDir() + "/.version"
+
+	lock, err := AcquireLock(fsys, path)
+	if err != nil {
+		t.Fatalf("AcquireLock failed: %v", err)
+	}
+
+	data, err := fsys.ReadFile(path + LockSuffix)
+	if err != nil {
+		t.Fatalf("expected lock file to exist: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "pid ") {
+		t.Errorf("expected lock file to describe the holder, got %q", string(data))
+	}
+
+	if err := lock.Release(); err != nil {
+		t.Fatalf("Release failed: %v", err)
+	}
+	if _, err := fsys.Stat(path + LockSuffix); err == nil {
+		t.Error("expected lock file to be removed after release")
+	}
+	if err := lock.Release(); err != nil {
+		t.Errorf("expected second Release to be a no-op, got %v", err)
+	}
+}
+
+func TestAcquireLock_Reentrant(t *testing.T) {
+	fsys := NewMockFileSystem()
+
+	outer, err := AcquireLock(fsys, "/repo/.version")
+	if err != nil {
+		t.Fatalf("AcquireLock failed: %v", err)
+	}
+	inner, err := AcquireLock(fsys, "/repo/.version")
+	if err != nil {
+		t.Fatalf("nested AcquireLock failed: %v", err)
+	}
+
+	_ = inner.Release()
+	if _, ok := fsys.GetFile("/repo/.version.lock"); !ok {
+		t.Error("expected lock file to be kept while the outer lock is held")
+	}
+
+	_ = outer.Release()
+	if _, ok := fsys.GetFile("/repo/.version.lock"); ok {
+		t.Error("expected lock file to be removed after the outer release")
+	}
+}
+
+func TestAcquireLock_HeldByAnotherProcess(t *testing.T) {
+	withLockTimings(t, 20*time.Millisecond, time.Hour)
+
+	fsys := NewMockFileSystem()
+	fsys.SetFile("/repo/.version.lock", []byte("pid 42 on ci-runner\n"))
+
+	_, err := AcquireLock(fsys, "/repo/.version")
+
+	var lockErr *apperrors.LockError
+	if !errors.As(err, &lockErr) {
+		t.Fatalf("expected *apperrors.LockError, got %v", err)
+	}
+	if lockErr.Holder != "pid 42 on ci-runner" {
+		t.Errorf("expected holder from lock file, got %q", lockErr.Holder)
+	}
+	if lockErr.LockPath != "/repo/.version.lock" {
+		t.Errorf("expected lock path /repo/.version.lock, got %q", lockErr.LockPath)
+	}
+}
+
+func TestAcquireLock_WaitsForRelease(t *testing.T) {
+	withLockTimings(t, 5*time.Second, time.Hour)
+
+	fsys := NewMockFileSystem()
+	fsys.SetFile("/repo/.version.lock", []byte("pid 42"))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = fsys.Remove("/repo/.version.lock")
+	}()
+
+	lock, err := AcquireLock(fsys, "/repo/.version")
+	if err != nil {
+		t.Fatalf("expected lock to be acquired after release, got %v", err)
+	}
+	_ = lock.Release()
+}
+
+func TestAcquireLock_BreaksStaleLock(t *testing.T) {
+	withLockTimings(t, time.Second, time.Millisecond)
+
+	fsys := NewOSFileSystem()
+	path := t.TempDir() + "/.version"
+	if err := fsys.WriteFile(path+LockSuffix, []byte("pid 42"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	lock, err := AcquireLock(fsys, path)
+	if err != nil {
+		t.Fatalf("expected stale lock to be broken, got %v", err)
+	}
+	_ = lock.Release()
+}
+
+func TestAcquireLock_MissingDir(t *testing.T) {
+	fsys := NewOSFileSystem()
+	path := t.TempDir() + "/missing/.version"
+
+	lock, err := AcquireLock(fsys, path)
+	if err != nil {
+		t.Fatalf("expected the directory to be created, got %v", err)
+	}
+	if _, err := fsys.Stat(path + LockSuffix); err != nil {
+		t.Errorf("expected lock file to exist: %v", err)
+	}
+	if err := lock.Release(); err != nil {
+		t.Errorf("Release failed: %v", err)
+	}
+}
+
+func TestAcquireLock_MissingDirError(t *testing.T) {
+	fsys := NewOSFileSystem()
+	parent := t.TempDir() + "/file"
+	if err := fsys.WriteFile(parent, []byte("not a directory"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := AcquireLock(fsys, parent+"/missing/.version"); err == nil {
+		t.Fatal("expected an error when the directory cannot be created")
+	}
+}
+
+func TestBreakStaleLock_KeepsFreshLock(t *testing.T) {
+	withLockTimings(t, time.Second, time.Hour)
+
+	fsys := NewOSFileSystem()
+	lockPath := t.TempDir() + "/.version" + LockSuffix
+	if err := fsys.WriteFile(lockPath, []byte("pid 42"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// A process that saw the lock stale finds it re-created in the meantime
+	if breakStaleLock(fsys, lockPath) {
+		t.Error("expected a fresh lock not to be broken")
+	}
+	if _, err := fsys.Stat(lockPath); err != nil {
+		t.Errorf("expected fresh lock to be kept: %v", err)
+	}
+	if _, err := fsys.Stat(lockPath + breakSuffix); err == nil {
+		t.Error("expected no break lock to be left behind")
+	}
+}
+
+// slowRemoveFS delays removals, so a process breaking a stale lock removes it
+// late.
+type slowRemoveFS struct {
+	*OSFileSystem
+	delay time.Duration
+}
+
+func (f slowRemoveFS) Remove(path string) error {
+	time.Sleep(f.delay)
+	return f.OSFileSystem.Remove(path)
+}
+
+func TestAcquireLock_CompetingStaleBreakers(t *testing.T) {
+	withLockTimings(t, 5*time.Second, time.Minute)
+
+	fsys := NewOSFileSystem()
+	// The second acquirer removes the stale lock it saw after the first one
+	// has re-created it.
+	acquirers := []FileSystem{fsys, slowRemoveFS{OSFileSystem: fsys, delay: 5 * time.Millisecond}}
+	dir := t.TempDir()
+	// Two paths to the same file have different lock paths in this process,
+	// so the acquirers compete like two processes would.
+	link := t.TempDir() + "/link"
+	if err := os.Symlink(dir, link); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+	paths := []string{dir + "/.version", link + "/.version"}
+
+	for round := range 5 {
+		stale := time.Now().Add(-time.Hour)
+		if err := fsys.WriteFile(paths[0]+LockSuffix, []byte("pid 42"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(paths[0]+LockSuffix, stale, stale); err != nil {
+			t.Fatal(err)
+		}
+
+		var holders, overlaps atomic.Int32
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		for i, path := range paths {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				lock, err := AcquireLock(acquirers[i], path)
+				if err != nil {
+					t.Errorf("round %d: AcquireLock(%s) failed: %v", round, path, err)
+					return
+				}
+				if holders.Add(1) > 1 {
+					overlaps.Add(1)
+				}
+				time.Sleep(20 * time.Millisecond)
+				holders.Add(-1)
+				_ = lock.Release()
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		if overlaps.Load() > 0 {
+			t.Fatalf("round %d: both acquirers held the lock at once", round)
+		}
+	}
+}
@@ -57,6 +57,17 @@ func (m *MockFileSystem) WriteFile(path string, data []byte, perm fs.FileMode) e
 	return nil
 }
 
+func (m *MockFileSystem) CreateExclusive(path string, data []byte, perm fs.FileMode) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.files[path]; ok {
+		return fs.ErrExist
+	}
+	m.files[path] = data
+	m.perms[path] = perm
+	return nil
+}
+
 func (m *MockFileSystem) Stat(path string) (fs.FileInfo, error) {
 	if m.StatErr != nil {
 		return nil, m.StatErr
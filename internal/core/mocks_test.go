@@ -617,3 +617,66 @@ func TestMockCommandExecutor_Run_CommandKey(t *testing.T) {
 		t.Errorf("args = %v, want [status]", mockExec.Calls[0].Args)
 	}
 }
+
+func TestWriteFileAtomic(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := tmpDir + "/.version"
+
+	if err := WriteFileAtomic(path, []byte("1.2.3\n"), 0640); err != nil {
+		t.Fatalf("WriteFileAtomic failed: %v", err)
+	}
+	if err := WriteFileAtomic(path, []byte("1.2.4\n"), 0600); err != nil {
+		t.Fatalf("WriteFileAtomic (overwrite) failed: %v", err)
+	}
+
+	data, err := NewOSFileSystem().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if string(data) != "1.2.4\n" {
+		t.Errorf("expected %q, got %q", "1.2.4\n", string(data))
+	}
+
+	info, err := NewOSFileSystem().Stat(path)
+	if err != nil {
+		t.Fatalf("Stat failed: %v", err)
+	}
+	if info.Mode().Perm() != 0640 {
+		t.Errorf("expected existing permissions 0640 to be kept, got %o", info.Mode().Perm())
+	}
+
+	entries, err := NewOSFileSystem().ReadDir(tmpDir)
+	if err != nil {
+		t.Fatalf("ReadDir failed: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected no temporary files to remain, got %d entries", len(entries))
+	}
+}
+
+func TestWriteFileAtomic_MissingDir(t *testing.T) {
+	err := WriteFileAtomic(t.TempDir()+"/missing/.version", []byte("1.2.3\n"), 0600)
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected fs.ErrNotExist, got %v", err)
+	}
+}
+
+func TestCreateExclusive(t *testing.T) {
+	for name, fsys := range map[string]FileSystem{"os": NewOSFileSystem(), "mock": NewMockFileSystem()} {
+		t.Run(name, func(t *testing.T) {
+			path := t.TempDir() + "/.version.lock"
+
+			if err := fsys.CreateExclusive(path, []byte("a"), 0600); err != nil {
+				t.Fatalf("CreateExclusive failed: %v", err)
+			}
+			if err := fsys.CreateExclusive(path, []byte("b"), 0600); !errors.Is(err, fs.ErrExist) {
+				t.Errorf("expected fs.ErrExist, got %v", err)
+			}
+
+			data, _ := fsys.ReadFile(path)
+			if string(data) != "a" {
+				t.Errorf("expected original content to be kept, got %q", string(data))
+			}
+		})
+	}
+}
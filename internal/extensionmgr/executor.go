@@ -20,6 +20,8 @@ type HookInput struct {
 	Prerelease      *string `json:"prerelease,omitempty"`
 	Metadata        *string `json:"metadata,omitempty"`
 	ProjectRoot     string  `json:"project_root"`
+	Module          string  `json:"module,omitempty"`
+	ModuleDir       string  `json:"module_dir,omitempty"`
 }
 
 // HookOutput represents the JSON output expected from an extension script
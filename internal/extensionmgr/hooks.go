@@ -142,31 +142,51 @@ func ValidateExtensionHook(hookType string) error {
 
 // RunPreBumpHooks is a convenience function to run pre-bump hooks
 func RunPreBumpHooks(ctx context.Context, cfg *config.Config, version, previousVersion, bumpType string) error {
-	if cfg == nil {
-		return nil
-	}
-
-	runner := NewExtensionHookRunner(cfg)
+	return runBumpHooks(ctx, cfg, PreBumpHook, HookInput{
+		Version:         version,
+		PreviousVersion: previousVersion,
+		BumpType:        bumpType,
+	})
+}
 
-	// Get project root (current directory)
-	projectRoot, err := os.Getwd()
-	if err != nil {
-		projectRoot = "."
-	}
+// RunPostBumpHooks is a convenience function to run post-bump hooks
+func RunPostBumpHooks(ctx context.Context, cfg *config.Config, version, previousVersion, bumpType string, prerelease, metadata *string) error {
+	return runBumpHooks(ctx, cfg, PostBumpHook, HookInput{
+		Version:         version,
+		PreviousVersion: previousVersion,
+		BumpType:        bumpType,
+		Prerelease:      prerelease,
+		Metadata:        metadata,
+	})
+}
 
-	input := HookInput{
-		Hook:            string(PreBumpHook),
+// RunModulePreBumpHooks runs pre-bump hooks for the bump of a workspace module
+func RunModulePreBumpHooks(ctx context.Context, cfg *config.Config, module, moduleDir, version, previousVersion, bumpType string) error {
+	return runBumpHooks(ctx, cfg, PreBumpHook, HookInput{
 		Version:         version,
 		PreviousVersion: previousVersion,
 		BumpType:        bumpType,
-		ProjectRoot:     projectRoot,
-	}
+		Module:          module,
+		ModuleDir:       moduleDir,
+	})
+}
 
-	return runner.RunHooks(ctx, PreBumpHook, input)
+// RunModulePostBumpHooks runs post-bump hooks for the bump of a workspace module
+func RunModulePostBumpHooks(ctx context.Context, cfg *config.Config, module, moduleDir, version, previousVersion, bumpType string, prerelease, metadata *string) error {
+	return runBumpHooks(ctx, cfg, PostBumpHook, HookInput{
+		Version:         version,
+		PreviousVersion: previousVersion,
+		BumpType:        bumpType,
+		Prerelease:      prerelease,
+		Metadata:        metadata,
+		Module:          module,
+		ModuleDir:       moduleDir,
+	})
 }
 
-// RunPostBumpHooks is a convenience function to run post-bump hooks
-func RunPostBumpHooks(ctx context.Context, cfg *config.Config, version, previousVersion, bumpType string, prerelease, metadata *string) error {
+// runBumpHooks runs the hooks of hookType with input, completed with the hook
+// name and the project root
+func runBumpHooks(ctx context.Context, cfg *config.Config, hookType HookType, input HookInput) error {
 	if cfg == nil {
 		return nil
 	}
@@ -179,15 +199,8 @@ func RunPostBumpHooks(ctx context.Context, cfg *config.Config, version, previous
 		projectRoot = "."
 	}
 
-	input := HookInput{
-		Hook:            string(PostBumpHook),
-		Version:         version,
-		PreviousVersion: previousVersion,
-		BumpType:        bumpType,
-		Prerelease:      prerelease,
-		Metadata:        metadata,
-		ProjectRoot:     projectRoot,
-	}
+	input.Hook = string(hookType)
+	input.ProjectRoot = projectRoot
 
-	return runner.RunHooks(ctx, PostBumpHook, input)
+	return runner.RunHooks(ctx, hookType, input)
 }
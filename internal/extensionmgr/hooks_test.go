@@ -425,6 +425,51 @@ echo '{"success": true}'
 	}
 }
 
+func TestRunModuleBumpHooks(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	manifest := `name: test-ext
+version: 1.0.0
+description: Test extension
+author: test
+repository: https://github.com/test/test
+entry: hook.sh
+hooks:
+  - pre-bump
+  - post-bump
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "extension.yaml"), []byte(manifest), 0644); err != nil {
+		t.Fatalf("failed to create manifest: %v", err)
+	}
+
+	// The hook fails unless it receives the module name and directory
+	script := `#!/bin/sh
+read input
+case "$input" in
+  *'"module":"api"'*'"module_dir":"services/api"'*) echo '{"success": true}' ;;
+  *) echo '{"success": false, "message": "module missing"}'; exit 1 ;;
+esac
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "hook.sh"), []byte(script), 0755); err != nil {
+		t.Fatalf("failed to create script: %v", err)
+	}
+
+	cfg := &config.Config{
+		Extensions: []config.ExtensionConfig{{Name: "test-ext", Path: tmpDir, Enabled: true}},
+	}
+
+	ctx := context.Background()
+	if err := RunModulePreBumpHooks(ctx, cfg, "api", "services/api", "1.2.4", "1.2.3", "patch"); err != nil {
+		t.Errorf("RunModulePreBumpHooks() error = %v", err)
+	}
+	if err := RunModulePostBumpHooks(ctx, cfg, "api", "services/api", "1.2.4", "1.2.3", "patch", nil, nil); err != nil {
+		t.Errorf("RunModulePostBumpHooks() error = %v", err)
+	}
+	if err := RunPreBumpHooks(ctx, cfg, "1.2.4", "1.2.3", "patch"); err == nil {
+		t.Error("RunPreBumpHooks() expected error without module input")
+	}
+}
+
 // Mock executor for testing error scenarios
 type mockExecutor struct {
 	executeFunc func(ctx context.Context, scriptPath string, input HookInput) (*HookOutput, error)
@@ -4,6 +4,7 @@ package operations
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/indaco/verso/internal/core"
 	"github.com/indaco/verso/internal/semver"
@@ -19,6 +20,12 @@ const (
 	BumpMajor   BumpType = "major"
 	BumpRelease BumpType = "release"
 	BumpAuto    BumpType = "auto"
+	BumpStable  BumpType = "stable"
+
+	// Compound bumps: bump the level and start a new pre-release (1.2.5 -> 1.3.0-rc.1).
+	BumpPrePatch BumpType = "prepatch"
+	BumpPreMinor BumpType = "preminor"
+	BumpPreMajor BumpType = "premajor"
 )
 
 // BumpOperation performs a version bump on a module.
@@ -28,8 +35,41 @@ type BumpOperation struct {
 	preRelease       string
 	metadata         string
 	preserveMetadata bool
+
+	// initialDevelopment maps bumps of 0.x modules down one level.
+	initialDevelopment bool
+
+	// hooks run around the version write, if set.
+	hooks BumpHooks
+}
+
+// BumpHooks extends a module bump with the release lifecycle. PreBump runs
+// once the new version is known, before it is written; an error aborts the
+// bump. PostBump runs after the new version is written.
+type BumpHooks interface {
+	PreBump(ctx context.Context, mod *workspace.Module, bumpType BumpType, previous, next semver.SemVersion) error
+	PostBump(ctx context.Context, mod *workspace.Module, bumpType BumpType, previous, next semver.SemVersion) error
+}
+
+// BumpPreview is the bump an operation would apply to a module.
+type BumpPreview struct {
+	Module   *workspace.Module
+	BumpType BumpType
+	Previous semver.SemVersion
+	Next     semver.SemVersion
+}
+
+// BumpPreviewer is implemented by operations that can tell the bump of a
+// module before running it.
+type BumpPreviewer interface {
+	// PreviewBump returns the bump of the module without writing it, or nil
+	// if the operation leaves the module unchanged.
+	PreviewBump(mod *workspace.Module) (*BumpPreview, error)
 }
 
+// Ensure BumpOperation implements BumpPreviewer.
+var _ BumpPreviewer = (*BumpOperation)(nil)
+
 // NewBumpOperation creates a new bump operation.
 func NewBumpOperation(fs core.FileSystem, bumpType BumpType, preRelease, metadata string, preserveMetadata bool) *BumpOperation {
 	return &BumpOperation{
@@ -41,6 +81,20 @@ func NewBumpOperation(fs core.FileSystem, bumpType BumpType, preRelease, metadat
 	}
 }
 
+// WithInitialDevelopment applies the initial development policy to patch,
+// minor and major bumps: modules whose major version is 0 bump minor instead
+// of major and patch instead of minor. It is meant for inferred bumps.
+func (op *BumpOperation) WithInitialDevelopment(enabled bool) *BumpOperation {
+	op.initialDevelopment = enabled
+	return op
+}
+
+// WithHooks runs hooks before and after the version of each module is written.
+func (op *BumpOperation) WithHooks(hooks BumpHooks) *BumpOperation {
+	op.hooks = hooks
+	return op
+}
+
 // Execute performs the bump operation on the module.
 func (op *BumpOperation) Execute(ctx context.Context, mod *workspace.Module) error {
 	// Check for context cancellation
@@ -53,6 +107,13 @@ func (op *BumpOperation) Execute(ctx context.Context, mod *workspace.Module) err
 	// Create version manager
 	vm := semver.NewVersionManager(op.fs, nil)
 
+	// Hold the module's version file lock across read and write
+	lock, err := vm.Lock(mod.Path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = lock.Release() }()
+
 	// Read current version
 	currentVer, err := vm.Read(mod.Path)
 	if err != nil {
@@ -63,26 +124,65 @@ func (op *BumpOperation) Execute(ctx context.Context, mod *workspace.Module) err
 	oldVersion := currentVer.String()
 	_ = oldVersion // For potential logging
 
-	// Perform the bump based on type
+	newVer, err := op.next(vm.Scheme(), currentVer)
+	if err != nil {
+		return err
+	}
+
+	if op.hooks != nil {
+		if err := op.hooks.PreBump(ctx, mod, op.bumpType, currentVer, newVer); err != nil {
+			return err
+		}
+	}
+
+	// Write the new version
+	if err := vm.Save(mod.Path, newVer); err != nil {
+		return fmt.Errorf("failed to write version to %s: %w", mod.Path, err)
+	}
+
+	// Update module's current version for display
+	mod.CurrentVersion = newVer.String()
+
+	if op.hooks != nil {
+		return op.hooks.PostBump(ctx, mod, op.bumpType, currentVer, newVer)
+	}
+	return nil
+}
+
+// PreviewBump returns the bump of the module without writing it.
+func (op *BumpOperation) PreviewBump(mod *workspace.Module) (*BumpPreview, error) {
+	vm := semver.NewVersionManager(op.fs, nil)
+	current, err := vm.Read(mod.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read version from %s: %w", mod.Path, err)
+	}
+	next, err := op.next(vm.Scheme(), current)
+	if err != nil {
+		return nil, err
+	}
+	return &BumpPreview{Module: mod, BumpType: op.bumpType, Previous: current, Next: next}, nil
+}
+
+// Next returns the version the bump would write for a module at current,
+// without writing it.
+func (op *BumpOperation) Next(current semver.SemVersion) (semver.SemVersion, error) {
+	return op.next(semver.NewVersionManager(op.fs, nil).Scheme(), current)
+}
+
+// next computes the bumped version of currentVer with scheme.
+func (op *BumpOperation) next(scheme semver.Scheme, currentVer semver.SemVersion) (semver.SemVersion, error) {
 	var newVer semver.SemVersion
+	var err error
 	switch op.bumpType {
-	case BumpPatch:
-		newVer = semver.SemVersion{
-			Major: currentVer.Major,
-			Minor: currentVer.Minor,
-			Patch: currentVer.Patch + 1,
-		}
-	case BumpMinor:
-		newVer = semver.SemVersion{
-			Major: currentVer.Major,
-			Minor: currentVer.Minor + 1,
-			Patch: 0,
+	case BumpPatch, BumpMinor, BumpMajor:
+		level := string(op.bumpType)
+		if op.initialDevelopment {
+			level = semver.InitialDevelopmentLabel(currentVer, level)
 		}
-	case BumpMajor:
-		newVer = semver.SemVersion{
-			Major: currentVer.Major + 1,
-			Minor: 0,
-			Patch: 0,
+		// Arithmetic is delegated to the version scheme (SemVer or CalVer)
+		newVer, err = scheme.Bump(currentVer, level)
+		if err != nil {
+			return semver.SemVersion{}, fmt.Errorf("bump failed: %w", err)
 		}
 	case BumpRelease:
 		// Release removes pre-release and build metadata
@@ -91,19 +191,32 @@ func (op *BumpOperation) Execute(ctx context.Context, mod *workspace.Module) err
 			Minor: currentVer.Minor,
 			Patch: currentVer.Patch,
 		}
+	case BumpStable:
+		// Stable graduates an initial development version to 1.0.0
+		newVer, err = semver.BumpStable(currentVer)
+		if err != nil {
+			return semver.SemVersion{}, fmt.Errorf("bump failed: %w", err)
+		}
 	case BumpAuto:
 		// Auto bump uses heuristic-based logic
 		autoVer, autoErr := semver.BumpNextFunc(currentVer)
 		if autoErr != nil {
-			return fmt.Errorf("auto bump failed: %w", autoErr)
+			return semver.SemVersion{}, fmt.Errorf("auto bump failed: %w", autoErr)
 		}
 		newVer = autoVer
+	case BumpPrePatch, BumpPreMinor, BumpPreMajor:
+		// The pre-release label names the new pre-release series
+		level := strings.TrimPrefix(string(op.bumpType), "pre")
+		newVer, err = semver.BumpPre(currentVer, level, op.preRelease)
+		if err != nil {
+			return semver.SemVersion{}, fmt.Errorf("bump failed: %w", err)
+		}
 	default:
-		return fmt.Errorf("unknown bump type: %s", op.bumpType)
+		return semver.SemVersion{}, fmt.Errorf("unknown bump type: %s", op.bumpType)
 	}
 
 	// Apply pre-release label if provided
-	if op.preRelease != "" {
+	if op.preRelease != "" && !op.isCompound() {
 		newVer.PreRelease = op.preRelease
 	}
 
@@ -114,15 +227,16 @@ func (op *BumpOperation) Execute(ctx context.Context, mod *workspace.Module) err
 		newVer.Build = currentVer.Build
 	}
 
-	// Write the new version
-	if err := vm.Save(mod.Path, newVer); err != nil {
-		return fmt.Errorf("failed to write version to %s: %w", mod.Path, err)
-	}
-
-	// Update module's current version for display
-	mod.CurrentVersion = newVer.String()
+	return newVer, nil
+}
 
-	return nil
+// isCompound reports whether the bump starts a new pre-release series.
+func (op *BumpOperation) isCompound() bool {
+	switch op.bumpType {
+	case BumpPrePatch, BumpPreMinor, BumpPreMajor:
+		return true
+	}
+	return false
 }
 
 // Name returns the name of this operation.
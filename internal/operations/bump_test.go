@@ -2,6 +2,8 @@ package operations
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -232,6 +234,90 @@ func TestBumpOperation_Execute_WithPreRelease(t *testing.T) {
 	}
 }
 
+func TestBumpOperation_Execute_Compound(t *testing.T) {
+	tests := []struct {
+		name     string
+		bumpType BumpType
+		initial  string
+		label    string
+		expected string
+	}{
+		{"prepatch", BumpPrePatch, "1.2.5\n", "", "1.2.6-rc.1\n"},
+		{"preminor with label", BumpPreMinor, "1.2.5\n", "beta", "1.3.0-beta.1\n"},
+		{"premajor keeps current label", BumpPreMajor, "1.2.5-alpha-3\n", "", "2.0.0-alpha-1\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := core.NewMockFileSystem()
+			fs.SetFile("/test/.version", []byte(tt.initial))
+
+			op := NewBumpOperation(fs, tt.bumpType, tt.label, "", false)
+			mod := &workspace.Module{Name: "test", Path: "/test/.version"}
+
+			if err := op.Execute(context.Background(), mod); err != nil {
+				t.Fatalf("Execute failed: %v", err)
+			}
+
+			data, _ := fs.GetFile("/test/.version")
+			if string(data) != tt.expected {
+				t.Errorf("version = %q, want %q", string(data), tt.expected)
+			}
+		})
+	}
+}
+
+func TestBumpOperation_Execute_Stable(t *testing.T) {
+	fs := core.NewMockFileSystem()
+	fs.SetFile("/test/.version", []byte("0.9.3\n"))
+
+	op := NewBumpOperation(fs, BumpStable, "", "", false)
+	mod := &workspace.Module{Name: "test", Path: "/test/.version"}
+
+	if err := op.Execute(context.Background(), mod); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if data, _ := fs.GetFile("/test/.version"); string(data) != "1.0.0\n" {
+		t.Errorf("version = %q, want %q", string(data), "1.0.0\n")
+	}
+
+	if err := op.Execute(context.Background(), mod); err == nil {
+		t.Error("expected error for already stable module")
+	}
+}
+
+func TestBumpOperation_Execute_InitialDevelopment(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  string
+		bumpType BumpType
+		enabled  bool
+		expected string
+	}{
+		{"major on 0.x bumps minor", "0.4.2\n", BumpMajor, true, "0.5.0\n"},
+		{"minor on 0.x bumps patch", "0.4.2\n", BumpMinor, true, "0.4.3\n"},
+		{"major on 1.x", "1.4.2\n", BumpMajor, true, "2.0.0\n"},
+		{"disabled", "0.4.2\n", BumpMajor, false, "1.0.0\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := core.NewMockFileSystem()
+			fs.SetFile("/test/.version", []byte(tt.initial))
+
+			op := NewBumpOperation(fs, tt.bumpType, "", "", false).WithInitialDevelopment(tt.enabled)
+			mod := &workspace.Module{Name: "test", Path: "/test/.version"}
+
+			if err := op.Execute(context.Background(), mod); err != nil {
+				t.Fatalf("Execute failed: %v", err)
+			}
+			if data, _ := fs.GetFile("/test/.version"); string(data) != tt.expected {
+				t.Errorf("version = %q, want %q", string(data), tt.expected)
+			}
+		})
+	}
+}
+
 func TestBumpOperation_Execute_WithMetadata(t *testing.T) {
 	fs := core.NewMockFileSystem()
 	fs.SetFile("/test/.version", []byte("1.2.3\n"))
@@ -429,6 +515,44 @@ func TestBumpOperation_Execute_UnknownBumpType(t *testing.T) {
 	}
 }
 
+func TestBumpOperation_Next(t *testing.T) {
+	tests := []struct {
+		name       string
+		op         *BumpOperation
+		current    string
+		want       string
+		wantErrStr string
+	}{
+		{"minor", NewBumpOperation(nil, BumpMinor, "", "", false), "1.2.3", "1.3.0", ""},
+		{"initial development", NewBumpOperation(nil, BumpMajor, "", "", false).WithInitialDevelopment(true), "0.4.2", "0.5.0", ""},
+		{"auto promotes pre-release", NewBumpOperation(nil, BumpAuto, "", "", false), "1.3.0-rc.1", "1.3.0", ""},
+		{"metadata", NewBumpOperation(nil, BumpPatch, "", "ci.1", false), "1.2.3", "1.2.4+ci.1", ""},
+		{"unknown bump type", NewBumpOperation(nil, BumpType("unknown"), "", "", false), "1.2.3", "", "unknown bump type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, err := semver.ParseVersion(tt.current)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := tt.op.Next(current)
+			if tt.wantErrStr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrStr) {
+					t.Fatalf("Next() error = %v, want %q", err, tt.wantErrStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Next() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("Next() = %s, want %s", got.String(), tt.want)
+			}
+		})
+	}
+}
+
 func TestBumpOperation_Name(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -474,3 +598,111 @@ func TestBumpOperation_Name(t *testing.T) {
 		})
 	}
 }
+
+func TestBumpOperation_Execute_CalVerScheme(t *testing.T) {
+	now := func() time.Time { return time.Date(2026, time.April, 2, 0, 0, 0, 0, time.UTC) }
+	calver, err := semver.NewCalVerScheme("YYYY.0M.MICRO", now)
+	if err != nil {
+		t.Fatalf("NewCalVerScheme failed: %v", err)
+	}
+	defer semver.SetDefaultScheme(calver)()
+
+	tests := []struct {
+		name     string
+		bumpType BumpType
+		current  string
+		expected string
+		wantErr  bool
+	}{
+		{"patch in same period", BumpPatch, "2026.04.1\n", "2026.04.2", false},
+		{"auto resets on new period", BumpAuto, "2026.03.7\n", "2026.04.0", false},
+		{"minor unsupported", BumpMinor, "2026.04.1\n", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := core.NewMockFileSystem()
+			fs.SetFile("/test/.version", []byte(tt.current))
+
+			op := NewBumpOperation(fs, tt.bumpType, "", "", false)
+			mod := &workspace.Module{Name: "test", Path: "/test/.version"}
+
+			err := op.Execute(context.Background(), mod)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute failed: %v", err)
+			}
+			if mod.CurrentVersion != tt.expected {
+				t.Errorf("module CurrentVersion = %q, want %q", mod.CurrentVersion, tt.expected)
+			}
+			data, _ := fs.ReadFile("/test/.version")
+			if string(data) != tt.expected+"\n" {
+				t.Errorf("file content = %q, want %q", string(data), tt.expected+"\n")
+			}
+		})
+	}
+}
+
+// recordingHooks records the bumps it sees and fails PreBump if preErr is set.
+type recordingHooks struct {
+	preErr error
+	calls  []string
+}
+
+func (h *recordingHooks) PreBump(_ context.Context, mod *workspace.Module, bumpType BumpType, previous, next semver.SemVersion) error {
+	h.calls = append(h.calls, "pre "+mod.Name+" "+string(bumpType)+" "+previous.String()+" -> "+next.String())
+	return h.preErr
+}
+
+func (h *recordingHooks) PostBump(_ context.Context, mod *workspace.Module, bumpType BumpType, previous, next semver.SemVersion) error {
+	h.calls = append(h.calls, "post "+mod.Name+" "+string(bumpType)+" "+previous.String()+" -> "+next.String())
+	return nil
+}
+
+func TestBumpOperation_Execute_Hooks(t *testing.T) {
+	t.Run("runs around the write", func(t *testing.T) {
+		fs := core.NewMockFileSystem()
+		fs.SetFile("/test/.version", []byte("1.2.3\n"))
+		hooks := &recordingHooks{}
+
+		op := NewBumpOperation(fs, BumpMinor, "", "", false).WithHooks(hooks)
+		if err := op.Execute(context.Background(), &workspace.Module{Name: "test", Path: "/test/.version"}); err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+
+		want := []string{"pre test minor 1.2.3 -> 1.3.0", "post test minor 1.2.3 -> 1.3.0"}
+		if len(hooks.calls) != len(want) {
+			t.Fatalf("calls = %v, want %v", hooks.calls, want)
+		}
+		for i := range want {
+			if hooks.calls[i] != want[i] {
+				t.Errorf("calls[%d] = %q, want %q", i, hooks.calls[i], want[i])
+			}
+		}
+	})
+
+	t.Run("PreBump error aborts the bump", func(t *testing.T) {
+		fs := core.NewMockFileSystem()
+		fs.SetFile("/test/.version", []byte("1.2.3\n"))
+		hooks := &recordingHooks{preErr: errors.New("gate failed")}
+
+		op := NewBumpOperation(fs, BumpPatch, "", "", false).WithHooks(hooks)
+		err := op.Execute(context.Background(), &workspace.Module{Name: "test", Path: "/test/.version"})
+		if err == nil || err.Error() != "gate failed" {
+			t.Fatalf("Execute error = %v, want gate failed", err)
+		}
+
+		data, _ := fs.GetFile("/test/.version")
+		if string(data) != "1.2.3\n" {
+			t.Errorf("version = %q, want unchanged", string(data))
+		}
+		if len(hooks.calls) != 1 {
+			t.Errorf("calls = %v, want only PreBump", hooks.calls)
+		}
+	})
+}
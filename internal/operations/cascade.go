@@ -0,0 +1,208 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/semver"
+	"github.com/indaco/verso/internal/workspace"
+)
+
+// Cascade propagates the bumps in results to dependent modules. Every module
+// depending on a bumped module gets its references to it updated, and is
+// bumped if it was not bumped already, along with the other members of its
+// group (see CascadeTargets). Cascaded bumps propagate in turn. Cascaded bumps
+// run hooks, if not nil. The results of the cascaded bumps are appended to
+// results.
+func Cascade(ctx context.Context, fs core.FileSystem, graph *workspace.Graph, groups []*workspace.Group, results []workspace.ExecutionResult, level BumpType, hooks BumpHooks) ([]workspace.ExecutionResult, error) {
+	switch level {
+	case BumpPatch, BumpMinor, BumpMajor:
+	default:
+		return results, fmt.Errorf("invalid cascade level %q (want none, patch, minor or major)", level)
+	}
+
+	bumped := map[string]string{}
+	levels := map[string]BumpType{}
+	for _, result := range results {
+		if result.Success && result.OldVersion != result.NewVersion {
+			bumped[result.Module.Name] = result.NewVersion
+			levels[result.Module.Name] = ChangeLevel(result.OldVersion, result.NewVersion)
+		}
+	}
+	if len(bumped) == 0 {
+		return results, nil
+	}
+
+	targets, err := CascadeTargets(graph, groups, levels, level)
+	if err != nil {
+		return results, err
+	}
+	order, err := graph.Order()
+	if err != nil {
+		return results, err
+	}
+
+	for _, mod := range order {
+		var changedDeps []string
+		for _, dep := range graph.DependsOn(mod.Name) {
+			if _, ok := bumped[dep]; ok {
+				changedDeps = append(changedDeps, dep)
+			}
+		}
+		targetLevel, isTarget := targets[mod.Name]
+		if len(changedDeps) == 0 && !isTarget {
+			continue
+		}
+
+		start := time.Now()
+		oldVersion := mod.CurrentVersion
+		err := cascadeModule(ctx, fs, graph, mod, changedDeps, bumped, targetLevel, isTarget, hooks)
+		if !isTarget && err == nil {
+			// Bumped already: only its references were updated
+			continue
+		}
+
+		results = append(results, workspace.ExecutionResult{
+			Module:     mod,
+			OldVersion: oldVersion,
+			NewVersion: mod.CurrentVersion,
+			Success:    err == nil,
+			Error:      err,
+			Duration:   time.Since(start),
+		})
+		if err != nil {
+			return results, fmt.Errorf("cascade failed on module %s: %w", mod.Name, err)
+		}
+		bumped[mod.Name] = mod.CurrentVersion
+	}
+
+	return results, nil
+}
+
+// PreviewCascade returns the bumps Cascade would add to the previewed bumps,
+// without writing them.
+func PreviewCascade(fs core.FileSystem, graph *workspace.Graph, groups []*workspace.Group, previews []*BumpPreview, level BumpType) ([]*BumpPreview, error) {
+	levels := map[string]BumpType{}
+	for _, p := range previews {
+		if p.Previous.String() != p.Next.String() {
+			levels[p.Module.Name] = ChangeLevel(p.Previous.String(), p.Next.String())
+		}
+	}
+	if len(levels) == 0 {
+		return nil, nil
+	}
+
+	targets, err := CascadeTargets(graph, groups, levels, level)
+	if err != nil {
+		return nil, err
+	}
+	order, err := graph.Order()
+	if err != nil {
+		return nil, err
+	}
+
+	var cascaded []*BumpPreview
+	for _, mod := range order {
+		targetLevel, ok := targets[mod.Name]
+		if !ok {
+			continue
+		}
+		preview, err := NewBumpOperation(fs, targetLevel, "", "", false).PreviewBump(mod)
+		if err != nil {
+			return nil, fmt.Errorf("cascade failed on module %s: %w", mod.Name, err)
+		}
+		cascaded = append(cascaded, preview)
+	}
+	return cascaded, nil
+}
+
+// cascadeLevels orders the levels of cascaded bumps.
+var cascadeLevels = []BumpType{BumpPatch, BumpMinor, BumpMajor}
+
+// CascadeTargets returns the modules a cascade bumps, with their level, given
+// the levels of the bumped modules. Modules depending on a bumped module are
+// bumped by level, along with the other members of their group, so groups stay
+// in lockstep. A group with members bumped already is bumped by the highest of
+// level and their levels. Cascaded bumps propagate in turn.
+func CascadeTargets(graph *workspace.Graph, groups []*workspace.Group, bumped map[string]BumpType, level BumpType) (map[string]BumpType, error) {
+	order, err := graph.Order()
+	if err != nil {
+		return nil, err
+	}
+
+	targets := map[string]BumpType{}
+	isBumped := func(name string) bool {
+		_, ok := bumped[name]
+		_, target := targets[name]
+		return ok || target
+	}
+
+	// Group members may come before the dependent in the order: repeat until
+	// no module is added, so their own dependents are bumped too.
+	for added := true; added; {
+		added = false
+		for _, mod := range order {
+			if isBumped(mod.Name) || !slices.ContainsFunc(graph.DependsOn(mod.Name), isBumped) {
+				continue
+			}
+
+			members, groupLevel := []*workspace.Module{mod}, level
+			if g := workspace.GroupOf(groups, mod.Name); g != nil {
+				members = g.Modules
+				for _, member := range g.Modules {
+					if l, ok := bumped[member.Name]; ok && slices.Index(cascadeLevels, l) > slices.Index(cascadeLevels, groupLevel) {
+						groupLevel = l
+					}
+				}
+			}
+			for _, member := range members {
+				if !isBumped(member.Name) {
+					targets[member.Name] = groupLevel
+				}
+			}
+			added = true
+		}
+	}
+	return targets, nil
+}
+
+// ChangeLevel returns the level of the change from one version to another:
+// major or minor if that component changed, patch otherwise.
+func ChangeLevel(from, to string) BumpType {
+	old, errOld := semver.ParseVersion(from)
+	next, errNext := semver.ParseVersion(to)
+	switch {
+	case errOld != nil || errNext != nil:
+		return BumpPatch
+	case old.Major != next.Major:
+		return BumpMajor
+	case old.Minor != next.Minor:
+		return BumpMinor
+	default:
+		return BumpPatch
+	}
+}
+
+// cascadeModule updates the references of mod to its bumped dependencies, and
+// bumps mod by level if bump is set. The version file lock of mod is held
+// across both, so its manifests and version change together.
+func cascadeModule(ctx context.Context, fs core.FileSystem, graph *workspace.Graph, mod *workspace.Module, deps []string, bumped map[string]string, level BumpType, bump bool, hooks BumpHooks) error {
+	lock, err := semver.NewVersionManager(fs, nil).Lock(mod.Path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = lock.Release() }()
+
+	for _, dep := range deps {
+		if _, err := graph.UpdateReferences(fs, mod.Name, dep, bumped[dep]); err != nil {
+			return fmt.Errorf("failed to update references to %s: %w", dep, err)
+		}
+	}
+	if !bump {
+		return nil
+	}
+	return NewBumpOperation(fs, level, "", "", false).WithHooks(hooks).Execute(ctx, mod)
+}
@@ -0,0 +1,277 @@
+package operations
+
+import (
+	"context"
+	"maps"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/semver"
+	"github.com/indaco/verso/internal/workspace"
+)
+
+func TestCascade(t *testing.T) {
+	fs := core.NewMockFileSystem()
+	fs.SetFile("/repo/shared/.version", []byte("1.3.0\n"))
+	fs.SetFile("/repo/shared/go.mod", []byte("module example.com/repo/shared\n"))
+	fs.SetFile("/repo/api/.version", []byte("2.0.0\n"))
+	fs.SetFile("/repo/api/go.mod", []byte("module example.com/repo/api\n\nrequire example.com/repo/shared v1.2.0\n"))
+	fs.SetFile("/repo/web/.version", []byte("0.4.1\n"))
+	fs.SetFile("/repo/docs/.version", []byte("1.0.0\n"))
+
+	shared := &workspace.Module{Name: "shared", Path: "/repo/shared/.version", Dir: "/repo/shared", CurrentVersion: "1.3.0"}
+	api := &workspace.Module{Name: "api", Path: "/repo/api/.version", Dir: "/repo/api", CurrentVersion: "2.0.0"}
+	web := &workspace.Module{Name: "web", Path: "/repo/web/.version", Dir: "/repo/web", CurrentVersion: "0.4.1"}
+	docs := &workspace.Module{Name: "docs", Path: "/repo/docs/.version", Dir: "/repo/docs", CurrentVersion: "1.0.0"}
+
+	cfg := &config.Config{Workspace: &config.WorkspaceConfig{
+		Modules: []config.ModuleConfig{{Name: "web", DependsOn: []string{"api"}}},
+	}}
+	graph, err := workspace.BuildGraph(fs, []*workspace.Module{web, api, shared, docs}, cfg)
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+
+	results := []workspace.ExecutionResult{
+		{Module: shared, OldVersion: "1.2.0", NewVersion: "1.3.0", Success: true},
+		{Module: docs, OldVersion: "1.0.0", NewVersion: "1.0.0", Success: true},
+	}
+
+	results, err = Cascade(context.Background(), fs, graph, nil, results, BumpPatch, nil)
+	if err != nil {
+		t.Fatalf("Cascade() error = %v", err)
+	}
+
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(results))
+	}
+	want := map[string]string{"api": "2.0.1", "web": "0.4.2"}
+	for _, result := range results[2:] {
+		if !result.Success || result.NewVersion != want[result.Module.Name] {
+			t.Errorf("%s: got %s (success %v), want %s", result.Module.Name, result.NewVersion, result.Success, want[result.Module.Name])
+		}
+	}
+
+	data, _ := fs.GetFile("/repo/api/go.mod")
+	if !strings.Contains(string(data), "example.com/repo/shared v1.3.0") {
+		t.Errorf("expected api go.mod to require shared v1.3.0, got %q", data)
+	}
+}
+
+func TestCascade_Errors(t *testing.T) {
+	mod := &workspace.Module{Name: "a", CurrentVersion: "1.0.0"}
+	graph, err := workspace.BuildGraph(core.NewMockFileSystem(), []*workspace.Module{mod}, nil)
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+
+	if _, err := Cascade(context.Background(), core.NewMockFileSystem(), graph, nil, nil, BumpAuto, nil); err == nil {
+		t.Error("expected error for invalid cascade level")
+	}
+
+	results := []workspace.ExecutionResult{{Module: mod, OldVersion: "1.0.0", NewVersion: "1.0.0", Success: true}}
+	got, err := Cascade(context.Background(), core.NewMockFileSystem(), graph, nil, results, BumpMinor, nil)
+	if err != nil || len(got) != 1 {
+		t.Errorf("expected no cascade without bumped modules, got %v, %v", got, err)
+	}
+}
+
+func TestCascade_LockedDependent(t *testing.T) {
+	origTimeout := core.LockTimeout
+	core.LockTimeout = 20 * time.Millisecond
+	defer func() { core.LockTimeout = origTimeout }()
+
+	fs := core.NewMockFileSystem()
+	fs.SetFile("/repo/shared/.version", []byte("1.3.0\n"))
+	fs.SetFile("/repo/shared/go.mod", []byte("module example.com/repo/shared\n"))
+	fs.SetFile("/repo/api/.version", []byte("2.0.0\n"))
+	apiGoMod := "module example.com/repo/api\n\nrequire example.com/repo/shared v1.2.0\n"
+	fs.SetFile("/repo/api/go.mod", []byte(apiGoMod))
+	// Another process is bumping api
+	fs.SetFile("/repo/api/.version.lock", []byte("pid 42"))
+
+	shared := &workspace.Module{Name: "shared", Path: "/repo/shared/.version", Dir: "/repo/shared", CurrentVersion: "1.3.0"}
+	api := &workspace.Module{Name: "api", Path: "/repo/api/.version", Dir: "/repo/api", CurrentVersion: "2.0.0"}
+	graph, err := workspace.BuildGraph(fs, []*workspace.Module{api, shared}, nil)
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+
+	results := []workspace.ExecutionResult{{Module: shared, OldVersion: "1.2.0", NewVersion: "1.3.0", Success: true}}
+	if _, err := Cascade(context.Background(), fs, graph, nil, results, BumpPatch, nil); err == nil || !strings.Contains(err.Error(), "locked") {
+		t.Fatalf("expected a lock error, got %v", err)
+	}
+
+	if data, _ := fs.GetFile("/repo/api/go.mod"); string(data) != apiGoMod {
+		t.Errorf("expected api go.mod to be kept, got %q", data)
+	}
+}
+
+func TestCascade_Groups(t *testing.T) {
+	tests := []struct {
+		name    string
+		results map[string][2]string
+		want    map[string]string
+	}{
+		{
+			name:    "the group of a dependent is bumped with it",
+			results: map[string][2]string{"shared": {"1.2.0", "1.3.0"}},
+			want:    map[string]string{"api": "2.0.1", "cli": "2.0.1", "tool": "1.0.1"},
+		},
+		{
+			name:    "a group bumped in part is bumped at its highest level",
+			results: map[string][2]string{"shared": {"1.2.0", "1.3.0"}, "cli": {"2.0.0", "2.1.0"}},
+			want:    map[string]string{"api": "2.1.0", "tool": "1.0.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := core.NewMockFileSystem()
+			modules := map[string]*workspace.Module{}
+			for name, version := range map[string]string{"shared": "1.2.0", "api": "2.0.0", "cli": "2.0.0", "tool": "1.0.0"} {
+				if r, ok := tt.results[name]; ok {
+					version = r[1]
+				}
+				path := "/repo/" + name + "/.version"
+				fs.SetFile(path, []byte(version+"\n"))
+				modules[name] = &workspace.Module{Name: name, Path: path, Dir: "/repo/" + name, CurrentVersion: version}
+			}
+
+			cfg := &config.Config{Workspace: &config.WorkspaceConfig{
+				Modules: []config.ModuleConfig{
+					{Name: "api", DependsOn: []string{"shared"}},
+					{Name: "tool", DependsOn: []string{"cli"}},
+				},
+				Groups: []config.GroupConfig{{Name: "platform", Modules: []string{"api", "cli"}}},
+			}}
+			all := []*workspace.Module{modules["tool"], modules["cli"], modules["api"], modules["shared"]}
+			graph, err := workspace.BuildGraph(fs, all, cfg)
+			if err != nil {
+				t.Fatalf("BuildGraph() error = %v", err)
+			}
+			groups, err := workspace.BuildGroups(all, cfg)
+			if err != nil {
+				t.Fatalf("BuildGroups() error = %v", err)
+			}
+
+			var results []workspace.ExecutionResult
+			for name, r := range tt.results {
+				results = append(results, workspace.ExecutionResult{Module: modules[name], OldVersion: r[0], NewVersion: r[1], Success: true})
+			}
+			results, err = Cascade(context.Background(), fs, graph, groups, results, BumpPatch, nil)
+			if err != nil {
+				t.Fatalf("Cascade() error = %v", err)
+			}
+
+			got := map[string]string{}
+			for _, result := range results[len(tt.results):] {
+				got[result.Module.Name] = result.NewVersion
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("cascaded bumps = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreviewCascade(t *testing.T) {
+	tests := []struct {
+		name     string
+		previews map[string][2]string
+		want     map[string]string
+	}{
+		{
+			name:     "the group of a dependent is bumped with it",
+			previews: map[string][2]string{"shared": {"1.2.0", "1.3.0"}},
+			want:     map[string]string{"api": "2.0.1", "cli": "2.0.1", "tool": "1.0.1"},
+		},
+		{
+			name:     "a group bumped in part is bumped at its highest level",
+			previews: map[string][2]string{"shared": {"1.2.0", "1.3.0"}, "cli": {"2.0.0", "2.1.0"}},
+			want:     map[string]string{"api": "2.1.0", "tool": "1.0.1"},
+		},
+		{
+			name:     "unchanged modules cascade nothing",
+			previews: map[string][2]string{"shared": {"1.2.0", "1.2.0"}},
+			want:     map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := core.NewMockFileSystem()
+			modules := map[string]*workspace.Module{}
+			for name, version := range map[string]string{"shared": "1.2.0", "api": "2.0.0", "cli": "2.0.0", "tool": "1.0.0"} {
+				path := "/repo/" + name + "/.version"
+				fs.SetFile(path, []byte(version+"\n"))
+				modules[name] = &workspace.Module{Name: name, Path: path, Dir: "/repo/" + name, CurrentVersion: version}
+			}
+
+			cfg := &config.Config{Workspace: &config.WorkspaceConfig{
+				Modules: []config.ModuleConfig{
+					{Name: "api", DependsOn: []string{"shared"}},
+					{Name: "tool", DependsOn: []string{"cli"}},
+				},
+				Groups: []config.GroupConfig{{Name: "platform", Modules: []string{"api", "cli"}}},
+			}}
+			all := []*workspace.Module{modules["tool"], modules["cli"], modules["api"], modules["shared"]}
+			graph, err := workspace.BuildGraph(fs, all, cfg)
+			if err != nil {
+				t.Fatalf("BuildGraph() error = %v", err)
+			}
+			groups, err := workspace.BuildGroups(all, cfg)
+			if err != nil {
+				t.Fatalf("BuildGroups() error = %v", err)
+			}
+
+			var previews []*BumpPreview
+			for name, p := range tt.previews {
+				previous, errPrev := semver.ParseVersion(p[0])
+				next, errNext := semver.ParseVersion(p[1])
+				if errPrev != nil || errNext != nil {
+					t.Fatalf("invalid preview %v", p)
+				}
+				previews = append(previews, &BumpPreview{Module: modules[name], Previous: previous, Next: next})
+			}
+			cascaded, err := PreviewCascade(fs, graph, groups, previews, BumpPatch)
+			if err != nil {
+				t.Fatalf("PreviewCascade() error = %v", err)
+			}
+
+			got := map[string]string{}
+			for _, preview := range cascaded {
+				got[preview.Module.Name] = preview.Next.String()
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("cascaded bumps = %v, want %v", got, tt.want)
+			}
+
+			// Previews write nothing
+			if data, _ := fs.ReadFile("/repo/api/.version"); string(data) != "2.0.0\n" {
+				t.Errorf("api/.version = %q, want unchanged", data)
+			}
+		})
+	}
+}
+
+func TestChangeLevel(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     BumpType
+	}{
+		{"1.2.3", "1.2.4", BumpPatch},
+		{"1.2.3", "1.3.0", BumpMinor},
+		{"1.2.3", "2.0.0", BumpMajor},
+		{"1.3.0-rc.1", "1.3.0", BumpPatch},
+		{"invalid", "1.0.0", BumpPatch},
+	}
+	for _, tt := range tests {
+		if got := ChangeLevel(tt.from, tt.to); got != tt.want {
+			t.Errorf("ChangeLevel(%q, %q) = %q, want %q", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/semver"
+	"github.com/indaco/verso/internal/workspace"
+)
+
+// SatisfiesOperation checks a module's version against a constraint expression.
+type SatisfiesOperation struct {
+	fs          core.FileSystem
+	constraints *semver.Constraints
+}
+
+// NewSatisfiesOperation creates a new satisfies operation.
+func NewSatisfiesOperation(fs core.FileSystem, constraints *semver.Constraints) *SatisfiesOperation {
+	return &SatisfiesOperation{
+		fs:          fs,
+		constraints: constraints,
+	}
+}
+
+// Execute reads the module version and returns an error if it does not
+// satisfy the constraints. The version is stored in the module's CurrentVersion field.
+func (op *SatisfiesOperation) Execute(ctx context.Context, mod *workspace.Module) error {
+	// Check for context cancellation
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	vm := semver.NewVersionManager(op.fs, nil)
+
+	ver, err := vm.Read(mod.Path)
+	if err != nil {
+		return fmt.Errorf("failed to read version from %s: %w", mod.Path, err)
+	}
+
+	mod.CurrentVersion = ver.String()
+
+	if !op.constraints.Check(ver) {
+		return fmt.Errorf("version %s does not satisfy %q", ver.String(), op.constraints.String())
+	}
+
+	return nil
+}
+
+// Name returns the name of this operation.
+func (op *SatisfiesOperation) Name() string {
+	return "satisfies"
+}
@@ -0,0 +1,71 @@
+package operations
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/semver"
+	"github.com/indaco/verso/internal/workspace"
+)
+
+func TestSatisfiesOperation_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    string
+		constraint string
+		wantErr    bool
+	}{
+		{"caret match", "1.4.0\n", "^1.2", false},
+		{"caret mismatch", "2.0.0\n", "^1.2", true},
+		{"range match", "1.9.9\n", ">=1.0.0 <2.0.0", false},
+		{"pre-release excluded", "1.5.0-rc.1\n", ">=1.0.0", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := core.NewMockFileSystem()
+			fs.SetFile("/test/.version", []byte(tt.version))
+
+			c, err := semver.ParseConstraints(tt.constraint)
+			if err != nil {
+				t.Fatalf("ParseConstraints failed: %v", err)
+			}
+
+			op := NewSatisfiesOperation(fs, c)
+			mod := &workspace.Module{Name: "test", Path: "/test/.version"}
+
+			err = op.Execute(context.Background(), mod)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), "does not satisfy") {
+				t.Errorf("unexpected error message: %v", err)
+			}
+			if mod.CurrentVersion != strings.TrimSpace(tt.version) {
+				t.Errorf("module CurrentVersion = %q, want %q", mod.CurrentVersion, strings.TrimSpace(tt.version))
+			}
+		})
+	}
+}
+
+func TestSatisfiesOperation_Execute_FileNotFound(t *testing.T) {
+	c, _ := semver.ParseConstraints("*")
+	op := NewSatisfiesOperation(core.NewMockFileSystem(), c)
+
+	err := op.Execute(context.Background(), &workspace.Module{Name: "test", Path: "/test/.version"})
+	if err == nil {
+		t.Fatal("expected error for missing file, got nil")
+	}
+}
+
+func TestSatisfiesOperation_Name(t *testing.T) {
+	op := NewSatisfiesOperation(core.NewMockFileSystem(), nil)
+	if op.Name() != "satisfies" {
+		t.Errorf("Name() = %q, want %q", op.Name(), "satisfies")
+	}
+}
@@ -39,6 +39,7 @@ type AuditLogPlugin struct {
 // Entry represents a single audit log entry.
 type Entry struct {
 	Timestamp       string `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+	Module          string `json:"module,omitempty" yaml:"module,omitempty"`
 	PreviousVersion string `json:"previous_version" yaml:"previous_version"`
 	NewVersion      string `json:"new_version" yaml:"new_version"`
 	BumpType        string `json:"bump_type" yaml:"bump_type"`
@@ -4,14 +4,20 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
+
+	"github.com/indaco/verso/internal/semver"
 )
 
 // Generator handles changelog content generation.
 type Generator struct {
 	config *Config
 	remote *RemoteInfo
+
+	// notes are written before the grouped commits, in a "Release Notes" section.
+	notes []string
 }
 
 // NewGenerator creates a new changelog generator.
@@ -125,6 +131,15 @@ func (g *Generator) GenerateVersionChangelogWithResult(version, previousVersion
 		}
 	}
 
+	// Release notes
+	if len(g.notes) > 0 {
+		sb.WriteString("### Release Notes\n\n")
+		for _, note := range g.notes {
+			sb.WriteString(formatNote(note))
+		}
+		sb.WriteString("\n")
+	}
+
 	// Grouped commits
 	for _, label := range sortedKeys {
 		commits := grouped[label]
@@ -166,6 +181,18 @@ func (g *Generator) GenerateVersionChangelogWithResult(version, previousVersion
 	}
 }
 
+// formatNote formats a release note as a list item. Lines after the first are
+// indented to stay in the item.
+func formatNote(note string) string {
+	lines := strings.Split(strings.TrimSpace(note), "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" {
+			lines[i] = "  " + lines[i]
+		}
+	}
+	return "- " + strings.Join(lines, "\n") + "\n"
+}
+
 // buildCompareURL generates a compare URL for the provider.
 func (g *Generator) buildCompareURL(remote *RemoteInfo, prev, curr string) string {
 	switch remote.Provider {
@@ -416,12 +443,32 @@ func (g *Generator) MergeVersionedFiles() error {
 	return nil
 }
 
-// sortVersionFiles sorts version files by semantic version (newest first).
+// sortVersionFiles sorts version files by semantic version precedence (newest first).
+// Files whose names cannot be parsed as versions are placed after all versioned
+// files, in reverse lexicographic order.
 func sortVersionFiles(files []string) {
-	// Simple reverse lexicographic sort (works for vX.Y.Z format)
-	for i := 1; i < len(files); i++ {
-		for j := i; j > 0 && files[j] > files[j-1]; j-- {
-			files[j], files[j-1] = files[j-1], files[j]
+	slices.SortStableFunc(files, func(a, b string) int {
+		va, errA := versionFromFilename(a)
+		vb, errB := versionFromFilename(b)
+
+		switch {
+		case errA == nil && errB == nil:
+			if c := semver.Compare(vb, va); c != 0 {
+				return c
+			}
+		case errA == nil:
+			return -1
+		case errB == nil:
+			return 1
 		}
-	}
+
+		return strings.Compare(b, a)
+	})
+}
+
+// versionFromFilename parses the version encoded in a changelog file name
+// such as ".changes/v1.2.3.md".
+func versionFromFilename(path string) (semver.SemVersion, error) {
+	name := strings.TrimSuffix(filepath.Base(path), ".md")
+	return semver.ParseVersion(name)
 }
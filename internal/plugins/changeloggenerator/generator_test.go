@@ -918,3 +918,30 @@ Some description about this project.
 		t.Error("expected new version in result")
 	}
 }
+
+func TestSortVersionFiles_SemverPrecedence(t *testing.T) {
+	files := []string{
+		"/tmp/.changes/v1.9.0.md",
+		"/tmp/.changes/v1.10.0.md",
+		"/tmp/.changes/v1.10.0-rc.1.md",
+		"/tmp/.changes/v1.10.0-rc.10.md",
+		"/tmp/.changes/v1.10.0-rc.2.md",
+		"/tmp/.changes/vnotes.md",
+	}
+
+	sortVersionFiles(files)
+
+	expected := []string{
+		"/tmp/.changes/v1.10.0.md",
+		"/tmp/.changes/v1.10.0-rc.10.md",
+		"/tmp/.changes/v1.10.0-rc.2.md",
+		"/tmp/.changes/v1.10.0-rc.1.md",
+		"/tmp/.changes/v1.9.0.md",
+		"/tmp/.changes/vnotes.md",
+	}
+	for i, want := range expected {
+		if files[i] != want {
+			t.Errorf("position %d: expected %s, got %s", i, want, files[i])
+		}
+	}
+}
@@ -13,6 +13,7 @@ type CommitInfo struct {
 	Hash        string
 	ShortHash   string
 	Subject     string
+	Body        string // Message body after the subject, including footers
 	Author      string
 	AuthorEmail string
 }
@@ -40,14 +41,34 @@ var KnownProviders = map[string]string{
 var (
 	execCommand          = exec.Command
 	GetCommitsWithMetaFn = getCommitsWithMeta
+	GetModuleCommitsFn   = getModuleCommits
 	GetRemoteInfoFn      = getRemoteInfo
 	GetLatestTagFn       = getLatestTag
+	GetLatestModuleTagFn = getLatestModuleTag
 	GetContributorsFn    = getContributors
 )
 
+// Separators used in git log output. Bodies span several lines, so fields are
+// split on the ASCII unit separator and commits on the record separator.
+const (
+	fieldSeparator  = "\x1f"
+	recordSeparator = "\x1e"
+)
+
 // getCommitsWithMeta retrieves commits between two refs with full metadata.
-// Format: hash|short_hash|subject|author|email
+// Format: hash, short_hash, subject, author, email, body
 func getCommitsWithMeta(since, until string) ([]CommitInfo, error) {
+	return getCommitsWithMetaInPath(since, until, "")
+}
+
+// getModuleCommits retrieves the commits between two refs that touch dir.
+func getModuleCommits(since, until, dir string) ([]CommitInfo, error) {
+	return getCommitsWithMetaInPath(since, until, dir)
+}
+
+// getCommitsWithMetaInPath retrieves commits between two refs, limited to the
+// commits touching path if it is not empty.
+func getCommitsWithMetaInPath(since, until, path string) ([]CommitInfo, error) {
 	if until == "" {
 		until = "HEAD"
 	}
@@ -62,9 +83,12 @@ func getCommitsWithMeta(since, until string) ([]CommitInfo, error) {
 	}
 
 	revRange := since + ".." + until
-	// Use a delimiter that's unlikely to appear in commit messages
-	format := "%H|%h|%s|%an|%ae"
-	cmd := execCommand("git", "log", "--pretty=format:"+format, revRange)
+	format := strings.Join([]string{"%H", "%h", "%s", "%an", "%ae", "%b"}, "%x1f") + "%x1e"
+	args := []string{"log", "--pretty=format:" + format, revRange}
+	if path != "" {
+		args = append(args, "--", path)
+	}
+	cmd := execCommand("git", args...)
 
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
@@ -78,16 +102,15 @@ func getCommitsWithMeta(since, until string) ([]CommitInfo, error) {
 		return nil, fmt.Errorf("git log failed: %w", err)
 	}
 
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-	if len(lines) == 1 && lines[0] == "" {
-		return []CommitInfo{}, nil
-	}
-
-	commits := make([]CommitInfo, 0, len(lines))
-	for _, line := range lines {
-		parts := strings.SplitN(line, "|", 5)
-		if len(parts) < 5 {
-			continue // Skip malformed lines
+	commits := []CommitInfo{}
+	for record := range strings.SplitSeq(string(output), recordSeparator) {
+		record = strings.TrimLeft(record, "\n")
+		if record == "" {
+			continue
+		}
+		parts := strings.SplitN(record, fieldSeparator, 6)
+		if len(parts) < 6 {
+			continue // Skip malformed records
 		}
 		commits = append(commits, CommitInfo{
 			Hash:        parts[0],
@@ -95,6 +118,7 @@ func getCommitsWithMeta(since, until string) ([]CommitInfo, error) {
 			Subject:     parts[2],
 			Author:      parts[3],
 			AuthorEmail: parts[4],
+			Body:        strings.TrimSpace(parts[5]),
 		})
 	}
 
@@ -103,7 +127,23 @@ func getCommitsWithMeta(since, until string) ([]CommitInfo, error) {
 
 // getLatestTag returns the most recent git tag.
 func getLatestTag() (string, error) {
-	cmd := execCommand("git", "describe", "--tags", "--abbrev=0")
+	return describeTag()
+}
+
+// getLatestModuleTag returns the most recent tag of the named module: a tag
+// prefixed with "<module>/", "<module>@" or "<module>-v".
+func getLatestModuleTag(module string) (string, error) {
+	return describeTag(module+"/*", module+"@*", module+"-v*")
+}
+
+// describeTag returns the most recent git tag matching one of patterns, or
+// any tag if no pattern is given.
+func describeTag(patterns ...string) (string, error) {
+	args := []string{"describe", "--tags", "--abbrev=0"}
+	for _, pattern := range patterns {
+		args = append(args, "--match", pattern)
+	}
+	cmd := execCommand("git", args...)
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
 
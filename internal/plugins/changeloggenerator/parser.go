@@ -3,6 +3,8 @@ package changeloggenerator
 import (
 	"regexp"
 	"strings"
+
+	"github.com/indaco/verso/internal/plugins/commitparser/conventional"
 )
 
 // ParsedCommit represents a fully parsed conventional commit.
@@ -17,19 +19,20 @@ type ParsedCommit struct {
 
 // Regex patterns for conventional commit parsing.
 var (
-	// Matches: type(scope)!: description or type!: description or type: description
-	conventionalCommitRe = regexp.MustCompile(`^(\w+)(?:\(([^)]+)\))?(!)?:\s*(.+)$`)
-
 	// Matches: (#123) or (closes #123) etc at end of message
 	prNumberRe = regexp.MustCompile(`\(?#(\d+)\)?`)
 )
 
-// ParseConventionalCommit parses a commit message into its components.
-// Returns nil if the commit doesn't follow conventional commit format.
+// ParseConventionalCommit parses a commit message (subject and body) into its
+// components. Commits that don't follow conventional commit format are returned
+// with an empty Type and the subject as description.
 func ParseConventionalCommit(commit CommitInfo) *ParsedCommit {
-	matches := conventionalCommitRe.FindStringSubmatch(commit.Subject)
-	if matches == nil {
-		// Not a conventional commit, return with just the subject as description
+	message := commit.Subject
+	if commit.Body != "" {
+		message += "\n\n" + commit.Body
+	}
+	c := conventional.Parse(message)
+	if !c.IsConventional() {
 		return &ParsedCommit{
 			CommitInfo:  commit,
 			Type:        "",
@@ -39,10 +42,10 @@ func ParseConventionalCommit(commit CommitInfo) *ParsedCommit {
 
 	parsed := &ParsedCommit{
 		CommitInfo:  commit,
-		Type:        strings.ToLower(matches[1]),
-		Scope:       matches[2],
-		Breaking:    matches[3] == "!",
-		Description: matches[4],
+		Type:        c.Type,
+		Scope:       c.Scope,
+		Breaking:    c.IsBreaking(),
+		Description: c.Description,
 	}
 
 	// Extract PR number from description and remove it from the description text
@@ -8,6 +8,7 @@ func TestParseConventionalCommit(t *testing.T) {
 	tests := []struct {
 		name         string
 		subject      string
+		body         string
 		wantType     string
 		wantScope    string
 		wantDesc     string
@@ -69,6 +70,22 @@ func TestParseConventionalCommit(t *testing.T) {
 			wantScope: "ci-cd",
 			wantDesc:  "update pipeline",
 		},
+		{
+			name:         "breaking change footer in body",
+			subject:      "refactor(api): drop v1 endpoints",
+			body:         "The v1 API was deprecated in 1.4.\n\nBREAKING CHANGE: clients must use /v2",
+			wantType:     "refactor",
+			wantScope:    "api",
+			wantBreaking: true,
+			wantDesc:     "drop v1 endpoints",
+		},
+		{
+			name:     "body without breaking footer",
+			subject:  "fix: handle empty input",
+			body:     "Refs: #42",
+			wantType: "fix",
+			wantDesc: "handle empty input",
+		},
 	}
 
 	for _, tt := range tests {
@@ -77,6 +94,7 @@ func TestParseConventionalCommit(t *testing.T) {
 				Hash:      "abc123",
 				ShortHash: "abc",
 				Subject:   tt.subject,
+				Body:      tt.body,
 				Author:    "Test Author",
 			}
 
@@ -3,6 +3,7 @@ package changeloggenerator
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 )
 
 // ChangelogGenerator defines the interface for changelog generation.
@@ -21,14 +22,25 @@ type ChangelogGenerator interface {
 	GetConfig() *Config
 }
 
+// ModuleChangelogGenerator is implemented by changelog generators that write
+// the changelogs of workspace modules.
+type ModuleChangelogGenerator interface {
+	// GenerateForModule generates the changelog of the module in dir from the
+	// commits touching dir.
+	GenerateForModule(dir, version, previousVersion, bumpType string) error
+}
+
 // ChangelogGeneratorPlugin implements the ChangelogGenerator interface.
 type ChangelogGeneratorPlugin struct {
 	config    *Config
 	generator *Generator
 }
 
-// Ensure ChangelogGeneratorPlugin implements ChangelogGenerator.
-var _ ChangelogGenerator = (*ChangelogGeneratorPlugin)(nil)
+// Ensure ChangelogGeneratorPlugin implements ChangelogGenerator and ModuleChangelogGenerator.
+var (
+	_ ChangelogGenerator       = (*ChangelogGeneratorPlugin)(nil)
+	_ ModuleChangelogGenerator = (*ChangelogGeneratorPlugin)(nil)
+)
 
 // NewChangelogGenerator creates a new changelog generator plugin.
 func NewChangelogGenerator(cfg *Config) *ChangelogGeneratorPlugin {
@@ -62,6 +74,15 @@ func (p *ChangelogGeneratorPlugin) GetConfig() *Config {
 	return p.config
 }
 
+// WithNotes returns a copy of the plugin that writes notes, such as the
+// summaries of change files, in a "Release Notes" section of the generated
+// changelogs.
+func (p *ChangelogGeneratorPlugin) WithNotes(notes []string) *ChangelogGeneratorPlugin {
+	generator := *p.generator
+	generator.notes = notes
+	return &ChangelogGeneratorPlugin{config: p.config, generator: &generator}
+}
+
 // GenerateForVersion generates changelog for a version bump.
 func (p *ChangelogGeneratorPlugin) GenerateForVersion(version, previousVersion, bumpType string) error {
 	if !p.config.Enabled {
@@ -74,7 +95,69 @@ func (p *ChangelogGeneratorPlugin) GenerateForVersion(version, previousVersion,
 		return fmt.Errorf("failed to get commits: %w", err)
 	}
 
-	if len(commits) == 0 {
+	return p.generate(version, previousVersion, commits)
+}
+
+// GenerateForModule generates the changelog of the module in dir. Only the
+// commits touching dir are included, and the changes directory and changelog
+// path are resolved relative to dir.
+func (p *ChangelogGeneratorPlugin) GenerateForModule(dir, version, previousVersion, bumpType string) error {
+	if !p.config.Enabled {
+		return nil
+	}
+
+	commits, err := GetModuleCommitsFn(previousVersion, "HEAD", dir)
+	if err != nil {
+		return fmt.Errorf("failed to get commits: %w", err)
+	}
+
+	return p.forModule(dir).generate(version, previousVersion, commits)
+}
+
+// PreviewForVersion returns the changelog entry GenerateForVersion would
+// write, without writing it. It is empty if there is nothing to record.
+func (p *ChangelogGeneratorPlugin) PreviewForVersion(version, previousVersion string) (string, error) {
+	commits, err := GetCommitsWithMetaFn(previousVersion, "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("failed to get commits: %w", err)
+	}
+	return p.preview(version, previousVersion, commits), nil
+}
+
+// PreviewForModule returns the changelog entry GenerateForModule would
+// write for the module in dir, without writing it. It is empty if there is
+// nothing to record.
+func (p *ChangelogGeneratorPlugin) PreviewForModule(dir, version, previousVersion string) (string, error) {
+	commits, err := GetModuleCommitsFn(previousVersion, "HEAD", dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to get commits: %w", err)
+	}
+	return p.forModule(dir).preview(version, previousVersion, commits), nil
+}
+
+// forModule returns a copy of the plugin writing the changelog of the module
+// in dir.
+func (p *ChangelogGeneratorPlugin) forModule(dir string) *ChangelogGeneratorPlugin {
+	cfg := *p.config
+	cfg.ChangesDir = filepath.Join(dir, cfg.ChangesDir)
+	cfg.ChangelogPath = filepath.Join(dir, cfg.ChangelogPath)
+	generator := NewGenerator(&cfg)
+	generator.notes = p.generator.notes
+	return &ChangelogGeneratorPlugin{config: &cfg, generator: generator}
+}
+
+// preview returns the changelog entry of version from commits and release
+// notes, or an empty string if there are neither.
+func (p *ChangelogGeneratorPlugin) preview(version, previousVersion string, commits []CommitInfo) string {
+	if len(commits) == 0 && len(p.generator.notes) == 0 {
+		return ""
+	}
+	return p.generator.GenerateVersionChangelogWithResult(version, previousVersion, commits).Content
+}
+
+// generate writes the changelog of version from commits and release notes.
+func (p *ChangelogGeneratorPlugin) generate(version, previousVersion string, commits []CommitInfo) error {
+	if len(commits) == 0 && len(p.generator.notes) == 0 {
 		return nil // No commits to process
 	}
 
@@ -1,6 +1,7 @@
 package changeloggenerator
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -255,6 +256,137 @@ func TestGenerateForVersion_Enabled_BothMode(t *testing.T) {
 	}
 }
 
+func TestGenerateForModule(t *testing.T) {
+	tmpDir := t.TempDir()
+	moduleDir := filepath.Join(tmpDir, "services", "api")
+
+	cfg := DefaultConfig()
+	cfg.Enabled = true
+	cfg.Mode = "both"
+	cfg.ChangesDir = ".changes"
+	cfg.ChangelogPath = "CHANGELOG.md"
+	cfg.Repository = &RepositoryConfig{Provider: "github", Host: "github.com", Owner: "testowner", Repo: "testrepo"}
+	plugin := NewChangelogGenerator(cfg)
+
+	originalFn := GetModuleCommitsFn
+	var gotSince, gotDir string
+	GetModuleCommitsFn = func(since, until, dir string) ([]CommitInfo, error) {
+		gotSince, gotDir = since, dir
+		return []CommitInfo{
+			{Hash: "abc123", ShortHash: "abc123", Subject: "fix(api): handle timeouts", Author: "Test", AuthorEmail: "test@example.com"},
+		}, nil
+	}
+	defer func() { GetModuleCommitsFn = originalFn }()
+
+	if err := plugin.GenerateForModule(moduleDir, "v1.2.4", "api/v1.2.3", "patch"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotSince != "api/v1.2.3" || gotDir != moduleDir {
+		t.Errorf("commits since %q in %q, want %q in %q", gotSince, gotDir, "api/v1.2.3", moduleDir)
+	}
+	for _, path := range []string{
+		filepath.Join(moduleDir, ".changes", "v1.2.4.md"),
+		filepath.Join(moduleDir, "CHANGELOG.md"),
+	} {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("expected changelog at %s: %v", path, err)
+		}
+		if !strings.Contains(string(data), "handle timeouts") {
+			t.Errorf("%s does not contain the module commit", path)
+		}
+	}
+
+	if cfg.ChangelogPath != "CHANGELOG.md" {
+		t.Error("GenerateForModule should not change the plugin configuration")
+	}
+}
+
+func TestGenerateForVersion_WithNotes(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := DefaultConfig()
+	cfg.Enabled = true
+	cfg.Mode = "versioned"
+	cfg.ChangesDir = tmpDir
+	cfg.Repository = &RepositoryConfig{Provider: "github", Host: "github.com", Owner: "testowner", Repo: "testrepo"}
+	plugin := NewChangelogGenerator(cfg)
+
+	originalFn := GetCommitsWithMetaFn
+	GetCommitsWithMetaFn = func(since, until string) ([]CommitInfo, error) {
+		return []CommitInfo{}, nil
+	}
+	defer func() { GetCommitsWithMetaFn = originalFn }()
+
+	notes := []string{"Add search.", "Rework the cache.\n\nEntries now expire."}
+	if err := plugin.WithNotes(notes).GenerateForVersion("v1.1.0", "v1.0.0", "minor"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, "v1.1.0.md"))
+	if err != nil {
+		t.Fatalf("expected a changelog without commits: %v", err)
+	}
+	want := "### Release Notes\n\n- Add search.\n- Rework the cache.\n\n  Entries now expire.\n"
+	if !strings.Contains(string(data), want) {
+		t.Errorf("changelog = %q, want it to contain %q", data, want)
+	}
+
+	if len(plugin.generator.notes) != 0 {
+		t.Error("WithNotes should not change the plugin")
+	}
+}
+
+func TestPreview(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := DefaultConfig()
+	cfg.Enabled = true
+	cfg.Mode = "both"
+	cfg.ChangesDir = filepath.Join(tmpDir, ".changes")
+	cfg.ChangelogPath = filepath.Join(tmpDir, "CHANGELOG.md")
+	cfg.Repository = &RepositoryConfig{Provider: "github", Host: "github.com", Owner: "testowner", Repo: "testrepo"}
+	plugin := NewChangelogGenerator(cfg)
+
+	origCommits, origModuleCommits := GetCommitsWithMetaFn, GetModuleCommitsFn
+	defer func() { GetCommitsWithMetaFn, GetModuleCommitsFn = origCommits, origModuleCommits }()
+	GetCommitsWithMetaFn = func(since, until string) ([]CommitInfo, error) {
+		return []CommitInfo{{Hash: "abc123", ShortHash: "abc123", Subject: "feat: add search"}}, nil
+	}
+	GetModuleCommitsFn = func(since, until, dir string) ([]CommitInfo, error) {
+		return nil, nil
+	}
+
+	entry, err := plugin.PreviewForVersion("v1.1.0", "v1.0.0")
+	if err != nil {
+		t.Fatalf("PreviewForVersion() error = %v", err)
+	}
+	if !strings.Contains(entry, "## v1.1.0") || !strings.Contains(entry, "add search") {
+		t.Errorf("PreviewForVersion() = %q", entry)
+	}
+
+	entry, err = plugin.PreviewForModule(filepath.Join(tmpDir, "api"), "v2.0.1", "api/v2.0.0")
+	if err != nil || entry != "" {
+		t.Errorf("PreviewForModule() without commits = %q, %v", entry, err)
+	}
+	entry, _ = plugin.WithNotes([]string{"Fix the parser."}).PreviewForModule(filepath.Join(tmpDir, "api"), "v2.0.1", "api/v2.0.0")
+	if !strings.Contains(entry, "- Fix the parser.") {
+		t.Errorf("PreviewForModule() with notes = %q", entry)
+	}
+
+	if entries, _ := os.ReadDir(tmpDir); len(entries) != 0 {
+		t.Errorf("expected nothing written, found %d entries", len(entries))
+	}
+
+	GetCommitsWithMetaFn = func(since, until string) ([]CommitInfo, error) {
+		return nil, errors.New("git failed")
+	}
+	if _, err := plugin.PreviewForVersion("v1.1.0", "v1.0.0"); err == nil {
+		t.Error("PreviewForVersion() expected error")
+	}
+}
+
 func TestGenerateForVersion_NoCommits(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.Enabled = true
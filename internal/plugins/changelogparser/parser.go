@@ -160,34 +160,53 @@ func (p *changelogFileParser) mapSubsectionsToFields(section *UnreleasedSection)
 	}
 }
 
-// InferBumpType determines the bump type based on changelog entries.
+// InferBumpType determines the bump type based on changelog entries: the
+// level of the first entry listed by Entries, which lists the highest first.
 // Priority: major (Removed/Changed) > minor (Added) > patch (Fixed/Security/Deprecated)
 func (s *UnreleasedSection) InferBumpType() (string, error) {
 	if !s.HasEntries {
 		return "", errors.New("no changelog entries found in unreleased section")
 	}
 
-	// Major: Removed or Changed sections (breaking changes)
-	if len(s.Removed) > 0 {
-		return "major", nil
+	if entries := s.Entries(); len(entries) > 0 {
+		return entries[0].Level, nil
 	}
 
-	// Changed can indicate major or minor depending on content
-	// For simplicity, we treat any Changed as major (conservative approach)
-	if len(s.Changed) > 0 {
-		return "major", nil
-	}
+	// No recognized sections with content
+	return "", errors.New("no bump type could be inferred from changelog")
+}
 
-	// Minor: Added section (new features)
-	if len(s.Added) > 0 {
-		return "minor", nil
-	}
+// ChangelogEntry is an Unreleased entry with the bump level its subsection implies.
+type ChangelogEntry struct {
+	Section string `json:"section"`
+	Entry   string `json:"entry"`
+	Level   string `json:"level"`
+}
 
-	// Patch: Fixed, Security, or Deprecated
-	if len(s.Fixed) > 0 || len(s.Security) > 0 || len(s.Deprecated) > 0 {
-		return "patch", nil
+// Entries lists the entries of the subsections used for inference, with
+// their bump level, from the highest level down. It is the single mapping of
+// subsections to levels, used by InferBumpType:
+// Removed and Changed (major), Added (minor), Fixed, Security and Deprecated (patch).
+func (s *UnreleasedSection) Entries() []ChangelogEntry {
+	sections := []struct {
+		name    string
+		entries []string
+		level   string
+	}{
+		{"Removed", s.Removed, "major"},
+		// Changed can be breaking or not; it is treated as major to be safe
+		{"Changed", s.Changed, "major"},
+		{"Added", s.Added, "minor"},
+		{"Fixed", s.Fixed, "patch"},
+		{"Security", s.Security, "patch"},
+		{"Deprecated", s.Deprecated, "patch"},
 	}
 
-	// No recognized sections with content
-	return "", errors.New("no bump type could be inferred from changelog")
+	var entries []ChangelogEntry
+	for _, section := range sections {
+		for _, entry := range section.entries {
+			entries = append(entries, ChangelogEntry{Section: section.name, Entry: entry, Level: section.level})
+		}
+	}
+	return entries
 }
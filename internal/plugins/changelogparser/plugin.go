@@ -102,6 +102,21 @@ func (p *ChangelogParserPlugin) InferBumpType() (string, error) {
 	return bumpType, nil
 }
 
+// UnreleasedEntries returns the Unreleased entries used for inference, with
+// the bump level each one implies.
+func (p *ChangelogParserPlugin) UnreleasedEntries() ([]ChangelogEntry, error) {
+	if !p.IsEnabled() || !p.config.InferBumpType {
+		return nil, errors.New("changelog parser not enabled or inference disabled")
+	}
+
+	parser := newChangelogFileParser(p.config.Path)
+	section, err := parser.ParseUnreleased()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse unreleased section: %w", err)
+	}
+	return section.Entries(), nil
+}
+
 // ValidateHasEntries validates that the Unreleased section has entries.
 func (p *ChangelogParserPlugin) ValidateHasEntries() error {
 	if !p.IsEnabled() || !p.config.RequireUnreleasedSection {
@@ -3,6 +3,7 @@ package changelogparser
 import (
 	"errors"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -276,6 +277,49 @@ func TestInferBumpType_Plugin(t *testing.T) {
 	})
 }
 
+func TestUnreleasedEntries(t *testing.T) {
+	origOpenFile := openFileFn
+	defer func() { openFileFn = origOpenFile }()
+
+	openFileFn = mockOpenFile(`# Changelog
+
+## [Unreleased]
+
+### Added
+- Login page
+
+### Fixed
+- Crash on start
+
+### Changed
+- Config format
+
+## [1.0.0] - 2024-01-01
+
+### Added
+- Initial release
+`)
+
+	plugin := NewChangelogParser(&Config{Enabled: true, InferBumpType: true})
+	entries, err := plugin.UnreleasedEntries()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []ChangelogEntry{
+		{Section: "Changed", Entry: "Config format", Level: "major"},
+		{Section: "Added", Entry: "Login page", Level: "minor"},
+		{Section: "Fixed", Entry: "Crash on start", Level: "patch"},
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("UnreleasedEntries() = %+v, want %+v", entries, want)
+	}
+
+	if _, err := NewChangelogParser(&Config{Enabled: false}).UnreleasedEntries(); err == nil {
+		t.Error("expected error when plugin disabled")
+	}
+}
+
 func TestValidateHasEntries(t *testing.T) {
 	// Save original and restore after test
 	origOpenFile := openFileFn
@@ -0,0 +1,79 @@
+package commitparser
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Bump levels that commit types and scopes can map to.
+const (
+	LevelMajor = "major"
+	LevelMinor = "minor"
+	LevelPatch = "patch"
+	LevelNone  = "none"
+)
+
+// levelRank orders bump levels so the highest one wins.
+var levelRank = map[string]int{
+	LevelNone:  0,
+	LevelPatch: 1,
+	LevelMinor: 2,
+	LevelMajor: 3,
+}
+
+// Config holds configuration for the commit parser plugin.
+type Config struct {
+	// Convention selects the commit message convention: conventional
+	// (default), angular, gitmoji or regex.
+	Convention string
+
+	// Pattern is the subject pattern of the regex convention, with named
+	// groups "type", "scope", "breaking" and "description".
+	Pattern string
+
+	// Types maps commit types to bump levels. Entries extend the
+	// convention's built-in mapping (e.g. feat: minor, fix: patch).
+	Types map[string]string
+
+	// Scopes maps commit scopes to bump levels, overriding Types.
+	Scopes map[string]string
+
+	// Default is the bump level for types not listed in Types (default: "none").
+	Default string
+
+	// IgnoreScopes lists scopes whose commits never affect the bump.
+	IgnoreScopes []string
+}
+
+// DefaultConfig returns the default commit parser configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		Convention: ConventionConventional,
+		Default:    LevelNone,
+	}
+}
+
+// Validate checks that all configured bump levels are known.
+func (c *Config) Validate() error {
+	if c.Default != "" {
+		if _, ok := levelRank[c.Default]; !ok {
+			return fmt.Errorf("invalid default bump level %q (want major, minor, patch or none)", c.Default)
+		}
+	}
+	for _, kind := range []struct {
+		name    string
+		mapping map[string]string
+	}{{"type", c.Types}, {"scope", c.Scopes}} {
+		keys := make([]string, 0, len(kind.mapping))
+		for k := range kind.mapping {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if _, ok := levelRank[kind.mapping[k]]; !ok {
+				return fmt.Errorf("invalid bump level %q for %s %q (want major, minor, patch or none)", kind.mapping[k], kind.name, k)
+			}
+		}
+	}
+	return nil
+}
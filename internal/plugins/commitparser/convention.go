@@ -0,0 +1,219 @@
+package commitparser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/indaco/verso/internal/plugins/commitparser/conventional"
+)
+
+// Supported commit conventions.
+const (
+	ConventionConventional = "conventional"
+	ConventionAngular      = "angular"
+	ConventionGitmoji      = "gitmoji"
+	ConventionRegex        = "regex"
+)
+
+// Convention extracts structured commits from commit messages following a
+// commit message convention. Parsed commits are mapped to bump levels by
+// their type and scope.
+type Convention interface {
+	// Name returns the convention name, as used in configuration.
+	Name() string
+
+	// Parse parses a full commit message. Messages that do not follow the
+	// convention are returned with an empty Type.
+	Parse(message string) conventional.Commit
+
+	// DefaultTypes returns the built-in type to bump level mapping.
+	DefaultTypes() map[string]string
+}
+
+// NewConvention returns the named convention. An empty name selects
+// Conventional Commits; pattern is only used by the regex convention.
+func NewConvention(name, pattern string) (Convention, error) {
+	switch strings.ToLower(name) {
+	case "", ConventionConventional:
+		return conventionalCommits{}, nil
+	case ConventionAngular:
+		return angular{}, nil
+	case ConventionGitmoji:
+		return gitmoji{}, nil
+	case ConventionRegex:
+		return newRegexConvention(pattern)
+	default:
+		return nil, fmt.Errorf("unknown commit convention %q (want conventional, angular, gitmoji or regex)", name)
+	}
+}
+
+/* ------------------------------------------------------------------------- */
+/* CONVENTIONAL COMMITS                                                      */
+/* ------------------------------------------------------------------------- */
+
+// conventionalCommits implements https://www.conventionalcommits.org.
+type conventionalCommits struct{}
+
+func (conventionalCommits) Name() string { return ConventionConventional }
+
+func (conventionalCommits) Parse(message string) conventional.Commit {
+	return conventional.Parse(message)
+}
+
+func (conventionalCommits) DefaultTypes() map[string]string {
+	return map[string]string{
+		"feat": LevelMinor,
+		"fix":  LevelPatch,
+	}
+}
+
+/* ------------------------------------------------------------------------- */
+/* ANGULAR                                                                   */
+/* ------------------------------------------------------------------------- */
+
+// angularTypes are the commit types allowed by the Angular convention.
+var angularTypes = map[string]bool{
+	"build": true, "ci": true, "docs": true, "feat": true, "fix": true,
+	"perf": true, "refactor": true, "style": true, "test": true,
+}
+
+// angular implements the Angular commit message guidelines: a fixed set of
+// types, with breaking changes declared in a BREAKING CHANGE footer.
+type angular struct{}
+
+func (angular) Name() string { return ConventionAngular }
+
+func (angular) Parse(message string) conventional.Commit {
+	c := conventional.Parse(message)
+	if !angularTypes[c.Type] {
+		c.Type, c.Scope, c.Bang, c.Description = "", "", false, c.Subject
+	}
+	return c
+}
+
+func (angular) DefaultTypes() map[string]string {
+	return map[string]string{
+		"feat": LevelMinor,
+		"fix":  LevelPatch,
+		"perf": LevelPatch,
+	}
+}
+
+/* ------------------------------------------------------------------------- */
+/* GITMOJI                                                                   */
+/* ------------------------------------------------------------------------- */
+
+// gitmojiCodes maps gitmoji emojis to their shortcodes (without colons).
+var gitmojiCodes = map[string]string{
+	"🎨": "art", "⚡": "zap", "🔥": "fire", "🐛": "bug", "🚑": "ambulance",
+	"✨": "sparkles", "📝": "memo", "🚀": "rocket", "💄": "lipstick", "🎉": "tada",
+	"✅": "white_check_mark", "🔒": "lock", "🔖": "bookmark", "🚨": "rotating_light",
+	"🚧": "construction", "💚": "green_heart", "⬇": "arrow_down", "⬆": "arrow_up",
+	"📌": "pushpin", "👷": "construction_worker", "♻": "recycle", "➕": "heavy_plus_sign",
+	"➖": "heavy_minus_sign", "🔧": "wrench", "🌐": "globe_with_meridians", "✏": "pencil2",
+	"💥": "boom", "🩹": "adhesive_bandage", "🗃": "card_file_box", "⏪": "rewind",
+	"🔀": "twisted_rightwards_arrows", "🗑": "wastebasket",
+}
+
+// gitmojiRe matches ":code: (scope): description" or "<emoji> description".
+var gitmojiRe = regexp.MustCompile(`^(:[a-z0-9_+-]+:|\S+)\s*(?:\(([^)]+)\):?)?\s*(.*)$`)
+
+// gitmoji implements https://gitmoji.dev. The type of a commit is the
+// shortcode of its leading gitmoji; :boom: marks a breaking change.
+type gitmoji struct{}
+
+func (gitmoji) Name() string { return ConventionGitmoji }
+
+func (gitmoji) Parse(message string) conventional.Commit {
+	c := conventional.Parse(message)
+	c.Type, c.Scope, c.Bang, c.Description = "", "", false, c.Subject
+
+	m := gitmojiRe.FindStringSubmatch(c.Subject)
+	if m == nil {
+		return c
+	}
+
+	var code string
+	if strings.HasPrefix(m[1], ":") && strings.HasSuffix(m[1], ":") && len(m[1]) > 2 {
+		code = m[1][1 : len(m[1])-1]
+	} else {
+		code = gitmojiCodes[strings.TrimSuffix(m[1], "\ufe0f")]
+	}
+	if code == "" {
+		return c
+	}
+
+	c.Type = code
+	c.Scope = m[2]
+	c.Bang = code == "boom"
+	c.Description = m[3]
+	return c
+}
+
+func (gitmoji) DefaultTypes() map[string]string {
+	return map[string]string{
+		"boom":             LevelMajor,
+		"sparkles":         LevelMinor,
+		"bug":              LevelPatch,
+		"ambulance":        LevelPatch,
+		"lock":             LevelPatch,
+		"zap":              LevelPatch,
+		"adhesive_bandage": LevelPatch,
+	}
+}
+
+/* ------------------------------------------------------------------------- */
+/* REGEX                                                                     */
+/* ------------------------------------------------------------------------- */
+
+// regexConvention parses subjects with a user-provided pattern. Named groups
+// "type", "scope", "breaking" and "description" fill the commit; a non-empty
+// "breaking" group marks a breaking change. Footers are still parsed from the body.
+type regexConvention struct {
+	re *regexp.Regexp
+}
+
+func newRegexConvention(pattern string) (Convention, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("the regex commit convention requires a pattern")
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid commit pattern: %w", err)
+	}
+	if re.SubexpIndex("type") < 0 {
+		return nil, fmt.Errorf("commit pattern %q must have a named group \"type\"", pattern)
+	}
+	return regexConvention{re: re}, nil
+}
+
+func (regexConvention) Name() string { return ConventionRegex }
+
+func (r regexConvention) Parse(message string) conventional.Commit {
+	c := conventional.Parse(message)
+	c.Type, c.Scope, c.Bang, c.Description = "", "", false, c.Subject
+
+	m := r.re.FindStringSubmatch(c.Subject)
+	if m == nil {
+		return c
+	}
+
+	group := func(name string) string {
+		if i := r.re.SubexpIndex(name); i >= 0 {
+			return m[i]
+		}
+		return ""
+	}
+	c.Type = strings.ToLower(group("type"))
+	c.Scope = group("scope")
+	c.Bang = group("breaking") != ""
+	if desc := group("description"); desc != "" {
+		c.Description = desc
+	}
+	return c
+}
+
+func (regexConvention) DefaultTypes() map[string]string {
+	return conventionalCommits{}.DefaultTypes()
+}
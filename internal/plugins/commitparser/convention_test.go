@@ -0,0 +1,116 @@
+package commitparser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewConvention(t *testing.T) {
+	for _, name := range []string{"", "conventional", "Angular", "gitmoji"} {
+		c, err := NewConvention(name, "")
+		if err != nil {
+			t.Fatalf("NewConvention(%q) failed: %v", name, err)
+		}
+		want := strings.ToLower(name)
+		if want == "" {
+			want = ConventionConventional
+		}
+		if c.Name() != want {
+			t.Errorf("NewConvention(%q).Name() = %q, want %q", name, c.Name(), want)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    string
+	}{
+		{"svn", "", "unknown commit convention"},
+		{"regex", "", "requires a pattern"},
+		{"regex", `(?P<type>[`, "invalid commit pattern"},
+		{"regex", `^(\w+):`, `must have a named group "type"`},
+	}
+	for _, tt := range tests {
+		if _, err := NewConvention(tt.name, tt.pattern); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("NewConvention(%q, %q): expected error containing %q, got %v", tt.name, tt.pattern, tt.want, err)
+		}
+	}
+}
+
+func TestConventions_Parse(t *testing.T) {
+	regex, err := NewConvention(ConventionRegex, `^\[(?P<type>\w+)(?:/(?P<scope>\w+))?\](?P<breaking>!)?\s*(?P<description>.+)$`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		convention Convention
+		message    string
+		wantType   string
+		wantScope  string
+		wantBreak  bool
+		wantDesc   string
+	}{
+		{"angular feat", angular{}, "feat(core): add signal", "feat", "core", false, "add signal"},
+		{"angular unknown type", angular{}, "chore: tidy", "", "", false, "chore: tidy"},
+		{"angular footer", angular{}, "refactor: drop x\n\nBREAKING CHANGE: x is gone", "refactor", "", true, "drop x"},
+		{"gitmoji shortcode", gitmoji{}, ":sparkles: add login", "sparkles", "", false, "add login"},
+		{"gitmoji shortcode with scope", gitmoji{}, ":bug: (auth): fix token refresh", "bug", "auth", false, "fix token refresh"},
+		{"gitmoji unicode", gitmoji{}, "✨ add login", "sparkles", "", false, "add login"},
+		{"gitmoji unicode with variation selector", gitmoji{}, "🚑️ hotfix crash", "ambulance", "", false, "hotfix crash"},
+		{"gitmoji boom is breaking", gitmoji{}, ":boom: remove v1 API", "boom", "", true, "remove v1 API"},
+		{"gitmoji plain text", gitmoji{}, "Update README", "", "", false, "Update README"},
+		{"regex match", regex, "[Feature/ui]! new layout", "feature", "ui", true, "new layout"},
+		{"regex no match", regex, "feat: ignored", "", "", false, "feat: ignored"},
+		{"regex footer", regex, "[Fix] x\n\nBREAKING-CHANGE: y", "fix", "", true, "x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.convention.Parse(tt.message)
+			if c.Type != tt.wantType || c.Scope != tt.wantScope || c.IsBreaking() != tt.wantBreak || c.Description != tt.wantDesc {
+				t.Errorf("Parse(%q) = type %q, scope %q, breaking %v, description %q; want %q, %q, %v, %q",
+					tt.message, c.Type, c.Scope, c.IsBreaking(), c.Description,
+					tt.wantType, tt.wantScope, tt.wantBreak, tt.wantDesc)
+			}
+		})
+	}
+}
+
+func TestCommitParser_ParseWithConvention(t *testing.T) {
+	tests := []struct {
+		name         string
+		cfg          *Config
+		commits      []string
+		expectedBump string
+		expectError  bool
+	}{
+		{"gitmoji sparkles", &Config{Convention: "gitmoji"}, []string{":bug: x", ":sparkles: y"}, "minor", false},
+		{"gitmoji bug", &Config{Convention: "gitmoji"}, []string{"🐛 x", ":memo: docs"}, "patch", false},
+		{"gitmoji boom", &Config{Convention: "gitmoji"}, []string{":boom: drop v1"}, "major", false},
+		{"gitmoji mapped type", &Config{Convention: "gitmoji", Types: map[string]string{"memo": "patch"}}, []string{":memo: docs"}, "patch", false},
+		{"gitmoji ignores conventional", &Config{Convention: "gitmoji"}, []string{"feat: x"}, "", true},
+		{"angular perf", &Config{Convention: "angular"}, []string{"perf: faster"}, "patch", false},
+		{"regex", &Config{Convention: "regex", Pattern: `^(?P<type>\w+) -`}, []string{"Feat - x"}, "minor", false},
+		{"invalid convention", &Config{Convention: "svn"}, []string{"feat: x"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewCommitParser(tt.cfg).Parse(tt.commits)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got %q", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expectedBump {
+				t.Errorf("expected %q, got %q", tt.expectedBump, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Package conventional parses commit messages following the Conventional
+// Commits specification (https://www.conventionalcommits.org) into structured
+// commits. It is shared by the commit parser and the changelog generator.
+package conventional
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// Matches: type(scope)!: description or type!: description or type: description
+	headerRe = regexp.MustCompile(`^(\w+)(?:\(([^)]+)\))?(!)?:\s*(.+)$`)
+
+	// Matches a footer line: "Token: value", "Token #value" or "BREAKING CHANGE: value"
+	footerRe = regexp.MustCompile(`^(BREAKING CHANGE|[A-Za-z][\w-]*)(?:: | #)(.*)$`)
+)
+
+// Footer is a git trailer-style footer, such as "Refs: #123".
+type Footer struct {
+	Token string
+	Value string
+}
+
+// IsBreaking reports whether the footer declares a breaking change.
+func (f Footer) IsBreaking() bool {
+	return f.Token == "BREAKING CHANGE" || f.Token == "BREAKING-CHANGE"
+}
+
+// Commit is a parsed commit message.
+type Commit struct {
+	// Subject is the first line of the message.
+	Subject string
+	// Type is the lowercased commit type (feat, fix, ...); empty for
+	// messages that do not follow the specification.
+	Type  string
+	Scope string
+	// Bang reports a "!" before the colon (feat!: ...).
+	Bang        bool
+	Description string
+	Body        string
+	Footers     []Footer
+}
+
+// IsConventional reports whether the subject follows the specification.
+func (c Commit) IsConventional() bool {
+	return c.Type != ""
+}
+
+// IsBreaking reports a breaking change, declared with "!" or a
+// BREAKING CHANGE footer.
+func (c Commit) IsBreaking() bool {
+	if c.Bang {
+		return true
+	}
+	for _, f := range c.Footers {
+		if f.IsBreaking() {
+			return true
+		}
+	}
+	return false
+}
+
+// Footer returns the value of the first footer with the given token
+// (case-insensitive), if present.
+func (c Commit) Footer(token string) (string, bool) {
+	for _, f := range c.Footers {
+		if strings.EqualFold(f.Token, token) {
+			return f.Value, true
+		}
+	}
+	return "", false
+}
+
+// Parse parses a full commit message: subject, optional body and footers.
+// Messages that do not follow the specification are returned with an empty
+// Type and the subject as Description; body and footers are still parsed.
+func Parse(message string) Commit {
+	message = strings.ReplaceAll(strings.TrimSpace(message), "\r\n", "\n")
+	subject, rest, _ := strings.Cut(message, "\n")
+
+	c := Commit{Subject: strings.TrimSpace(subject), Description: strings.TrimSpace(subject)}
+	if m := headerRe.FindStringSubmatch(c.Subject); m != nil {
+		c.Type = strings.ToLower(m[1])
+		c.Scope = m[2]
+		c.Bang = m[3] == "!"
+		c.Description = m[4]
+	}
+
+	c.Body, c.Footers = parseBody(strings.TrimSpace(rest))
+	return c
+}
+
+// parseBody splits the text after the subject into the body and the footers.
+// Footers form the last paragraph, which must start with a footer line; other
+// lines in it continue the value of the previous footer.
+func parseBody(text string) (string, []Footer) {
+	if text == "" {
+		return "", nil
+	}
+
+	paragraphs := strings.Split(text, "\n\n")
+	last := paragraphs[len(paragraphs)-1]
+	if first, _, _ := strings.Cut(last, "\n"); !footerRe.MatchString(first) {
+		return text, nil
+	}
+
+	var footers []Footer
+	for line := range strings.SplitSeq(last, "\n") {
+		if m := footerRe.FindStringSubmatch(line); m != nil {
+			footers = append(footers, Footer{Token: m[1], Value: strings.TrimSpace(m[2])})
+			continue
+		}
+		prev := &footers[len(footers)-1]
+		prev.Value = strings.TrimSpace(prev.Value + "\n" + line)
+	}
+
+	body := strings.TrimSpace(strings.Join(paragraphs[:len(paragraphs)-1], "\n\n"))
+	return body, footers
+}
@@ -0,0 +1,104 @@
+package conventional
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    Commit
+	}{
+		{
+			name:    "subject only",
+			message: "feat(api): add users endpoint",
+			want:    Commit{Subject: "feat(api): add users endpoint", Type: "feat", Scope: "api", Description: "add users endpoint"},
+		},
+		{
+			name:    "bang without scope",
+			message: "Fix!: change error format",
+			want:    Commit{Subject: "Fix!: change error format", Type: "fix", Bang: true, Description: "change error format"},
+		},
+		{
+			name:    "non-conventional subject",
+			message: "Update README",
+			want:    Commit{Subject: "Update README", Description: "Update README"},
+		},
+		{
+			name:    "body without footers",
+			message: "fix: parse\n\nFirst paragraph.\n\nSecond paragraph.\n",
+			want:    Commit{Subject: "fix: parse", Type: "fix", Description: "parse", Body: "First paragraph.\n\nSecond paragraph."},
+		},
+		{
+			name:    "body and footers",
+			message: "refactor: drop v1\r\n\r\nRemoves old endpoints.\r\n\r\nRefs #12\r\nBREAKING CHANGE: v1 endpoints\r\n  are gone\r\nReviewed-by: Z",
+			want: Commit{
+				Subject:     "refactor: drop v1",
+				Type:        "refactor",
+				Description: "drop v1",
+				Body:        "Removes old endpoints.",
+				Footers: []Footer{
+					{Token: "Refs", Value: "12"},
+					{Token: "BREAKING CHANGE", Value: "v1 endpoints\n  are gone"},
+					{Token: "Reviewed-by", Value: "Z"},
+				},
+			},
+		},
+		{
+			name:    "footers only",
+			message: "chore: bump deps\n\nBREAKING-CHANGE: requires Go 1.24",
+			want: Commit{
+				Subject:     "chore: bump deps",
+				Type:        "chore",
+				Description: "bump deps",
+				Footers:     []Footer{{Token: "BREAKING-CHANGE", Value: "requires Go 1.24"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Parse(tt.message)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommit_IsBreaking(t *testing.T) {
+	tests := []struct {
+		message string
+		want    bool
+	}{
+		{"feat!: redesign", true},
+		{"feat(auth)!: new tokens", true},
+		{"fix: x\n\nBREAKING CHANGE: y", true},
+		{"fix: x\n\nBREAKING-CHANGE: y", true},
+		{"fix: x\n\nbreaking change: y", false},
+		{"fix: x\n\nMentions BREAKING CHANGE: in the body.\n\nRefs: #1", false},
+		{"feat: x", false},
+	}
+
+	for _, tt := range tests {
+		if got := Parse(tt.message).IsBreaking(); got != tt.want {
+			t.Errorf("Parse(%q).IsBreaking() = %v, want %v", tt.message, got, tt.want)
+		}
+	}
+}
+
+func TestCommit_Footer(t *testing.T) {
+	c := Parse("fix: x\n\nRefs: #7\nrefs: #8")
+
+	if v, ok := c.Footer("REFS"); !ok || v != "#7" {
+		t.Errorf("Footer(REFS) = %q, %v; want %q, true", v, ok, "#7")
+	}
+	if _, ok := c.Footer("Closes"); ok {
+		t.Error("Footer(Closes): expected not found")
+	}
+	if !c.IsConventional() || Parse("WIP").IsConventional() {
+		t.Error("IsConventional() mismatch")
+	}
+}
@@ -8,11 +8,83 @@ import (
 )
 
 var (
-	GetCommitsFn = getCommits
-	execCommand  = exec.Command
+	GetCommitsFn       = getCommits
+	GetModuleCommitsFn = getModuleCommits
+	HasModuleChangesFn = hasModuleChanges
+	execCommand        = exec.Command
 )
 
+// Separators used in git log output. Messages are multi-line, so fields are
+// split on the ASCII unit separator and commits on the record separator.
+const (
+	fieldSeparator  = "\x1f"
+	recordSeparator = "\x1e"
+)
+
+// getCommits returns the full messages (subject, body and footers) of the
+// commits in since..until to analyze, according to the configured history
+// mode. Reverted commits and their reverts are left out.
 func getCommits(since string, until string) ([]string, error) {
+	return getCommitsInPath(since, until, "")
+}
+
+// getModuleCommits returns the messages of the commits in since..until that
+// touch dir, the directory of the module called name. An empty since resolves
+// to the last tag of the module (see getLastModuleTag), then to the last tag
+// of the repository.
+func getModuleCommits(name, dir, since, until string) ([]string, error) {
+	if since == "" {
+		since = moduleSince(name)
+	}
+	return getCommitsInPath(since, until, dir)
+}
+
+// hasModuleChanges reports whether any commit in since..HEAD touches dir, the
+// directory of the module called name. An empty since resolves to the last tag
+// of the module, then to the last tag of the repository; without tags, every
+// commit touching dir counts.
+func hasModuleChanges(name, dir, since string) (bool, error) {
+	if since == "" {
+		since = moduleSince(name)
+	}
+
+	args := []string{"log", "-1", "--format=%H"}
+	if since != "" {
+		args = append(args, since+"..HEAD")
+	}
+	args = append(args, "--", dir)
+	cmd := execCommand("git", args...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return false, fmt.Errorf("git log failed: %s: %w", stderrMsg, err)
+		}
+		return false, fmt.Errorf("git log failed: %w", err)
+	}
+
+	return strings.TrimSpace(string(output)) != "", nil
+}
+
+// moduleSince returns the last tag of the module called name, or else the
+// last tag of the repository, or an empty string if there are no tags.
+func moduleSince(name string) string {
+	if tag, err := getLastModuleTag(name); err == nil {
+		return tag
+	}
+	if tag, err := getLastTag(); err == nil {
+		return tag
+	}
+	return ""
+}
+
+// getCommitsInPath is getCommits restricted to the commits touching path.
+// An empty path selects all commits.
+func getCommitsInPath(since, until, path string) ([]string, error) {
 	if until == "" {
 		until = "HEAD"
 	}
@@ -26,8 +98,22 @@ func getCommits(since string, until string) ([]string, error) {
 		}
 	}
 
-	revRange := since + ".." + until
-	cmd := execCommand("git", "log", "--pretty=format:%s", revRange)
+	opts := currentOptions
+	switch opts.History {
+	case "", HistoryAll, HistoryFirstParent, HistorySquash:
+	default:
+		return nil, fmt.Errorf("unknown history mode %q (want all, first-parent or squash)", opts.History)
+	}
+
+	args := []string{"log"}
+	if opts.History == HistoryFirstParent {
+		args = append(args, "--first-parent")
+	}
+	args = append(args, "--pretty=format:%H%x1f%P%x1f%B%x1e", since+".."+until)
+	if path != "" {
+		args = append(args, "--", path)
+	}
+	cmd := execCommand("git", args...)
 
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
@@ -41,15 +127,49 @@ func getCommits(since string, until string) ([]string, error) {
 		return nil, fmt.Errorf("git log failed: %w", err)
 	}
 
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-	if len(lines) == 1 && lines[0] == "" {
-		return []string{}, nil
+	return analyze(parseLog(string(output)), opts), nil
+}
+
+// parseLog parses "%H%x1f%P%x1f%B%x1e" git log output.
+func parseLog(output string) []Commit {
+	commits := []Commit{}
+	for record := range strings.SplitSeq(output, recordSeparator) {
+		record = strings.TrimLeft(record, "\n")
+		if record == "" {
+			continue
+		}
+		parts := strings.SplitN(record, fieldSeparator, 3)
+		if len(parts) < 3 {
+			continue // Skip malformed records
+		}
+		commits = append(commits, Commit{
+			Hash:    parts[0],
+			Parents: strings.Fields(parts[1]),
+			Message: strings.TrimSpace(parts[2]),
+		})
 	}
-	return lines, nil
+	return commits
 }
 
 func getLastTag() (string, error) {
-	cmd := execCommand("git", "describe", "--tags", "--abbrev=0")
+	return describeTag()
+}
+
+// getLastModuleTag returns the last tag of the module called name. Module
+// tags are prefixed with the module name: "<name>/v1.2.3", "<name>@1.2.3"
+// or "<name>-v1.2.3".
+func getLastModuleTag(name string) (string, error) {
+	return describeTag(name+"/*", name+"@*", name+"-v*")
+}
+
+// describeTag returns the most recent tag reachable from HEAD, restricted to
+// tags matching one of the glob patterns if any are given.
+func describeTag(patterns ...string) (string, error) {
+	args := []string{"describe", "--tags", "--abbrev=0"}
+	for _, pattern := range patterns {
+		args = append(args, "--match", pattern)
+	}
+	cmd := execCommand("git", args...)
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
 
@@ -3,6 +3,7 @@ package gitlog
 import (
 	"os"
 	"os/exec"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -44,6 +45,11 @@ func TestHelperProcess(t *testing.T) {
 	os.Exit(0)
 }
 
+// rec formats a commit record of the git log output.
+func rec(hash, parents, message string) string {
+	return hash + "\x1f" + parents + "\x1f" + message + "\x1e"
+}
+
 func stubExecCommand() func() {
 	orig := execCommand
 	execCommand = fakeExecCommand
@@ -69,16 +75,28 @@ func TestGetCommits(t *testing.T) {
 			since: "v1.2.0",
 			until: "HEAD",
 			mockGitCommands: map[string]string{
-				"git log --pretty=format:%s v1.2.0..HEAD": "feat: login\nfix: auth bug",
+				"git log --pretty=format:%H%x1f%P%x1f%B%x1e v1.2.0..HEAD": rec("b2", "a1", "feat: login") + "\n" + rec("a1", "a0", "fix: auth bug"),
 			},
 			expectedCommits: []string{"feat: login", "fix: auth bug"},
 		},
+		{
+			name:  "Multi-line messages keep body and footers",
+			since: "v1.2.0",
+			until: "HEAD",
+			mockGitCommands: map[string]string{
+				"git log --pretty=format:%H%x1f%P%x1f%B%x1e v1.2.0..HEAD": rec("b2", "a1", "refactor: drop v1 API\n\nRemoves the old endpoints.\n\nBREAKING CHANGE: v1 is gone\n") + "\n" + rec("a1", "a0", "fix: typo\n"),
+			},
+			expectedCommits: []string{
+				"refactor: drop v1 API\n\nRemoves the old endpoints.\n\nBREAKING CHANGE: v1 is gone",
+				"fix: typo",
+			},
+		},
 		{
 			name:  "With default until",
 			since: "v1.2.0",
 			until: "",
 			mockGitCommands: map[string]string{
-				"git log --pretty=format:%s v1.2.0..HEAD": "feat: new api",
+				"git log --pretty=format:%H%x1f%P%x1f%B%x1e v1.2.0..HEAD": rec("a1", "a0", "feat: new api"),
 			},
 			expectedCommits: []string{"feat: new api"},
 		},
@@ -87,7 +105,7 @@ func TestGetCommits(t *testing.T) {
 			since: "v1.2.0",
 			until: "HEAD",
 			mockGitCommands: map[string]string{
-				"git log --pretty=format:%s v1.2.0..HEAD": "",
+				"git log --pretty=format:%H%x1f%P%x1f%B%x1e v1.2.0..HEAD": "",
 			},
 			expectedCommits: []string{},
 		},
@@ -96,8 +114,8 @@ func TestGetCommits(t *testing.T) {
 			since: "",
 			until: "HEAD",
 			mockGitCommands: map[string]string{
-				"git describe --tags --abbrev=0":           "", // simulate error
-				"git log --pretty=format:%s HEAD~10..HEAD": "fix: update",
+				"git describe --tags --abbrev=0":                           "", // simulate error
+				"git log --pretty=format:%H%x1f%P%x1f%B%x1e HEAD~10..HEAD": rec("a1", "a0", "fix: update"),
 			},
 			expectedCommits: []string{"fix: update"},
 		},
@@ -106,8 +124,8 @@ func TestGetCommits(t *testing.T) {
 			since: "",
 			until: "HEAD",
 			mockGitCommands: map[string]string{
-				"git describe --tags --abbrev=0":          "v2.0.0",
-				"git log --pretty=format:%s v2.0.0..HEAD": "feat: something",
+				"git describe --tags --abbrev=0":                          "v2.0.0",
+				"git log --pretty=format:%H%x1f%P%x1f%B%x1e v2.0.0..HEAD": rec("a1", "a0", "feat: something"),
 			},
 			expectedCommits: []string{"feat: something"},
 			expectErr:       false,
@@ -117,7 +135,7 @@ func TestGetCommits(t *testing.T) {
 			since: "v1.0.0",
 			until: "HEAD",
 			mockGitCommands: map[string]string{
-				"git log --pretty=format:%s v1.0.0..HEAD": "ERROR",
+				"git log --pretty=format:%H%x1f%P%x1f%B%x1e v1.0.0..HEAD": "ERROR",
 			},
 			expectErr: true,
 		},
@@ -126,8 +144,8 @@ func TestGetCommits(t *testing.T) {
 			since: "",
 			until: "HEAD",
 			mockGitCommands: map[string]string{
-				"git describe --tags --abbrev=0":           "ERROR",
-				"git log --pretty=format:%s HEAD~10..HEAD": "fix: fallback",
+				"git describe --tags --abbrev=0":                           "ERROR",
+				"git log --pretty=format:%H%x1f%P%x1f%B%x1e HEAD~10..HEAD": rec("a1", "a0", "fix: fallback"),
 			},
 			expectedCommits: []string{"fix: fallback"},
 			expectErr:       false,
@@ -154,3 +172,123 @@ func TestGetCommits(t *testing.T) {
 		})
 	}
 }
+
+func TestGetModuleCommits(t *testing.T) {
+	restore := stubExecCommand()
+	defer restore()
+
+	const describeModule = "git describe --tags --abbrev=0 --match api/* --match api@* --match api-v*"
+
+	tests := []struct {
+		name            string
+		since           string
+		mockGitCommands map[string]string
+		expectedCommits []string
+	}{
+		{
+			name:  "since resolves to the module tag",
+			since: "",
+			mockGitCommands: map[string]string{
+				describeModule: "api/v1.4.0",
+				"git log --pretty=format:%H%x1f%P%x1f%B%x1e api/v1.4.0..HEAD -- /repo/services/api": rec("a1", "a0", "fix(api): timeout"),
+			},
+			expectedCommits: []string{"fix(api): timeout"},
+		},
+		{
+			name:  "falls back to the repository tag",
+			since: "",
+			mockGitCommands: map[string]string{
+				describeModule:                   "ERROR",
+				"git describe --tags --abbrev=0": "v2.0.0",
+				"git log --pretty=format:%H%x1f%P%x1f%B%x1e v2.0.0..HEAD -- /repo/services/api": rec("a1", "a0", "feat(api): search"),
+			},
+			expectedCommits: []string{"feat(api): search"},
+		},
+		{
+			name:  "explicit since is kept",
+			since: "v1.0.0",
+			mockGitCommands: map[string]string{
+				"git log --pretty=format:%H%x1f%P%x1f%B%x1e v1.0.0..HEAD -- /repo/services/api": "",
+			},
+			expectedCommits: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGitCommands = tt.mockGitCommands
+
+			commits, err := GetModuleCommitsFn("api", "/repo/services/api", tt.since, "HEAD")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(commits, tt.expectedCommits) {
+				t.Errorf("expected %q, got %q", tt.expectedCommits, commits)
+			}
+		})
+	}
+}
+
+func TestHasModuleChanges(t *testing.T) {
+	restore := stubExecCommand()
+	defer restore()
+
+	const describeModule = "git describe --tags --abbrev=0 --match web/* --match web@* --match web-v*"
+
+	tests := []struct {
+		name            string
+		since           string
+		mockGitCommands map[string]string
+		expected        bool
+		expectErr       bool
+	}{
+		{
+			name: "commits since the module tag",
+			mockGitCommands: map[string]string{
+				describeModule: "web@2.0.0",
+				"git log -1 --format=%H web@2.0.0..HEAD -- /repo/web": "abc123\n",
+			},
+			expected: true,
+		},
+		{
+			name: "no commits since the repository tag",
+			mockGitCommands: map[string]string{
+				describeModule:                                     "ERROR",
+				"git describe --tags --abbrev=0":                   "v1.0.0",
+				"git log -1 --format=%H v1.0.0..HEAD -- /repo/web": "",
+			},
+			expected: false,
+		},
+		{
+			name: "without tags any commit counts",
+			mockGitCommands: map[string]string{
+				describeModule:                        "ERROR",
+				"git describe --tags --abbrev=0":      "ERROR",
+				"git log -1 --format=%H -- /repo/web": "abc123\n",
+			},
+			expected: true,
+		},
+		{
+			name:  "explicit ref",
+			since: "main",
+			mockGitCommands: map[string]string{
+				"git log -1 --format=%H main..HEAD -- /repo/web": "ERROR",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGitCommands = tt.mockGitCommands
+
+			changed, err := HasModuleChangesFn("web", "/repo/web", tt.since)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if changed != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, changed)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package gitlog
+
+import (
+	"regexp"
+	"strings"
+)
+
+// History modes select which commits of a range are analyzed.
+const (
+	// HistoryAll analyzes every non-merge commit (default).
+	HistoryAll = "all"
+	// HistoryFirstParent follows only the first parent of merges. Pull request
+	// merge commits are analyzed through the message they carry in their body.
+	HistoryFirstParent = "first-parent"
+	// HistorySquash analyzes only pull request squash commits, whose subject
+	// ends with a reference such as "(#123)".
+	HistorySquash = "squash"
+)
+
+// Options configures how commits are collected.
+type Options struct {
+	// History is the history mode: all (default), first-parent or squash.
+	History string
+}
+
+// currentOptions holds the options used by GetCommitsFn.
+var currentOptions Options
+
+// Configure sets the options used to collect commits.
+func Configure(opts Options) {
+	currentOptions = opts
+}
+
+// Commit is a commit read from git log.
+type Commit struct {
+	Hash    string
+	Parents []string
+	Message string
+}
+
+// IsMerge reports whether the commit has more than one parent.
+func (c Commit) IsMerge() bool {
+	return len(c.Parents) > 1
+}
+
+// Subject returns the first line of the message.
+func (c Commit) Subject() string {
+	subject, _, _ := strings.Cut(c.Message, "\n")
+	return strings.TrimSpace(subject)
+}
+
+var (
+	// Matches the reference GitHub and similar forges append to squash commits: "(#123)".
+	prRefRe = regexp.MustCompile(`\s*\(#\d+\)$`)
+
+	// Matches a revert subject: Revert "original subject"
+	revertSubjectRe = regexp.MustCompile(`^Revert "(.+)"$`)
+
+	// Matches the body line git adds to reverts: "This reverts commit <sha>."
+	revertHashRe = regexp.MustCompile(`(?m)^This reverts commit ([0-9a-f]{7,40})`)
+)
+
+// analyze selects the commits of the configured history mode, cancels out
+// reverted commits with their reverts and returns the remaining messages.
+func analyze(commits []Commit, opts Options) []string {
+	selected := make([]Commit, 0, len(commits))
+	for _, c := range commits {
+		switch {
+		case opts.History == HistorySquash:
+			if c.IsMerge() || !prRefRe.MatchString(c.Subject()) {
+				continue
+			}
+		case c.IsMerge():
+			// Merged commits are analyzed themselves, unless only the first
+			// parent is followed: then the merge stands for the pull request.
+			if opts.History != HistoryFirstParent {
+				continue
+			}
+			_, body, _ := strings.Cut(c.Message, "\n")
+			if body = strings.TrimSpace(body); body == "" {
+				continue
+			}
+			c.Message = body
+		}
+		selected = append(selected, c)
+	}
+
+	messages := []string{}
+	for i, reverted := range pairReverts(selected) {
+		if !reverted {
+			messages = append(messages, selected[i].Message)
+		}
+	}
+	return messages
+}
+
+// pairReverts matches reverts with the commits they revert, newest first, and
+// reports which commits belong to a pair. Reverts whose original is outside the
+// range are kept. A revert of a revert cancels the revert, so the original
+// commit counts again.
+func pairReverts(commits []Commit) []bool {
+	cancelled := make([]bool, len(commits))
+	for i := range commits {
+		if cancelled[i] {
+			continue
+		}
+		if j := findReverted(commits, cancelled, i); j >= 0 {
+			cancelled[i], cancelled[j] = true, true
+		}
+	}
+	return cancelled
+}
+
+// findReverted returns the index of the older commit reverted by commits[i],
+// or -1. Commits are matched by the hash in the revert body, then by subject.
+func findReverted(commits []Commit, cancelled []bool, i int) int {
+	revert := commits[i]
+
+	if m := revertHashRe.FindStringSubmatch(revert.Message); m != nil {
+		for j := i + 1; j < len(commits); j++ {
+			if !cancelled[j] && strings.HasPrefix(commits[j].Hash, m[1]) {
+				return j
+			}
+		}
+	}
+
+	m := revertSubjectRe.FindStringSubmatch(prRefRe.ReplaceAllString(revert.Subject(), ""))
+	if m == nil {
+		return -1
+	}
+	for j := i + 1; j < len(commits); j++ {
+		if !cancelled[j] && prRefRe.ReplaceAllString(commits[j].Subject(), "") == m[1] {
+			return j
+		}
+	}
+	return -1
+}
@@ -0,0 +1,117 @@
+package gitlog
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	// Commits are newest first, as printed by git log.
+	tests := []struct {
+		name    string
+		opts    Options
+		commits []Commit
+		want    []string
+	}{
+		{
+			name: "revert by hash cancels the original",
+			commits: []Commit{
+				{Hash: "c3", Parents: []string{"b2"}, Message: "fix: typo"},
+				{Hash: "b2", Parents: []string{"a1"}, Message: "Revert \"feat: add login\"\n\nThis reverts commit a1f00d."},
+				{Hash: "a1f00d", Parents: []string{"a0"}, Message: "feat: add login"},
+			},
+			want: []string{"fix: typo"},
+		},
+		{
+			name: "revert by subject cancels the most recent match",
+			commits: []Commit{
+				{Hash: "c3", Parents: []string{"b2"}, Message: `Revert "feat: add login" (#14)`},
+				{Hash: "b2", Parents: []string{"a1"}, Message: "feat: add login (#12)"},
+				{Hash: "a1", Parents: []string{"a0"}, Message: "feat: add login"},
+			},
+			want: []string{"feat: add login"},
+		},
+		{
+			name: "revert of a revert restores the original",
+			commits: []Commit{
+				{Hash: "c3", Parents: []string{"b2"}, Message: "Revert \"Revert \"feat: add login\"\"\n\nThis reverts commit b2."},
+				{Hash: "b2", Parents: []string{"a1"}, Message: "Revert \"feat: add login\"\n\nThis reverts commit a1."},
+				{Hash: "a1", Parents: []string{"a0"}, Message: "feat: add login"},
+			},
+			want: []string{"feat: add login"},
+		},
+		{
+			name: "revert of a commit outside the range is kept",
+			commits: []Commit{
+				{Hash: "b2", Parents: []string{"a1"}, Message: "Revert \"feat: old\"\n\nThis reverts commit 0ld."},
+			},
+			want: []string{"Revert \"feat: old\"\n\nThis reverts commit 0ld."},
+		},
+		{
+			name: "merge commits are skipped by default",
+			commits: []Commit{
+				{Hash: "m1", Parents: []string{"a1", "f1"}, Message: "Merge pull request #3 from x/login\n\nfeat: add login"},
+				{Hash: "f1", Parents: []string{"a0"}, Message: "feat: add login"},
+			},
+			want: []string{"feat: add login"},
+		},
+		{
+			name: "first-parent analyzes merge bodies",
+			opts: Options{History: HistoryFirstParent},
+			commits: []Commit{
+				{Hash: "m2", Parents: []string{"m1", "f2"}, Message: "Merge branch 'wip'"},
+				{Hash: "m1", Parents: []string{"a1", "f1"}, Message: "Merge pull request #3 from x/login\n\nfeat: add login"},
+				{Hash: "a1", Parents: []string{"a0"}, Message: "fix: direct push"},
+			},
+			want: []string{"feat: add login", "fix: direct push"},
+		},
+		{
+			name: "squash keeps only pull request commits",
+			opts: Options{History: HistorySquash},
+			commits: []Commit{
+				{Hash: "c3", Parents: []string{"b2"}, Message: "feat: add login (#12)\n\n* wip\n* fix tests"},
+				{Hash: "b2", Parents: []string{"a1"}, Message: "fix: direct push"},
+				{Hash: "a1", Parents: []string{"a0", "x"}, Message: "Merge pull request #3 from x/y (#3)"},
+			},
+			want: []string{"feat: add login (#12)\n\n* wip\n* fix tests"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := analyze(tt.commits, tt.opts)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("analyze() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetCommits_FirstParent(t *testing.T) {
+	restore := stubExecCommand()
+	defer restore()
+
+	Configure(Options{History: HistoryFirstParent})
+	defer Configure(Options{})
+
+	fakeGitCommands = map[string]string{
+		"git log --first-parent --pretty=format:%H%x1f%P%x1f%B%x1e v1.0.0..HEAD": rec("m1", "a1 f1", "Merge pull request #3 from x/login\n\nfeat: add login"),
+	}
+
+	commits, err := GetCommitsFn("v1.0.0", "HEAD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(commits, []string{"feat: add login"}) {
+		t.Errorf("expected merge body, got %q", commits)
+	}
+}
+
+func TestGetCommits_UnknownHistory(t *testing.T) {
+	Configure(Options{History: "octopus"})
+	defer Configure(Options{})
+
+	if _, err := GetCommitsFn("v1.0.0", "HEAD"); err == nil {
+		t.Error("expected error for unknown history mode")
+	}
+}
@@ -2,20 +2,10 @@ package commitparser
 
 import (
 	"errors"
-	"regexp"
+	"fmt"
 	"strings"
-)
 
-// Conventional commit patterns for parsing.
-var (
-	// Matches breaking change indicator: type! or type(scope)!
-	breakingExclamationRe = regexp.MustCompile(`^[a-z]+(\([a-z0-9_-]+\))?!:`)
-	// Matches BREAKING CHANGE: or BREAKING-CHANGE: footer in commit body
-	breakingFooterRe = regexp.MustCompile(`(?i)\nBREAKING[- ]CHANGE:`)
-	// Matches feat or feat(scope):
-	featRe = regexp.MustCompile(`^feat(\([a-z0-9_-]+\))?:`)
-	// Matches fix or fix(scope):
-	fixRe = regexp.MustCompile(`^fix(\([a-z0-9_-]+\))?:`)
+	"github.com/indaco/verso/internal/plugins/commitparser/conventional"
 )
 
 /* ------------------------------------------------------------------------- */
@@ -30,7 +20,40 @@ type CommitParser interface {
 	Parse(commits []string) (string, error)
 }
 
-type CommitParserPlugin struct{}
+// ErrNoBumpType is returned by Parse when no commit implies a bump.
+var ErrNoBumpType = errors.New("no bump type could be inferred")
+
+// Explainer is implemented by commit parsers that can report how each commit
+// contributes to the inferred bump type.
+type Explainer interface {
+	Explain(commits []string) ([]Classification, error)
+}
+
+// Classification describes how a single commit was classified.
+type Classification struct {
+	Subject  string `json:"subject"`
+	Type     string `json:"type,omitempty"`
+	Scope    string `json:"scope,omitempty"`
+	Breaking bool   `json:"breaking"`
+	// Level is the bump level the commit contributes: major, minor, patch or none.
+	Level string `json:"level"`
+	// Reason names the rule that decided the level.
+	Reason string `json:"reason"`
+}
+
+func (c Classification) with(level, reason string) Classification {
+	c.Level, c.Reason = level, reason
+	return c
+}
+
+type CommitParserPlugin struct {
+	convention   Convention
+	types        map[string]string
+	scopes       map[string]string
+	defaultLevel string
+	ignoreScopes map[string]bool
+	err          error
+}
 
 func (CommitParserPlugin) Name() string { return "commit-parser" }
 func (CommitParserPlugin) Description() string {
@@ -42,55 +65,156 @@ func (CommitParserPlugin) Version() string { return "v0.1.0" }
 /* IMPLEMENTATION                                                            */
 /* ------------------------------------------------------------------------- */
 
-// NewCommitParser returns a new Conventional Commits parser.
+// NewCommitParser returns a parser for the configured commit convention,
+// using cfg on top of the convention's type mapping. A nil cfg uses the
+// defaults (Conventional Commits).
+func NewCommitParser(cfg *Config) *CommitParserPlugin {
+	defaults := DefaultConfig()
+	if cfg == nil {
+		cfg = defaults
+	}
+
+	p := &CommitParserPlugin{
+		types:        make(map[string]string),
+		scopes:       make(map[string]string),
+		defaultLevel: cfg.Default,
+		ignoreScopes: make(map[string]bool),
+		err:          cfg.Validate(),
+	}
+	if p.defaultLevel == "" {
+		p.defaultLevel = defaults.Default
+	}
+
+	convention, err := NewConvention(cfg.Convention, cfg.Pattern)
+	if err != nil {
+		convention = conventionalCommits{}
+		if p.err == nil {
+			p.err = err
+		}
+	}
+	p.convention = convention
+	for t, level := range convention.DefaultTypes() {
+		p.types[t] = level
+	}
+	for t, level := range cfg.Types {
+		p.types[strings.ToLower(t)] = level
+	}
+	for scope, level := range cfg.Scopes {
+		p.scopes[strings.ToLower(scope)] = level
+	}
+	for _, scope := range cfg.IgnoreScopes {
+		p.ignoreScopes[strings.ToLower(scope)] = true
+	}
+	return p
+}
+
+// newCommitParser returns a parser with the default configuration.
 func newCommitParser() CommitParser {
-	return &CommitParserPlugin{}
+	return NewCommitParser(nil)
 }
 
-// Parse analyzes a slice of commit messages and infers the semver bump type.
-// It returns "major", "minor", "patch", or an error if no inference is possible.
+// Parse analyzes a slice of full commit messages and infers the semver bump type.
+// Each commit maps to a bump level through its scope, then its type, then the
+// default level; breaking changes always bump major. Commits in ignored scopes
+// are skipped. It returns the highest level found ("major", "minor" or "patch"),
+// or an error if no inference is possible.
 func (p *CommitParserPlugin) Parse(commits []string) (string, error) {
-	hasBreaking := false
-	hasFeat := false
-	hasFix := false
-
-	for _, commit := range commits {
-		lowerCommit := strings.ToLower(commit)
+	if p.err != nil {
+		return "", p.err
+	}
 
-		// Check for breaking changes: feat!:, fix!:, or BREAKING CHANGE: footer
-		if breakingExclamationRe.MatchString(lowerCommit) || breakingFooterRe.MatchString(commit) {
-			hasBreaking = true
-			continue
+	best := LevelNone
+	for _, message := range commits {
+		if c := p.classify(message); levelRank[c.Level] > levelRank[best] {
+			best = c.Level
 		}
+	}
 
-		// Check for feat or feat(scope):
-		if featRe.MatchString(lowerCommit) {
-			hasFeat = true
-			continue
-		}
+	if best == LevelNone {
+		return "", ErrNoBumpType
+	}
+	return best, nil
+}
+
+// Explain classifies each commit message with the bump level it contributes.
+func (p *CommitParserPlugin) Explain(commits []string) ([]Classification, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	classifications := make([]Classification, 0, len(commits))
+	for _, message := range commits {
+		classifications = append(classifications, p.classify(message))
+	}
+	return classifications, nil
+}
+
+// classify returns the bump level of a single commit message and the rule
+// that decided it.
+func (p *CommitParserPlugin) classify(message string) Classification {
+	commit := p.convention.Parse(message)
+	c := Classification{
+		Subject:  commit.Subject,
+		Type:     commit.Type,
+		Scope:    commit.Scope,
+		Breaking: commit.IsBreaking(),
+	}
+	scopes := splitScopes(commit.Scope)
 
-		// Check for fix or fix(scope):
-		if fixRe.MatchString(lowerCommit) {
-			hasFix = true
-			continue
+	for _, scope := range scopes {
+		if p.ignoreScopes[scope] {
+			return c.with(LevelNone, fmt.Sprintf("scope %q is ignored", scope))
 		}
+	}
+
+	// Check for breaking changes: feat!:, fix!:, or BREAKING CHANGE: footer
+	if commit.Bang {
+		return c.with(LevelMajor, "breaking change (!)")
+	}
+	if commit.IsBreaking() {
+		return c.with(LevelMajor, "breaking change (BREAKING CHANGE footer)")
+	}
+
+	return c.with(p.mappedLevel(commit, scopes))
+}
+
+// mappedLevel returns the configured bump level of a non-breaking commit and
+// the mapping that produced it.
+func (p *CommitParserPlugin) mappedLevel(commit conventional.Commit, scopes []string) (string, string) {
+	if !commit.IsConventional() {
+		return LevelNone, fmt.Sprintf("not a %s commit", p.convention.Name())
+	}
 
-		// Fallback: check for "breaking change" anywhere in message
-		if strings.Contains(lowerCommit, "breaking change") {
-			hasBreaking = true
+	// Scope mappings override type mappings; the highest mapped scope wins.
+	scopeLevel, scopeName := "", ""
+	for _, scope := range scopes {
+		if level, ok := p.scopes[scope]; ok && (scopeName == "" || levelRank[level] > levelRank[scopeLevel]) {
+			scopeLevel, scopeName = level, scope
 		}
 	}
+	if scopeName != "" {
+		return scopeLevel, fmt.Sprintf("scope %q maps to %s", scopeName, scopeLevel)
+	}
+
+	if level, ok := p.types[commit.Type]; ok {
+		return level, fmt.Sprintf("type %q maps to %s", commit.Type, level)
+	}
+	return p.defaultLevel, fmt.Sprintf("type %q is not mapped, default is %s", commit.Type, p.defaultLevel)
+}
 
-	switch {
-	case hasBreaking:
-		return "major", nil
-	case hasFeat:
-		return "minor", nil
-	case hasFix:
-		return "patch", nil
-	default:
-		return "", errors.New("no bump type could be inferred")
+// splitScopes splits a scope such as "api, ui" into lowercased scopes.
+func splitScopes(scope string) []string {
+	if scope == "" {
+		return nil
+	}
+	parts := strings.Split(scope, ",")
+	scopes := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.ToLower(strings.TrimSpace(part)); part != "" {
+			scopes = append(scopes, part)
+		}
 	}
+	return scopes
 }
 
 /* ------------------------------------------------------------------------- */
@@ -98,6 +222,6 @@ func (p *CommitParserPlugin) Parse(commits []string) (string, error) {
 /* ------------------------------------------------------------------------- */
 
 // Register registers the commit parser plugin with the verso plugin system.
-func Register() {
-	RegisterCommitParserFn(&CommitParserPlugin{})
+func Register(cfg *Config) {
+	RegisterCommitParserFn(NewCommitParser(cfg))
 }
@@ -1,6 +1,7 @@
 package commitparser
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -45,7 +46,7 @@ func TestCommitParser_Parse(t *testing.T) {
 		{"Single feat commit", []string{"feat: add new feature"}, "minor", false},
 		{"Single fix commit", []string{"fix: bug fix"}, "patch", false},
 		{"Breaking change in body", []string{"chore: refactor\n\nBREAKING CHANGE: API"}, "major", false},
-		{"Multiple types, breaking wins", []string{"fix: bug", "feat: thing", "BREAKING CHANGE: yes"}, "major", false},
+		{"Multiple types, breaking wins", []string{"fix: bug", "feat: thing", "refactor: api\n\nBREAKING CHANGE: yes"}, "major", false},
 		{"Multiple types, feat wins", []string{"fix: bug", "feat: thing"}, "minor", false},
 		{"Only unrelated", []string{"docs: update", "chore: clean"}, "", true},
 		{"Empty list", []string{}, "", true},
@@ -57,6 +58,10 @@ func TestCommitParser_Parse(t *testing.T) {
 		{"fix(scope): scoped fix", []string{"fix(api): handle null"}, "patch", false},
 		{"feat(scope): scoped feature", []string{"feat(ui): add button"}, "minor", false},
 		{"BREAKING-CHANGE footer", []string{"refactor: update\n\nBREAKING-CHANGE: new format"}, "major", false},
+		{"Breaking footer after body and other footers", []string{"fix: parse\n\nLonger explanation.\n\nRefs: #42\nBREAKING CHANGE: drops v1"}, "major", false},
+		{"Non-breaking footers", []string{"feat: export\n\nReviewed-by: Z\nRefs #12"}, "minor", false},
+		{"Body mentioning breaking change", []string{"chore: update CI config\n\nThis has no breaking changes."}, "", true},
+		{"Fix body mentioning breaking change", []string{"fix: parse dates\n\nNot a breaking change."}, "patch", false},
 	}
 
 	for _, tt := range tests {
@@ -87,9 +92,138 @@ func TestRegisterCommitParser(t *testing.T) {
 	}
 	defer func() { RegisterCommitParserFn = original }()
 
-	Register()
+	Register(nil)
 
 	if !called {
 		t.Errorf("expected RegisterCommitParser to be called")
 	}
 }
+
+func TestCommitParser_ParseWithConfig(t *testing.T) {
+	cfg := &Config{
+		Types: map[string]string{
+			"perf":     LevelPatch,
+			"security": LevelPatch,
+			"deps":     LevelPatch,
+			"docs":     LevelNone,
+			"chore":    LevelNone,
+		},
+		Scopes:       map[string]string{"api": LevelMinor},
+		IgnoreScopes: []string{"release", "CI"},
+	}
+
+	tests := []struct {
+		name         string
+		commits      []string
+		expectedBump string
+		expectError  bool
+	}{
+		{"Mapped type perf", []string{"perf: faster startup"}, "patch", false},
+		{"Mapped type deps", []string{"deps: bump yaml"}, "patch", false},
+		{"Built-in types still apply", []string{"perf: x", "feat: y"}, "minor", false},
+		{"Types mapped to none", []string{"docs: readme", "chore: tidy"}, "", true},
+		{"Unknown type uses default none", []string{"style: fmt"}, "", true},
+		{"Scope overrides type", []string{"fix(api): change response"}, "minor", false},
+		{"Ignored scope skipped", []string{"feat(release): prepare"}, "", true},
+		{"Ignored scope skips breaking", []string{"feat(ci)!: new pipeline"}, "", true},
+		{"Ignored scope among several", []string{"feat(api, ci): thing"}, "", true},
+		{"Breaking overrides none mapping", []string{"chore!: drop Go 1.22"}, "major", false},
+		{"Body text does not override none mapping", []string{"docs: migration guide\n\nExplains the breaking change in v2."}, "", true},
+		{"Body text does not override default none", []string{"style: fmt\n\nNo breaking change here."}, "", true},
+	}
+
+	parser := NewCommitParser(cfg)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parser.Parse(tt.commits)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got %q", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expectedBump {
+				t.Errorf("expected %q, got %q", tt.expectedBump, result)
+			}
+		})
+	}
+
+	defaulted := NewCommitParser(&Config{Default: LevelPatch})
+	if got, err := defaulted.Parse([]string{"style: fmt"}); err != nil || got != "patch" {
+		t.Errorf("expected default patch, got %q, %v", got, err)
+	}
+	if got, err := defaulted.Parse([]string{"Update README"}); err == nil {
+		t.Errorf("expected non-conventional commit to be ignored, got %q", got)
+	}
+}
+
+func TestCommitParser_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		cfg  *Config
+		want string
+	}{
+		{&Config{Default: "huge"}, `invalid default bump level "huge"`},
+		{&Config{Types: map[string]string{"perf": "small"}}, `invalid bump level "small" for type "perf"`},
+		{&Config{Scopes: map[string]string{"api": ""}}, `invalid bump level "" for scope "api"`},
+	}
+
+	for _, tt := range tests {
+		_, err := NewCommitParser(tt.cfg).Parse([]string{"feat: x"})
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("expected error containing %q, got %v", tt.want, err)
+		}
+	}
+}
+
+func TestCommitParser_Explain(t *testing.T) {
+	parser := NewCommitParser(&Config{
+		Types:        map[string]string{"docs": LevelNone},
+		Scopes:       map[string]string{"api": LevelMinor},
+		IgnoreScopes: []string{"ci"},
+	})
+
+	got, err := parser.Explain([]string{
+		"feat(auth)!: new tokens",
+		"refactor: drop v1\n\nBREAKING CHANGE: gone",
+		"fix(api): response",
+		"feat: login",
+		"docs: readme",
+		"style: fmt",
+		"feat(ci): pipeline",
+		"Update README",
+		"chore: note breaking change",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct{ level, reason string }{
+		{"major", "breaking change (!)"},
+		{"major", "breaking change (BREAKING CHANGE footer)"},
+		{"minor", `scope "api" maps to minor`},
+		{"minor", `type "feat" maps to minor`},
+		{"none", `type "docs" maps to none`},
+		{"none", `type "style" is not mapped, default is none`},
+		{"none", `scope "ci" is ignored`},
+		{"none", "not a conventional commit"},
+		{"none", `type "chore" is not mapped, default is none`},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d classifications, got %d", len(want), len(got))
+	}
+	for i, w := range want {
+		if got[i].Level != w.level || got[i].Reason != w.reason {
+			t.Errorf("commit %q: got %s (%s), want %s (%s)", got[i].Subject, got[i].Level, got[i].Reason, w.level, w.reason)
+		}
+	}
+	if got[0].Type != "feat" || got[0].Scope != "auth" || !got[0].Breaking {
+		t.Errorf("unexpected fields: %+v", got[0])
+	}
+
+	if _, err := NewCommitParser(&Config{Default: "huge"}).Explain([]string{"feat: x"}); err == nil {
+		t.Error("expected configuration error")
+	}
+}
@@ -8,13 +8,14 @@ import (
 	"strings"
 
 	"github.com/goccy/go-yaml"
+	"github.com/indaco/verso/internal/core"
 	"github.com/pelletier/go-toml/v2"
 )
 
 // Function variables for testability.
 var (
 	readFileFn  = os.ReadFile
-	writeFileFn = os.WriteFile
+	writeFileFn = core.WriteFileAtomic
 
 	readJSONVersionFn   = readJSONVersion
 	writeJSONVersionFn  = writeJSONVersion
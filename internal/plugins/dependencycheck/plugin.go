@@ -2,7 +2,10 @@ package dependencycheck
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
+
+	"github.com/indaco/verso/internal/semver"
 )
 
 // DependencyChecker defines the interface for dependency version checking.
@@ -51,6 +54,10 @@ type FileConfig struct {
 	// Pattern is the regex pattern for "regex" format.
 	// Use capturing group for version: e.g., `version = "(.*?)"`
 	Pattern string
+
+	// Dialect is the ecosystem version syntax: semver (default), pep440, maven, nuget.
+	// Synced files get the native form; consistency checks compare semantically.
+	Dialect string
 }
 
 // Inconsistency represents a version mismatch in a file.
@@ -107,6 +114,22 @@ func (p *DependencyCheckerPlugin) GetConfig() *Config {
 	return p.config
 }
 
+// ForDir returns a checker for the configured files inside dir, a directory
+// relative to the repository root. Workspace modules check and sync only the
+// files in their own directory.
+func (p *DependencyCheckerPlugin) ForDir(dir string) *DependencyCheckerPlugin {
+	cfg := *p.config
+	cfg.Files = []FileConfig{}
+	for _, file := range p.config.Files {
+		rel, err := filepath.Rel(dir, file.Path)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		cfg.Files = append(cfg.Files, file)
+	}
+	return &DependencyCheckerPlugin{config: &cfg}
+}
+
 // CheckConsistency validates all configured files match the current version.
 func (p *DependencyCheckerPlugin) CheckConsistency(currentVersion string) ([]Inconsistency, error) {
 	if !p.IsEnabled() {
@@ -114,19 +137,22 @@ func (p *DependencyCheckerPlugin) CheckConsistency(currentVersion string) ([]Inc
 	}
 
 	var inconsistencies []Inconsistency
-	normalizedExpected := normalizeVersion(currentVersion)
 
 	for _, file := range p.config.Files {
+		expected, err := toDialect(currentVersion, file.Dialect)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert version for %s: %w", file.Path, err)
+		}
+
 		version, err := p.readVersionFromFile(file)
 		if err != nil {
 			return nil, fmt.Errorf("failed to read version from %s: %w", file.Path, err)
 		}
 
-		normalizedFound := normalizeVersion(version)
-		if normalizedFound != normalizedExpected {
+		if !versionsMatch(version, expected, file.Dialect) {
 			inconsistencies = append(inconsistencies, Inconsistency{
 				Path:     file.Path,
-				Expected: currentVersion,
+				Expected: expected,
 				Found:    version,
 				Format:   file.Format,
 			})
@@ -143,7 +169,11 @@ func (p *DependencyCheckerPlugin) SyncVersions(newVersion string) error {
 	}
 
 	for _, file := range p.config.Files {
-		if err := p.writeVersionToFile(file, newVersion); err != nil {
+		version, err := toDialect(newVersion, file.Dialect)
+		if err != nil {
+			return fmt.Errorf("failed to convert version for %s: %w", file.Path, err)
+		}
+		if err := p.writeVersionToFile(file, version); err != nil {
 			return fmt.Errorf("failed to write version to %s: %w", file.Path, err)
 		}
 	}
@@ -197,3 +227,34 @@ func (p *DependencyCheckerPlugin) writeVersionToFile(file FileConfig, version st
 func normalizeVersion(version string) string {
 	return strings.TrimPrefix(version, "v")
 }
+
+// toDialect renders a version in the given dialect.
+// Without a dialect the version is returned unchanged.
+func toDialect(version, dialect string) (string, error) {
+	if dialect == "" {
+		return version, nil
+	}
+	v, err := semver.ParseVersion(version)
+	if err != nil {
+		return "", fmt.Errorf("invalid version %q: %w", version, err)
+	}
+	return semver.FormatDialect(v, dialect)
+}
+
+// versionsMatch reports whether the version found in a file matches the expected one.
+// With a dialect, both are compared in their normalized native form, so PEP 440
+// "1.4.0-RC.2" matches "1.4.0rc2" and Maven qualifiers match case-insensitively.
+func versionsMatch(found, expected, dialect string) bool {
+	if dialect == "" {
+		return normalizeVersion(found) == normalizeVersion(expected)
+	}
+	v, err := semver.ParseDialect(found, dialect)
+	if err != nil {
+		return false
+	}
+	normalized, err := semver.FormatDialect(v, dialect)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(normalized, expected)
+}
@@ -84,6 +84,51 @@ func TestDependencyCheckerPlugin_GetConfig(t *testing.T) {
 	}
 }
 
+func TestDependencyCheckerPlugin_ForDir(t *testing.T) {
+	dc := NewDependencyChecker(&Config{
+		Enabled:  true,
+		AutoSync: true,
+		Files: []FileConfig{
+			{Path: "package.json", Field: "version", Format: "json"},
+			{Path: "services/api/package.json", Field: "version", Format: "json"},
+			{Path: "services/api-v2/package.json", Field: "version", Format: "json"},
+			{Path: "services/web/Cargo.toml", Field: "package.version", Format: "toml"},
+		},
+	})
+
+	tests := []struct {
+		dir  string
+		want []string
+	}{
+		{"services/api", []string{"services/api/package.json"}},
+		{"services/web", []string{"services/web/Cargo.toml"}},
+		{"services", []string{"services/api/package.json", "services/api-v2/package.json", "services/web/Cargo.toml"}},
+		{"tools", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			got := dc.ForDir(tt.dir)
+			if !got.IsEnabled() || !got.GetConfig().AutoSync {
+				t.Error("ForDir() should keep the plugin settings")
+			}
+			files := got.GetConfig().Files
+			if len(files) != len(tt.want) {
+				t.Fatalf("ForDir(%q) files = %v, want %v", tt.dir, files, tt.want)
+			}
+			for i, file := range files {
+				if file.Path != tt.want[i] {
+					t.Errorf("files[%d] = %q, want %q", i, file.Path, tt.want[i])
+				}
+			}
+		})
+	}
+
+	if len(dc.GetConfig().Files) != 4 {
+		t.Error("ForDir() should not change the original configuration")
+	}
+}
+
 func TestDependencyCheckerPlugin_CheckConsistency(t *testing.T) {
 	// Save original functions and restore after test
 	originalReadJSON := readJSONVersionFn
@@ -739,3 +784,113 @@ func TestSyncVersions_NilConfig(t *testing.T) {
 		t.Errorf("SyncVersions() with nil config error = %v", err)
 	}
 }
+
+func TestSyncVersions_Dialects(t *testing.T) {
+	originalWriteTOML := writeTOMLVersionFn
+	originalWriteRegex := writeRegexVersionFn
+	defer func() {
+		writeTOMLVersionFn = originalWriteTOML
+		writeRegexVersionFn = originalWriteRegex
+	}()
+
+	written := make(map[string]string)
+	writeTOMLVersionFn = func(path, field, version string) error { written[path] = version; return nil }
+	writeRegexVersionFn = func(path, pattern, version string) error { written[path] = version; return nil }
+
+	cfg := &Config{
+		Enabled: true,
+		Files: []FileConfig{
+			{Path: "pyproject.toml", Field: "project.version", Format: "toml", Dialect: "pep440"},
+			{Path: "pom.xml", Pattern: `<version>(.*?)</version>`, Format: "regex", Dialect: "maven"},
+			{Path: "Cargo.toml", Field: "package.version", Format: "toml"},
+		},
+	}
+
+	if err := NewDependencyChecker(cfg).SyncVersions("1.4.0-rc.2"); err != nil {
+		t.Fatalf("SyncVersions() error = %v", err)
+	}
+
+	expected := map[string]string{
+		"pyproject.toml": "1.4.0rc2",
+		"pom.xml":        "1.4.0-RC2",
+		"Cargo.toml":     "1.4.0-rc.2",
+	}
+	for path, want := range expected {
+		if written[path] != want {
+			t.Errorf("%s: expected %q, got %q", path, want, written[path])
+		}
+	}
+}
+
+func TestSyncVersions_DialectError(t *testing.T) {
+	originalWriteTOML := writeTOMLVersionFn
+	defer func() { writeTOMLVersionFn = originalWriteTOML }()
+
+	writeTOMLVersionFn = func(path, field, version string) error {
+		t.Errorf("unexpected write of %q", version)
+		return nil
+	}
+
+	cfg := &Config{
+		Enabled: true,
+		Files: []FileConfig{
+			{Path: "pyproject.toml", Field: "project.version", Format: "toml", Dialect: "pep440"},
+		},
+	}
+
+	err := NewDependencyChecker(cfg).SyncVersions("1.4.0-nightly.1")
+	if err == nil {
+		t.Fatal("expected error for pre-release without a PEP 440 equivalent")
+	}
+}
+
+func TestCheckConsistency_Dialects(t *testing.T) {
+	originalReadTOML := readTOMLVersionFn
+	defer func() { readTOMLVersionFn = originalReadTOML }()
+
+	tests := []struct {
+		name      string
+		dialect   string
+		found     string
+		current   string
+		wantMatch bool
+		wantExp   string
+	}{
+		{"pep440 normalized", "pep440", "1.4.0rc2", "1.4.0-rc.2", true, "1.4.0rc2"},
+		{"pep440 alternative spelling", "pep440", "1.4.0-RC.2", "1.4.0-rc.2", true, "1.4.0rc2"},
+		{"pep440 mismatch", "pep440", "1.4.0rc1", "1.4.0-rc.2", false, "1.4.0rc2"},
+		{"maven case-insensitive", "maven", "1.4.0-rc2", "1.4.0-rc.2", true, "1.4.0-RC2"},
+		{"maven snapshot", "maven", "1.4.0-SNAPSHOT", "1.4.0-snapshot", true, "1.4.0-SNAPSHOT"},
+		{"nuget revision", "nuget", "1.4.0.0", "1.4.0", true, "1.4.0"},
+		{"unparseable found", "pep440", "latest", "1.4.0", false, "1.4.0"},
+		{"semver is literal", "", "1.4.0rc2", "1.4.0-rc.2", false, "1.4.0-rc.2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readTOMLVersionFn = func(path, field string) (string, error) { return tt.found, nil }
+
+			cfg := &Config{
+				Enabled: true,
+				Files:   []FileConfig{{Path: "pyproject.toml", Field: "version", Format: "toml", Dialect: tt.dialect}},
+			}
+
+			inconsistencies, err := NewDependencyChecker(cfg).CheckConsistency(tt.current)
+			if err != nil {
+				t.Fatalf("CheckConsistency() error = %v", err)
+			}
+			if tt.wantMatch {
+				if len(inconsistencies) != 0 {
+					t.Errorf("expected no inconsistencies, got %v", inconsistencies)
+				}
+				return
+			}
+			if len(inconsistencies) != 1 {
+				t.Fatalf("expected 1 inconsistency, got %d", len(inconsistencies))
+			}
+			if inconsistencies[0].Expected != tt.wantExp {
+				t.Errorf("expected Expected %q, got %q", tt.wantExp, inconsistencies[0].Expected)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/indaco/verso/internal/plugins/changeloggenerator"
 	"github.com/indaco/verso/internal/plugins/changelogparser"
 	"github.com/indaco/verso/internal/plugins/commitparser"
+	"github.com/indaco/verso/internal/plugins/commitparser/gitlog"
 	"github.com/indaco/verso/internal/plugins/dependencycheck"
 	"github.com/indaco/verso/internal/plugins/releasegate"
 	"github.com/indaco/verso/internal/plugins/tagmanager"
@@ -19,7 +20,7 @@ func RegisterBuiltinPlugins(cfg *config.Config) {
 
 	registerCommitParser(cfg.Plugins)
 	registerTagManager(cfg.Plugins)
-	registerVersionValidator(cfg.Plugins)
+	registerVersionValidator(cfg.Plugins, cfg.PreRelease.GetChannels())
 	registerDependencyCheck(cfg.Plugins)
 	registerChangelogParser(cfg.Plugins)
 	registerChangelogGenerator(cfg.Plugins)
@@ -28,8 +29,9 @@ func RegisterBuiltinPlugins(cfg *config.Config) {
 }
 
 func registerCommitParser(plugins *config.PluginConfig) {
-	if plugins.CommitParser {
-		commitparser.Register()
+	if plugins.CommitParser.IsEnabled() {
+		commitparser.Register(convertCommitParserConfig(plugins.CommitParser))
+		gitlog.Configure(gitlog.Options{History: plugins.CommitParser.History})
 	}
 }
 
@@ -46,11 +48,12 @@ func registerTagManager(plugins *config.PluginConfig) {
 	}
 }
 
-func registerVersionValidator(plugins *config.PluginConfig) {
+func registerVersionValidator(plugins *config.PluginConfig, channels []string) {
 	if plugins.VersionValidator != nil && plugins.VersionValidator.Enabled {
 		vvCfg := &versionvalidator.Config{
-			Enabled: true,
-			Rules:   convertValidationRules(plugins.VersionValidator.Rules),
+			Enabled:  true,
+			Rules:    convertValidationRules(plugins.VersionValidator.Rules),
+			Channels: channels,
 		}
 		versionvalidator.Register(vvCfg)
 	}
@@ -89,6 +92,18 @@ func registerAuditLog(plugins *config.PluginConfig) {
 	}
 }
 
+// convertCommitParserConfig converts config to commitparser config.
+func convertCommitParserConfig(cfg *config.CommitParserConfig) *commitparser.Config {
+	return &commitparser.Config{
+		Convention:   cfg.Convention,
+		Pattern:      cfg.Pattern,
+		Types:        cfg.Types,
+		Scopes:       cfg.Scopes,
+		Default:      cfg.Default,
+		IgnoreScopes: cfg.IgnoreScopes,
+	}
+}
+
 // convertValidationRules converts config rules to versionvalidator rules.
 func convertValidationRules(configRules []config.ValidationRule) []versionvalidator.Rule {
 	rules := make([]versionvalidator.Rule, len(configRules))
@@ -114,6 +129,7 @@ func convertDependencyCheckConfig(cfg *config.DependencyCheckConfig) *dependency
 			Field:   f.Field,
 			Format:  f.Format,
 			Pattern: f.Pattern,
+			Dialect: f.Dialect,
 		}
 	}
 	return &dependencycheck.Config{
@@ -1,6 +1,7 @@
 package plugins
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/indaco/verso/internal/config"
@@ -19,7 +20,7 @@ func TestRegisterConfiguredPlugins_WithCommitParser(t *testing.T) {
 
 	cfg := &config.Config{
 		Plugins: &config.PluginConfig{
-			CommitParser: true,
+			CommitParser: &config.CommitParserConfig{Enabled: true},
 		},
 	}
 
@@ -35,12 +36,37 @@ func TestRegisterConfiguredPlugins_WithCommitParser(t *testing.T) {
 	}
 }
 
+func TestRegisterConfiguredPlugins_CommitParserMapping(t *testing.T) {
+	commitparser.ResetCommitParser()
+	defer commitparser.ResetCommitParser()
+
+	cfg := &config.Config{
+		Plugins: &config.PluginConfig{
+			CommitParser: &config.CommitParserConfig{
+				Enabled:      true,
+				Types:        map[string]string{"perf": "patch"},
+				IgnoreScopes: []string{"ci"},
+			},
+		},
+	}
+
+	RegisterBuiltinPlugins(cfg)
+
+	p := commitparser.GetCommitParserFn()
+	if p == nil {
+		t.Fatal("expected commit parser to be registered, got nil")
+	}
+	if got, err := p.Parse([]string{"perf: faster", "feat(ci): new job"}); err != nil || got != "patch" {
+		t.Errorf("expected configured mapping to infer patch, got %q, %v", got, err)
+	}
+}
+
 func TestRegisterConfiguredPlugins_DisabledCommitParser(t *testing.T) {
 	commitparser.ResetCommitParser()
 
 	cfg := &config.Config{
 		Plugins: &config.PluginConfig{
-			CommitParser: false,
+			CommitParser: &config.CommitParserConfig{Enabled: false},
 		},
 	}
 
@@ -169,6 +195,31 @@ func TestRegisterConfiguredPlugins_WithVersionValidator(t *testing.T) {
 	}
 }
 
+func TestRegisterConfiguredPlugins_VersionValidatorChannels(t *testing.T) {
+	versionvalidator.Unregister()
+	defer versionvalidator.Unregister()
+
+	cfg := &config.Config{
+		PreRelease: &config.PreReleaseConfig{Channels: []string{"dev", "qa", "rc"}},
+		Plugins: &config.PluginConfig{
+			VersionValidator: &config.VersionValidatorConfig{
+				Enabled: true,
+				Rules:   []config.ValidationRule{{Type: "pre-release-channel-order", Enabled: true}},
+			},
+		},
+	}
+
+	RegisterBuiltinPlugins(cfg)
+
+	plugin, ok := versionvalidator.GetVersionValidatorFn().(*versionvalidator.VersionValidatorPlugin)
+	if !ok {
+		t.Fatal("expected version validator to be registered")
+	}
+	if got := plugin.GetConfig().Channels; !reflect.DeepEqual(got, []string{"dev", "qa", "rc"}) {
+		t.Errorf("expected channels from pre-release config, got %v", got)
+	}
+}
+
 func TestRegisterConfiguredPlugins_VersionValidatorDisabled(t *testing.T) {
 	versionvalidator.Unregister()
 	defer versionvalidator.Unregister()
@@ -264,7 +315,7 @@ func TestRegisterConfiguredPlugins_AllPlugins(t *testing.T) {
 	autoCreate := true
 	cfg := &config.Config{
 		Plugins: &config.PluginConfig{
-			CommitParser: true,
+			CommitParser: &config.CommitParserConfig{Enabled: true},
 			TagManager: &config.TagManagerConfig{
 				Enabled:    true,
 				AutoCreate: &autoCreate,
@@ -657,7 +708,7 @@ func TestConvertDependencyCheckConfig_FileDetails(t *testing.T) {
 	input := &config.DependencyCheckConfig{
 		Enabled: true,
 		Files: []config.DependencyFileConfig{
-			{Path: "package.json", Field: "version", Format: "json", Pattern: ""},
+			{Path: "package.json", Field: "version", Format: "json", Pattern: "", Dialect: "pep440"},
 		},
 	}
 
@@ -676,6 +727,9 @@ func TestConvertDependencyCheckConfig_FileDetails(t *testing.T) {
 	if file.Format != "json" {
 		t.Errorf("expected format 'json', got %q", file.Format)
 	}
+	if file.Dialect != "pep440" {
+		t.Errorf("expected dialect 'pep440', got %q", file.Dialect)
+	}
 }
 
 func TestConvertChangelogParserConfig(t *testing.T) {
@@ -14,6 +14,7 @@ var (
 	tagExistsFn            = tagExists
 	getLatestTagFn         = getLatestTag
 	pushTagFn              = pushTag
+	pushTagsFn             = pushTags
 	execCommand            = exec.Command
 )
 
@@ -103,6 +104,23 @@ func pushTag(name string) error {
 	return nil
 }
 
+// pushTags pushes the named tags to origin in a single atomic push: either
+// every tag reaches the remote or none does.
+func pushTags(names ...string) error {
+	cmd := execCommand("git", append([]string{"push", "--atomic", "origin"}, names...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return fmt.Errorf("%s: %w", stderrMsg, err)
+		}
+		return err
+	}
+	return nil
+}
+
 // ListTags returns all git tags matching a pattern.
 func ListTags(pattern string) ([]string, error) {
 	args := []string{"tag", "-l"}
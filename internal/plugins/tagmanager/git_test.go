@@ -2,6 +2,8 @@ package tagmanager
 
 import (
 	"os/exec"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -231,6 +233,35 @@ func TestPushTag(t *testing.T) {
 	})
 }
 
+func TestPushTags(t *testing.T) {
+	original := execCommand
+	defer func() { execCommand = original }()
+
+	t.Run("success", func(t *testing.T) {
+		execCommand = func(name string, args ...string) *exec.Cmd {
+			want := []string{"push", "--atomic", "origin", "api/v1.0.0", "web/v2.0.0"}
+			if name != "git" || !slices.Equal(args, want) {
+				t.Errorf("unexpected command: %s %v", name, args)
+			}
+			return exec.Command("true")
+		}
+
+		if err := pushTags("api/v1.0.0", "web/v2.0.0"); err != nil {
+			t.Errorf("pushTags() error = %v", err)
+		}
+	})
+
+	t.Run("error with stderr", func(t *testing.T) {
+		execCommand = func(name string, args ...string) *exec.Cmd {
+			return exec.Command("sh", "-c", "echo 'atomic push failed' >&2 && exit 1")
+		}
+
+		if err := pushTags("api/v1.0.0"); err == nil || !strings.Contains(err.Error(), "atomic push failed") {
+			t.Errorf("pushTags() error = %v, want the git error", err)
+		}
+	})
+}
+
 func TestListTags(t *testing.T) {
 	original := execCommand
 	defer func() { execCommand = original }()
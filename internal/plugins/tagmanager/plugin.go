@@ -28,6 +28,35 @@ type TagManager interface {
 	FormatTagName(version semver.SemVersion) string
 }
 
+// ModuleTagManager is implemented by tag managers that tag the releases of
+// workspace modules. Module tags are prefixed with the module name, e.g.
+// "api/v1.2.3".
+type ModuleTagManager interface {
+	// FormatModuleTagName formats a module version as a tag name.
+	FormatModuleTagName(module string, version semver.SemVersion) string
+
+	// CreateModuleTag creates a git tag for the module version.
+	CreateModuleTag(module string, version semver.SemVersion, message string) error
+
+	// ValidateModuleTagAvailable ensures a tag can be created for the module version.
+	ValidateModuleTagAvailable(module string, version semver.SemVersion) error
+}
+
+// TagPusher is implemented by module tag managers that push the tags they
+// create. It lets a set of tags be created locally first and pushed together
+// once every tag exists.
+type TagPusher interface {
+	// PushesTags reports whether created tags are pushed to the remote.
+	PushesTags() bool
+
+	// CreateLocalModuleTag creates the git tag for the module version
+	// without pushing it.
+	CreateLocalModuleTag(module string, version semver.SemVersion, message string) error
+
+	// PushTags pushes the named tags to the remote in a single atomic push.
+	PushTags(names ...string) error
+}
+
 // Config holds configuration for the tag manager plugin.
 type Config struct {
 	// Enabled controls whether the plugin is active.
@@ -62,8 +91,12 @@ type TagManagerPlugin struct {
 	config *Config
 }
 
-// Ensure TagManagerPlugin implements TagManager.
-var _ TagManager = (*TagManagerPlugin)(nil)
+// Ensure TagManagerPlugin implements TagManager, ModuleTagManager and TagPusher.
+var (
+	_ TagManager       = (*TagManagerPlugin)(nil)
+	_ ModuleTagManager = (*TagManagerPlugin)(nil)
+	_ TagPusher        = (*TagManagerPlugin)(nil)
+)
 
 func (p *TagManagerPlugin) Name() string { return "tag-manager" }
 func (p *TagManagerPlugin) Description() string {
@@ -84,12 +117,42 @@ func (p *TagManagerPlugin) FormatTagName(version semver.SemVersion) string {
 	return p.config.Prefix + version.String()
 }
 
+// FormatModuleTagName formats a module version as a tag name: the module
+// name, a slash and the tag name of the version.
+func (p *TagManagerPlugin) FormatModuleTagName(module string, version semver.SemVersion) string {
+	return module + "/" + p.FormatTagName(version)
+}
+
 // CreateTag creates a git tag for the given version.
 func (p *TagManagerPlugin) CreateTag(version semver.SemVersion, message string) error {
-	tagName := p.FormatTagName(version)
+	return p.createTag(p.FormatTagName(version), version, message, p.config.Push)
+}
 
+// CreateModuleTag creates a git tag for the given module version.
+func (p *TagManagerPlugin) CreateModuleTag(module string, version semver.SemVersion, message string) error {
+	return p.createTag(p.FormatModuleTagName(module, version), version, message, p.config.Push)
+}
+
+// CreateLocalModuleTag creates a git tag for the given module version without
+// pushing it, even if push is enabled.
+func (p *TagManagerPlugin) CreateLocalModuleTag(module string, version semver.SemVersion, message string) error {
+	return p.createTag(p.FormatModuleTagName(module, version), version, message, false)
+}
+
+// PushesTags reports whether created tags are pushed to the remote.
+func (p *TagManagerPlugin) PushesTags() bool {
+	return p.config.Push
+}
+
+// PushTags pushes the named tags to the remote in a single atomic push.
+func (p *TagManagerPlugin) PushTags(names ...string) error {
+	return pushTagsFn(names...)
+}
+
+// createTag creates the named tag for version, and pushes it if push is set.
+func (p *TagManagerPlugin) createTag(tagName string, version semver.SemVersion, message string, push bool) error {
 	// Check if tag already exists
-	exists, err := p.TagExists(version)
+	exists, err := tagExistsFn(tagName)
 	if err != nil {
 		return fmt.Errorf("failed to check tag existence: %w", err)
 	}
@@ -112,7 +175,7 @@ func (p *TagManagerPlugin) CreateTag(version semver.SemVersion, message string)
 	}
 
 	// Optionally push the tag
-	if p.config.Push {
+	if push {
 		if err := pushTagFn(tagName); err != nil {
 			return fmt.Errorf("failed to push tag: %w", err)
 		}
@@ -150,12 +213,21 @@ func (p *TagManagerPlugin) GetLatestTag() (semver.SemVersion, error) {
 
 // ValidateTagAvailable ensures a tag can be created for the version.
 func (p *TagManagerPlugin) ValidateTagAvailable(version semver.SemVersion) error {
-	exists, err := p.TagExists(version)
+	return validateTagNameAvailable(p.FormatTagName(version))
+}
+
+// ValidateModuleTagAvailable ensures a tag can be created for the module version.
+func (p *TagManagerPlugin) ValidateModuleTagAvailable(module string, version semver.SemVersion) error {
+	return validateTagNameAvailable(p.FormatModuleTagName(module, version))
+}
+
+// validateTagNameAvailable returns an error if the named tag exists.
+func validateTagNameAvailable(tagName string) error {
+	exists, err := tagExistsFn(tagName)
 	if err != nil {
 		return fmt.Errorf("failed to check tag availability: %w", err)
 	}
 	if exists {
-		tagName := p.FormatTagName(version)
 		return fmt.Errorf("tag %s already exists", tagName)
 	}
 	return nil
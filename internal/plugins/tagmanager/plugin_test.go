@@ -2,6 +2,7 @@ package tagmanager
 
 import (
 	"errors"
+	"slices"
 	"testing"
 
 	"github.com/indaco/verso/internal/semver"
@@ -288,6 +289,96 @@ func TestTagManagerPlugin_CreateTag(t *testing.T) {
 	}
 }
 
+func TestTagManagerPlugin_ModuleTags(t *testing.T) {
+	origTagExists := tagExistsFn
+	origCreateAnnotated := createAnnotatedTagFn
+	defer func() {
+		tagExistsFn = origTagExists
+		createAnnotatedTagFn = origCreateAnnotated
+	}()
+
+	tm := NewTagManager(&Config{Enabled: true, AutoCreate: true, Prefix: "v", Annotate: true})
+	version := semver.SemVersion{Major: 1, Minor: 2, Patch: 3}
+
+	if got := tm.FormatModuleTagName("api", version); got != "api/v1.2.3" {
+		t.Errorf("FormatModuleTagName() = %q, want %q", got, "api/v1.2.3")
+	}
+
+	existing := map[string]bool{"v1.2.3": true}
+	tagExistsFn = func(name string) (bool, error) {
+		return existing[name], nil
+	}
+	var created string
+	createAnnotatedTagFn = func(name, msg string) error {
+		created = name
+		return nil
+	}
+
+	if err := tm.ValidateModuleTagAvailable("api", version); err != nil {
+		t.Errorf("ValidateModuleTagAvailable() error = %v, want nil", err)
+	}
+	if err := tm.CreateModuleTag("api", version, "Release api 1.2.3"); err != nil {
+		t.Fatalf("CreateModuleTag() error = %v", err)
+	}
+	if created != "api/v1.2.3" {
+		t.Errorf("created tag = %q, want %q", created, "api/v1.2.3")
+	}
+
+	existing["api/v1.2.3"] = true
+	if err := tm.ValidateModuleTagAvailable("api", version); err == nil {
+		t.Error("ValidateModuleTagAvailable() expected error for existing tag")
+	}
+	if err := tm.CreateModuleTag("api", version, ""); err == nil {
+		t.Error("CreateModuleTag() expected error for existing tag")
+	}
+}
+
+func TestTagManagerPlugin_CreateLocalModuleTag(t *testing.T) {
+	origTagExists := tagExistsFn
+	origCreateAnnotated := createAnnotatedTagFn
+	origPushTag := pushTagFn
+	origPushTags := pushTagsFn
+	defer func() {
+		tagExistsFn = origTagExists
+		createAnnotatedTagFn = origCreateAnnotated
+		pushTagFn = origPushTag
+		pushTagsFn = origPushTags
+	}()
+
+	tagExistsFn = func(name string) (bool, error) { return false, nil }
+	createAnnotatedTagFn = func(name, msg string) error { return nil }
+	var pushed []string
+	pushTagFn = func(name string) error {
+		pushed = append(pushed, name)
+		return nil
+	}
+	pushTagsFn = func(names ...string) error {
+		pushed = append(pushed, names...)
+		return nil
+	}
+
+	tm := NewTagManager(&Config{Enabled: true, AutoCreate: true, Prefix: "v", Annotate: true, Push: true})
+	if !tm.PushesTags() {
+		t.Error("PushesTags() = false, want true")
+	}
+
+	for _, module := range []string{"api", "web"} {
+		if err := tm.CreateLocalModuleTag(module, semver.SemVersion{Major: 1}, ""); err != nil {
+			t.Fatalf("CreateLocalModuleTag() error = %v", err)
+		}
+	}
+	if len(pushed) != 0 {
+		t.Fatalf("expected local tags not to be pushed, pushed %v", pushed)
+	}
+
+	if err := tm.PushTags("api/v1.0.0", "web/v1.0.0"); err != nil {
+		t.Fatalf("PushTags() error = %v", err)
+	}
+	if want := []string{"api/v1.0.0", "web/v1.0.0"}; !slices.Equal(pushed, want) {
+		t.Errorf("pushed = %v, want %v", pushed, want)
+	}
+}
+
 func TestTagManagerPlugin_GetLatestTag(t *testing.T) {
 	original := getLatestTagFn
 	defer func() { getLatestTagFn = original }()
@@ -21,15 +21,17 @@ type VersionValidator interface {
 type RuleType string
 
 const (
-	RulePreReleaseFormat    RuleType = "pre-release-format"
-	RuleMajorVersionMax     RuleType = "major-version-max"
-	RuleMinorVersionMax     RuleType = "minor-version-max"
-	RulePatchVersionMax     RuleType = "patch-version-max"
-	RuleRequirePreRelease0x RuleType = "require-pre-release-for-0x"
-	RuleBranchConstraint    RuleType = "branch-constraint"
-	RuleNoMajorBump         RuleType = "no-major-bump"
-	RuleNoMinorBump         RuleType = "no-minor-bump"
-	RuleNoPatchBump         RuleType = "no-patch-bump"
+	RulePreReleaseFormat       RuleType = "pre-release-format"
+	RuleMajorVersionMax        RuleType = "major-version-max"
+	RuleMinorVersionMax        RuleType = "minor-version-max"
+	RulePatchVersionMax        RuleType = "patch-version-max"
+	RuleRequirePreRelease0x    RuleType = "require-pre-release-for-0x"
+	RuleBranchConstraint       RuleType = "branch-constraint"
+	RuleNoMajorBump            RuleType = "no-major-bump"
+	RuleNoMinorBump            RuleType = "no-minor-bump"
+	RuleNoPatchBump            RuleType = "no-patch-bump"
+	RulePreReleaseChannelOrder RuleType = "pre-release-channel-order"
+	RuleRequireExplicitStable  RuleType = "require-explicit-stable"
 )
 
 // Rule represents a single validation rule.
@@ -46,6 +48,10 @@ type Rule struct {
 type Config struct {
 	Enabled bool   `yaml:"enabled"`
 	Rules   []Rule `yaml:"rules,omitempty"`
+
+	// Channels is the ordered pre-release channel progression used by
+	// channel rules; empty uses semver.DefaultChannels.
+	Channels []string `yaml:"channels,omitempty"`
 }
 
 // DefaultConfig returns the default configuration for the version validator.
@@ -145,6 +151,10 @@ func (p *VersionValidatorPlugin) applyRule(rule Rule, newVersion, previousVersio
 		return p.validateNoBumpType(rule, bumpType, "minor")
 	case RuleNoPatchBump:
 		return p.validateNoBumpType(rule, bumpType, "patch")
+	case RulePreReleaseChannelOrder:
+		return p.validateChannelOrder(rule, newVersion, previousVersion)
+	case RuleRequireExplicitStable:
+		return p.validateExplicitStable(rule, newVersion, previousVersion, bumpType)
 	default:
 		return fmt.Errorf("unknown rule type: %s", rule.Type)
 	}
@@ -255,18 +265,70 @@ func (p *VersionValidatorPlugin) validateBranchConstraint(rule Rule, bumpType st
 }
 
 // validateNoBumpType checks if a specific bump type is disallowed.
+// Compound bumps count as their level, so "premajor" is a major bump.
 func (p *VersionValidatorPlugin) validateNoBumpType(rule Rule, actualBumpType, restrictedType string) error {
 	if !rule.Enabled {
 		return nil
 	}
 
-	if actualBumpType == restrictedType {
+	if actualBumpType == restrictedType || actualBumpType == "pre"+restrictedType {
 		return fmt.Errorf("%s bumps are not allowed by policy", restrictedType)
 	}
 
 	return nil
 }
 
+// channels returns the configured pre-release channel progression.
+func (p *VersionValidatorPlugin) channels() []string {
+	if len(p.cfg.Channels) == 0 {
+		return semver.DefaultChannels
+	}
+	return p.cfg.Channels
+}
+
+// validateChannelOrder rejects pre-releases on unknown channels and moves to an
+// earlier channel within the same release (e.g., 1.3.0-rc.2 -> 1.3.0-alpha.1).
+func (p *VersionValidatorPlugin) validateChannelOrder(rule Rule, newVersion, previousVersion semver.SemVersion) error {
+	if !rule.Enabled || newVersion.PreRelease == "" {
+		return nil
+	}
+
+	channels := p.channels()
+	newIdx := semver.ChannelIndex(newVersion.PreRelease, channels)
+	if newIdx < 0 {
+		return fmt.Errorf("pre-release %q is not on a configured channel %v", newVersion.PreRelease, channels)
+	}
+
+	sameRelease := newVersion.Major == previousVersion.Major &&
+		newVersion.Minor == previousVersion.Minor &&
+		newVersion.Patch == previousVersion.Patch
+	if !sameRelease || previousVersion.PreRelease == "" {
+		return nil
+	}
+
+	prevIdx := semver.ChannelIndex(previousVersion.PreRelease, channels)
+	if prevIdx > newIdx {
+		return fmt.Errorf("pre-release channel cannot move backwards from %q to %q (order: %v)",
+			channels[prevIdx], channels[newIdx], channels)
+	}
+
+	return nil
+}
+
+// validateExplicitStable rejects leaving initial development (0.x) by any
+// bump other than "stable", so 1.0.0 is never reached by accident.
+func (p *VersionValidatorPlugin) validateExplicitStable(rule Rule, newVersion, previousVersion semver.SemVersion, bumpType string) error {
+	if !rule.Enabled {
+		return nil
+	}
+
+	if previousVersion.Major == 0 && newVersion.Major > 0 && bumpType != "stable" {
+		return fmt.Errorf("leaving initial development (%s -> %s) requires 'verso bump stable'", previousVersion.String(), newVersion.String())
+	}
+
+	return nil
+}
+
 // matchBranchPattern checks if a branch name matches a glob-like pattern.
 func matchBranchPattern(pattern, branch string) (bool, error) {
 	// Convert glob pattern to regex
@@ -2,6 +2,7 @@ package versionvalidator
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/indaco/verso/internal/semver"
@@ -321,6 +322,13 @@ func TestVersionValidatorPlugin_NoBumpType(t *testing.T) {
 			bumpType: "minor",
 			wantErr:  false,
 		},
+		{
+			name:     "no major bump - attempting premajor",
+			ruleType: RuleNoMajorBump,
+			enabled:  true,
+			bumpType: "premajor",
+			wantErr:  true,
+		},
 		{
 			name:     "no minor bump - attempting minor",
 			ruleType: RuleNoMinorBump,
@@ -680,6 +688,88 @@ func TestVersionValidatorPlugin_UnknownRuleType(t *testing.T) {
 	}
 }
 
+func TestVersionValidatorPlugin_ChannelOrder(t *testing.T) {
+	v := func(minor int, pre string) semver.SemVersion {
+		return semver.SemVersion{Major: 1, Minor: minor, Patch: 0, PreRelease: pre}
+	}
+
+	tests := []struct {
+		name     string
+		channels []string
+		enabled  bool
+		prev     semver.SemVersion
+		next     semver.SemVersion
+		wantErr  string
+	}{
+		{"forward", nil, true, v(3, "alpha.2"), v(3, "beta.1"), ""},
+		{"same channel", nil, true, v(3, "rc.1"), v(3, "rc.2"), ""},
+		{"backwards", nil, true, v(3, "rc.2"), v(3, "alpha.1"), `cannot move backwards from "rc" to "alpha"`},
+		{"backwards with dash separator", nil, true, v(3, "beta-2"), v(3, "alpha-1"), "cannot move backwards"},
+		{"new release line", nil, true, v(3, "rc.2"), v(4, "alpha.1"), ""},
+		{"from final", nil, true, v(3, ""), v(3, "alpha.1"), ""},
+		{"to final", nil, true, v(3, "rc.2"), v(3, ""), ""},
+		{"unknown channel", nil, true, v(3, "rc.2"), v(3, "nightly.1"), "not on a configured channel"},
+		{"custom channels", []string{"dev", "qa"}, true, v(3, "qa.1"), v(3, "dev.1"), `from "qa" to "dev"`},
+		{"rule disabled", nil, false, v(3, "rc.2"), v(3, "alpha.1"), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vv := NewVersionValidator(&Config{
+				Enabled:  true,
+				Channels: tt.channels,
+				Rules:    []Rule{{Type: RulePreReleaseChannelOrder, Enabled: tt.enabled}},
+			})
+
+			err := vv.Validate(tt.next, tt.prev, "pre")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestVersionValidatorPlugin_RequireExplicitStable(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		prev     semver.SemVersion
+		next     semver.SemVersion
+		bumpType string
+		wantErr  bool
+	}{
+		{"major from 0.x", true, semver.SemVersion{Minor: 9}, semver.SemVersion{Major: 1}, "major", true},
+		{"premajor from 0.x", true, semver.SemVersion{Minor: 9}, semver.SemVersion{Major: 1, PreRelease: "rc.1"}, "premajor", true},
+		{"stable from 0.x", true, semver.SemVersion{Minor: 9}, semver.SemVersion{Major: 1}, "stable", false},
+		{"minor within 0.x", true, semver.SemVersion{Minor: 9}, semver.SemVersion{Minor: 10}, "minor", false},
+		{"major from 1.x", true, semver.SemVersion{Major: 1}, semver.SemVersion{Major: 2}, "major", false},
+		{"rule disabled", false, semver.SemVersion{Minor: 9}, semver.SemVersion{Major: 1}, "major", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vv := NewVersionValidator(&Config{
+				Enabled: true,
+				Rules:   []Rule{{Type: RuleRequireExplicitStable, Enabled: tt.enabled}},
+			})
+
+			err := vv.Validate(tt.next, tt.prev, tt.bumpType)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "requires 'verso bump stable'") {
+				t.Errorf("unexpected error message: %v", err)
+			}
+		})
+	}
+}
+
 func TestVersionValidatorPlugin_ValidateSet_AllRuleTypes(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,176 @@
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/indaco/verso/internal/apperrors"
+)
+
+// DefaultCalVerFormat is the CalVer format used when none is configured.
+const DefaultCalVerFormat = "YYYY.MM.MICRO"
+
+// calverToken is a single segment of a CalVer format string.
+type calverToken string
+
+const (
+	tokenFullYear    calverToken = "YYYY" // 2006, 2016, 2106
+	tokenShortYear   calverToken = "YY"   // 6, 16, 106
+	tokenPaddedYear  calverToken = "0Y"   // 06, 16, 106
+	tokenMonth       calverToken = "MM"   // 1, 2 ... 11, 12
+	tokenPaddedMonth calverToken = "0M"   // 01, 02 ... 11, 12
+	tokenWeek        calverToken = "WW"   // ISO week: 1, 2 ... 52, 53
+	tokenPaddedWeek  calverToken = "0W"   // ISO week: 01, 02 ... 52, 53
+	tokenMicro       calverToken = "MICRO"
+	tokenPatch       calverToken = "PATCH" // alias for MICRO
+)
+
+// CalVerScheme implements Scheme for calendar versioning (https://calver.org/).
+//
+// Formats have three dot-separated segments: a year (YYYY, YY, 0Y), a month
+// (MM, 0M) or ISO week (WW, 0W), and a counter (MICRO or PATCH). The counter
+// resets to 0 whenever the calendar period changes.
+type CalVerScheme struct {
+	format string
+	tokens [3]calverToken
+	now    func() time.Time
+}
+
+// NewCalVerScheme creates a CalVer scheme for the given format.
+// An empty format uses DefaultCalVerFormat, and a nil clock uses time.Now.
+func NewCalVerScheme(format string, now func() time.Time) (*CalVerScheme, error) {
+	if format == "" {
+		format = DefaultCalVerFormat
+	}
+	if now == nil {
+		now = time.Now
+	}
+
+	parts := strings.Split(format, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid calver format %q: expected three segments (e.g., %q)", format, DefaultCalVerFormat)
+	}
+
+	s := &CalVerScheme{format: format, now: now}
+	allowed := [3][]calverToken{
+		{tokenFullYear, tokenShortYear, tokenPaddedYear},
+		{tokenMonth, tokenPaddedMonth, tokenWeek, tokenPaddedWeek},
+		{tokenMicro, tokenPatch},
+	}
+	names := [3]string{"year (YYYY, YY, 0Y)", "month or week (MM, 0M, WW, 0W)", "counter (MICRO, PATCH)"}
+
+	for i, part := range parts {
+		token := calverToken(part)
+		if !slices.Contains(allowed[i], token) {
+			return nil, fmt.Errorf("invalid calver format %q: segment %d must be a %s, got %q", format, i+1, names[i], part)
+		}
+		s.tokens[i] = token
+	}
+
+	return s, nil
+}
+
+// Name returns "calver".
+func (s *CalVerScheme) Name() string { return SchemeCalVer }
+
+// FormatString returns the configured format (e.g., "YYYY.MM.MICRO").
+func (s *CalVerScheme) FormatString() string { return s.format }
+
+// Bump supports only "patch", which advances the counter or starts a new period.
+// Minor and major bumps have no meaning for calendar versions.
+func (s *CalVerScheme) Bump(v SemVersion, label string) (SemVersion, error) {
+	if label != "patch" {
+		return SemVersion{}, fmt.Errorf("bump %q is not supported by the calver scheme (%s): use patch or auto", label, s.format)
+	}
+	return s.advance(v), nil
+}
+
+// Next promotes a pre-release to its final version; otherwise it advances the
+// counter, resetting it to 0 when the current period is later than the version's.
+func (s *CalVerScheme) Next(v SemVersion) (SemVersion, error) {
+	if v.PreRelease != "" {
+		promoted := v
+		promoted.PreRelease = ""
+		return promoted, nil
+	}
+	return s.advance(v), nil
+}
+
+// advance returns the next release for the current date.
+func (s *CalVerScheme) advance(v SemVersion) SemVersion {
+	current := s.Initial()
+	if Compare(current, SemVersion{Major: v.Major, Minor: v.Minor}) > 0 {
+		return current
+	}
+	// Same period (or a version dated in the future): increment the counter.
+	return SemVersion{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}
+}
+
+// Format renders the version using the configured segment padding.
+func (s *CalVerScheme) Format(v SemVersion) string {
+	values := [3]int{v.Major, v.Minor, v.Patch}
+	segments := make([]string, 3)
+	for i, token := range s.tokens {
+		switch token {
+		case tokenPaddedYear, tokenPaddedMonth, tokenPaddedWeek:
+			segments[i] = fmt.Sprintf("%02d", values[i])
+		default:
+			segments[i] = fmt.Sprintf("%d", values[i])
+		}
+	}
+
+	out := strings.Join(segments, ".")
+	if v.PreRelease != "" {
+		out += "-" + v.PreRelease
+	}
+	if v.Build != "" {
+		out += "+" + v.Build
+	}
+	return out
+}
+
+// ParseStrict parses s and requires it to match the configured format exactly,
+// including zero padding and a valid month or week.
+func (s *CalVerScheme) ParseStrict(str string) (SemVersion, error) {
+	v, err := ParseVersion(str)
+	if err != nil {
+		return SemVersion{}, &apperrors.InvalidVersionError{Version: str, Reason: fmt.Sprintf("does not match calver format %s", s.format)}
+	}
+
+	switch s.tokens[1] {
+	case tokenMonth, tokenPaddedMonth:
+		if v.Minor < 1 || v.Minor > 12 {
+			return SemVersion{}, &apperrors.InvalidVersionError{Version: str, Reason: fmt.Sprintf("month %d is out of range", v.Minor)}
+		}
+	default:
+		if v.Minor < 1 || v.Minor > 53 {
+			return SemVersion{}, &apperrors.InvalidVersionError{Version: str, Reason: fmt.Sprintf("week %d is out of range", v.Minor)}
+		}
+	}
+
+	if expected := s.Format(v); expected != str {
+		return SemVersion{}, &apperrors.InvalidVersionError{
+			Version: str,
+			Reason:  fmt.Sprintf("does not match calver format %s (expected %q)", s.format, expected),
+		}
+	}
+
+	return v, nil
+}
+
+// Initial returns the first version of the current period (counter 0).
+func (s *CalVerScheme) Initial() SemVersion {
+	t := s.now()
+
+	year, period := t.Year(), int(t.Month())
+	if s.tokens[1] == tokenWeek || s.tokens[1] == tokenPaddedWeek {
+		year, period = t.ISOWeek()
+	}
+	if s.tokens[0] != tokenFullYear {
+		year -= 2000
+	}
+
+	return SemVersion{Major: year, Minor: period, Patch: 0}
+}
@@ -0,0 +1,180 @@
+package semver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/indaco/verso/internal/apperrors"
+)
+
+func fixedClock(year int, month time.Month, day int) func() time.Time {
+	return func() time.Time {
+		return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+	}
+}
+
+func mustCalVer(t *testing.T, format string, now func() time.Time) *CalVerScheme {
+	t.Helper()
+	s, err := NewCalVerScheme(format, now)
+	if err != nil {
+		t.Fatalf("NewCalVerScheme(%q) failed: %v", format, err)
+	}
+	return s
+}
+
+func TestNewCalVerScheme(t *testing.T) {
+	valid := []string{"", "YYYY.MM.MICRO", "YY.0M.PATCH", "0Y.0W.MICRO", "YYYY.WW.MICRO"}
+	for _, format := range valid {
+		if _, err := NewCalVerScheme(format, nil); err != nil {
+			t.Errorf("expected format %q to be valid, got %v", format, err)
+		}
+	}
+
+	invalid := []struct {
+		format   string
+		expected string
+	}{
+		{"YYYY.MM", "expected three segments"},
+		{"YYYY.MM.DD.MICRO", "expected three segments"},
+		{"MM.YYYY.MICRO", "segment 1 must be a year"},
+		{"YYYY.DD.MICRO", "segment 2 must be a month or week"},
+		{"YYYY.MM.MINOR", "segment 3 must be a counter"},
+	}
+	for _, tt := range invalid {
+		_, err := NewCalVerScheme(tt.format, nil)
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("format %q: expected error containing %q, got %v", tt.format, tt.expected, err)
+		}
+	}
+}
+
+func TestCalVerScheme_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		v      SemVersion
+		want   string
+	}{
+		{"YYYY.MM.MICRO", SemVersion{Major: 2026, Minor: 3, Patch: 1}, "2026.3.1"},
+		{"YY.0M.PATCH", SemVersion{Major: 26, Minor: 3, Patch: 0}, "26.03.0"},
+		{"0Y.0W.MICRO", SemVersion{Major: 6, Minor: 9, Patch: 2}, "06.09.2"},
+		{"YYYY.0M.MICRO", SemVersion{Major: 2026, Minor: 10, Patch: 0, PreRelease: "rc.1", Build: "ci.7"}, "2026.10.0-rc.1+ci.7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			s := mustCalVer(t, tt.format, nil)
+			if got := s.Format(tt.v); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalVerScheme_Next(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		now     func() time.Time
+		current string
+		want    string
+	}{
+		{"same month increments micro", "YYYY.0M.MICRO", fixedClock(2026, time.March, 14), "2026.03.4", "2026.03.5"},
+		{"new month resets micro", "YYYY.0M.MICRO", fixedClock(2026, time.April, 1), "2026.03.4", "2026.04.0"},
+		{"new year resets micro", "YY.0M.PATCH", fixedClock(2027, time.January, 2), "26.12.9", "27.01.0"},
+		{"future-dated version increments micro", "YYYY.MM.MICRO", fixedClock(2026, time.March, 1), "2026.4.0", "2026.4.1"},
+		{"pre-release is promoted", "YYYY.MM.MICRO", fixedClock(2026, time.May, 1), "2026.4.0-rc.2", "2026.4.0"},
+		{"ISO week", "YYYY.0W.MICRO", fixedClock(2026, time.January, 1), "2025.52.3", "2026.01.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := mustCalVer(t, tt.format, tt.now)
+			defer SetDefaultScheme(s)()
+
+			current, err := ParseVersion(tt.current)
+			if err != nil {
+				t.Fatalf("ParseVersion(%q) failed: %v", tt.current, err)
+			}
+
+			next, err := s.Next(current)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if next.String() != tt.want {
+				t.Errorf("Next(%s) = %s, want %s", tt.current, next, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalVerScheme_Bump(t *testing.T) {
+	s := mustCalVer(t, "YYYY.MM.MICRO", fixedClock(2026, time.June, 30))
+
+	next, err := s.Bump(SemVersion{Major: 2026, Minor: 6, Patch: 0, PreRelease: "rc.1"}, "patch")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if next != (SemVersion{Major: 2026, Minor: 6, Patch: 1}) {
+		t.Errorf("expected 2026.6.1, got %+v", next)
+	}
+
+	for _, label := range []string{"minor", "major"} {
+		if _, err := s.Bump(next, label); err == nil {
+			t.Errorf("expected error for bump %q", label)
+		}
+	}
+}
+
+func TestCalVerScheme_ParseStrict(t *testing.T) {
+	s := mustCalVer(t, "YY.0M.MICRO", nil)
+
+	v, err := s.ParseStrict("26.03.1-rc.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Major != 26 || v.Minor != 3 || v.Patch != 1 || v.PreRelease != "rc.1" {
+		t.Errorf("unexpected version: %+v", v)
+	}
+
+	invalid := []struct {
+		input  string
+		reason string
+	}{
+		{"26.3.1", `does not match calver format YY.0M.MICRO (expected "26.03.1")`},
+		{"26.13.0", "month 13 is out of range"},
+		{"not-a-version", "does not match calver format"},
+	}
+	for _, tt := range invalid {
+		_, err := s.ParseStrict(tt.input)
+		var verr *apperrors.InvalidVersionError
+		if !errors.As(err, &verr) {
+			t.Errorf("%q: expected *apperrors.InvalidVersionError, got %v", tt.input, err)
+			continue
+		}
+		if !strings.Contains(verr.Reason, tt.reason) {
+			t.Errorf("%q: expected reason containing %q, got %q", tt.input, tt.reason, verr.Reason)
+		}
+	}
+}
+
+func TestCalVerScheme_Initial(t *testing.T) {
+	tests := []struct {
+		format string
+		now    func() time.Time
+		want   SemVersion
+	}{
+		{"YYYY.MM.MICRO", fixedClock(2026, time.October, 16), SemVersion{Major: 2026, Minor: 10}},
+		{"YY.0M.MICRO", fixedClock(2026, time.October, 16), SemVersion{Major: 26, Minor: 10}},
+		// 2027-01-01 belongs to ISO week 53 of 2026.
+		{"YYYY.WW.MICRO", fixedClock(2027, time.January, 1), SemVersion{Major: 2026, Minor: 53}},
+	}
+
+	for _, tt := range tests {
+		s := mustCalVer(t, tt.format, tt.now)
+		if got := s.Initial(); got != tt.want {
+			t.Errorf("%s: Initial() = %+v, want %+v", tt.format, got, tt.want)
+		}
+	}
+}
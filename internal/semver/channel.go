@@ -0,0 +1,61 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultChannels is the default pre-release channel progression.
+var DefaultChannels = []string{"alpha", "beta", "rc"}
+
+// PreReleaseChannel returns the channel label of a pre-release, i.e. the
+// pre-release without its counter: "rc.2" -> "rc", "beta-1" -> "beta".
+func PreReleaseChannel(pre string) string {
+	base := strings.TrimRight(pre, "0123456789")
+	if base == "" {
+		return pre
+	}
+	if last := base[len(base)-1]; last == '.' || last == '-' {
+		base = base[:len(base)-1]
+	}
+	return base
+}
+
+// ChannelIndex returns the position of the pre-release's channel in channels,
+// or -1 if it is not listed. Labels are compared case-insensitively.
+func ChannelIndex(pre string, channels []string) int {
+	channel := PreReleaseChannel(pre)
+	for i, c := range channels {
+		if strings.EqualFold(c, channel) {
+			return i
+		}
+	}
+	return -1
+}
+
+// NextChannel advances a pre-release to the first release of the next
+// channel, keeping its separator style: "alpha.3" -> "beta.1", "beta-2" -> "rc-1".
+func NextChannel(pre string, channels []string) (string, error) {
+	if pre == "" {
+		return "", fmt.Errorf("current version has no pre-release; use --label to start a channel")
+	}
+
+	idx := ChannelIndex(pre, channels)
+	if idx < 0 {
+		return "", fmt.Errorf("pre-release %q is not on a configured channel %v", pre, channels)
+	}
+	if idx == len(channels)-1 {
+		return "", fmt.Errorf("pre-release %q is already on the last channel %q; use 'verso bump release' to finalize", pre, channels[idx])
+	}
+
+	sep := "."
+	if rest := pre[len(PreReleaseChannel(pre)):]; rest != "" {
+		switch rest[0] {
+		case '.', '-':
+			sep = rest[:1]
+		default:
+			sep = ""
+		}
+	}
+	return formatPreReleaseWithSep(channels[idx+1], 1, sep), nil
+}
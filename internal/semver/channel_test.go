@@ -0,0 +1,68 @@
+package semver
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestChannelIndex(t *testing.T) {
+	tests := []struct {
+		pre  string
+		want int
+	}{
+		{"alpha", 0},
+		{"alpha.3", 0},
+		{"Beta-2", 1},
+		{"rc1", 2},
+		{"nightly.1", -1},
+		{"", -1},
+	}
+
+	for _, tt := range tests {
+		if got := ChannelIndex(tt.pre, DefaultChannels); got != tt.want {
+			t.Errorf("ChannelIndex(%q) = %d, want %d", tt.pre, got, tt.want)
+		}
+	}
+}
+
+func TestNextChannel(t *testing.T) {
+	tests := []struct {
+		pre      string
+		channels []string
+		want     string
+	}{
+		{"alpha.3", DefaultChannels, "beta.1"},
+		{"alpha", DefaultChannels, "beta.1"},
+		{"beta-2", DefaultChannels, "rc-1"},
+		{"beta2", DefaultChannels, "rc1"},
+		{"dev.4", []string{"dev", "qa", "rc"}, "qa.1"},
+	}
+
+	for _, tt := range tests {
+		got, err := NextChannel(tt.pre, tt.channels)
+		if err != nil {
+			t.Fatalf("NextChannel(%q) failed: %v", tt.pre, err)
+		}
+		if got != tt.want {
+			t.Errorf("NextChannel(%q) = %q, want %q", tt.pre, got, tt.want)
+		}
+	}
+}
+
+func TestNextChannel_Errors(t *testing.T) {
+	tests := []struct {
+		pre      string
+		expected string
+	}{
+		{"", "has no pre-release"},
+		{"nightly.1", "not on a configured channel"},
+		{"rc.2", `already on the last channel "rc"`},
+	}
+
+	for _, tt := range tests {
+		_, err := NextChannel(tt.pre, DefaultChannels)
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("NextChannel(%q): expected error containing %q, got %v", tt.pre, tt.expected, err)
+		}
+	}
+}
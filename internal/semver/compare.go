@@ -0,0 +1,148 @@
+package semver
+
+import (
+	"slices"
+	"strings"
+)
+
+// Compare returns an integer comparing two versions by SemVer 2.0.0 precedence.
+// The result is -1 if a < b, 0 if a == b, and +1 if a > b.
+// Build metadata is ignored, as required by the specification.
+func Compare(a, b SemVersion) int {
+	if c := compareInt(a.Major, b.Major); c != 0 {
+		return c
+	}
+	if c := compareInt(a.Minor, b.Minor); c != 0 {
+		return c
+	}
+	if c := compareInt(a.Patch, b.Patch); c != 0 {
+		return c
+	}
+	return comparePreRelease(a.PreRelease, b.PreRelease)
+}
+
+// Compare compares v with other by SemVer 2.0.0 precedence.
+// See the package-level Compare function for details.
+func (v SemVersion) Compare(other SemVersion) int {
+	return Compare(v, other)
+}
+
+// Less reports whether v has lower precedence than other.
+func (v SemVersion) Less(other SemVersion) bool {
+	return Compare(v, other) < 0
+}
+
+// Equal reports whether v and other have the same precedence.
+// Versions differing only in build metadata are considered equal.
+func (v SemVersion) Equal(other SemVersion) bool {
+	return Compare(v, other) == 0
+}
+
+// IsPreRelease reports whether the version carries a pre-release label.
+func (v SemVersion) IsPreRelease() bool {
+	return v.PreRelease != ""
+}
+
+// Sort sorts versions in ascending order of precedence.
+// The sort is stable, so versions with equal precedence (e.g., differing
+// only in build metadata) keep their original relative order.
+func Sort(versions []SemVersion) {
+	slices.SortStableFunc(versions, Compare)
+}
+
+// SortDescending sorts versions in descending order of precedence (newest first).
+func SortDescending(versions []SemVersion) {
+	slices.SortStableFunc(versions, func(a, b SemVersion) int {
+		return Compare(b, a)
+	})
+}
+
+// Max returns the version with the highest precedence.
+// Returns false if versions is empty.
+func Max(versions []SemVersion) (SemVersion, bool) {
+	if len(versions) == 0 {
+		return SemVersion{}, false
+	}
+	return slices.MaxFunc(versions, Compare), true
+}
+
+// compareInt compares two integers and returns -1, 0, or +1.
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// comparePreRelease compares two pre-release strings per SemVer 2.0.0 section 11:
+//   - a version without pre-release has higher precedence than one with it
+//   - identifiers are compared left to right, dot-separated
+//   - numeric identifiers compare numerically and have lower precedence than alphanumeric ones
+//   - alphanumeric identifiers compare lexically in ASCII order
+//   - a larger set of identifiers has higher precedence if all preceding ones are equal
+func comparePreRelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		if c := compareIdentifier(aParts[i], bParts[i]); c != 0 {
+			return c
+		}
+	}
+
+	return compareInt(len(aParts), len(bParts))
+}
+
+// compareIdentifier compares a single pair of pre-release identifiers.
+func compareIdentifier(a, b string) int {
+	aNum := isNumericIdentifier(a)
+	bNum := isNumericIdentifier(b)
+
+	switch {
+	case aNum && bNum:
+		return compareNumericStrings(a, b)
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// compareNumericStrings compares two digit-only strings numerically without
+// converting them to integers, so arbitrarily large identifiers never overflow.
+func compareNumericStrings(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if c := compareInt(len(a), len(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// isNumericIdentifier reports whether s consists only of ASCII digits.
+func isNumericIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
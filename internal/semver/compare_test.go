@@ -0,0 +1,141 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.0.0", "2.0.0", -1},
+		{"2.0.0", "2.1.0", -1},
+		{"2.1.0", "2.1.1", -1},
+		{"2.1.1", "2.1.0", 1},
+		{"1.10.0", "1.9.0", 1},
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0", "1.0.0-rc.1", 1},
+		{"1.0.0+build.1", "1.0.0+build.2", 0},
+		{"1.0.0-rc.1+a", "1.0.0-rc.1+b", 0},
+		// Precedence chain from the SemVer 2.0.0 specification, section 11.
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-alpha.beta", "1.0.0-beta", -1},
+		{"1.0.0-beta", "1.0.0-beta.2", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-beta.11", "1.0.0-rc.1", -1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		// Numeric identifiers compare numerically, even beyond int range.
+		{"1.0.0-rc.99999999999999999999", "1.0.0-rc.100000000000000000000", -1},
+		// Alphanumeric identifiers compare in ASCII order.
+		{"1.0.0-RC", "1.0.0-rc", -1},
+		{"1.0.0-rc1", "1.0.0-rc10", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_vs_"+tt.b, func(t *testing.T) {
+			a := mustParse(t, tt.a)
+			b := mustParse(t, tt.b)
+
+			if got := Compare(a, b); got != tt.want {
+				t.Errorf("Compare(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+			if got := Compare(b, a); got != -tt.want {
+				t.Errorf("Compare(%s, %s) = %d, want %d", tt.b, tt.a, got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestSemVersion_LessEqual(t *testing.T) {
+	a := mustParse(t, "1.2.3-beta.1")
+	b := mustParse(t, "1.2.3")
+	c := mustParse(t, "1.2.3+ci.7")
+
+	if !a.Less(b) {
+		t.Errorf("expected %s < %s", a, b)
+	}
+	if b.Less(a) {
+		t.Errorf("expected %s not < %s", b, a)
+	}
+	if !b.Equal(c) {
+		t.Errorf("expected %s == %s (build metadata ignored)", b, c)
+	}
+	if a.Equal(b) {
+		t.Errorf("expected %s != %s", a, b)
+	}
+	if got := b.Compare(a); got != 1 {
+		t.Errorf("expected %s.Compare(%s) = 1, got %d", b, a, got)
+	}
+	if !a.IsPreRelease() || b.IsPreRelease() {
+		t.Errorf("unexpected IsPreRelease result")
+	}
+}
+
+func TestSort(t *testing.T) {
+	input := []string{"1.0.0", "1.0.0-rc.1", "0.9.0", "1.0.0-alpha", "1.0.0-beta.11", "1.0.0-beta.2", "2.0.0"}
+	want := []string{"0.9.0", "1.0.0-alpha", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "2.0.0"}
+
+	versions := make([]SemVersion, len(input))
+	for i, s := range input {
+		versions[i] = mustParse(t, s)
+	}
+
+	Sort(versions)
+	for i, v := range versions {
+		if v.String() != want[i] {
+			t.Errorf("Sort: position %d = %s, want %s", i, v, want[i])
+		}
+	}
+
+	SortDescending(versions)
+	for i, v := range versions {
+		if v.String() != want[len(want)-1-i] {
+			t.Errorf("SortDescending: position %d = %s, want %s", i, v, want[len(want)-1-i])
+		}
+	}
+}
+
+func TestSort_StableForBuildMetadata(t *testing.T) {
+	versions := []SemVersion{
+		mustParse(t, "1.0.0+b"),
+		mustParse(t, "1.0.0+a"),
+	}
+
+	Sort(versions)
+
+	if versions[0].Build != "b" || versions[1].Build != "a" {
+		t.Errorf("expected stable order for equal precedence, got %v", versions)
+	}
+}
+
+func TestMax(t *testing.T) {
+	if _, ok := Max(nil); ok {
+		t.Error("expected ok=false for empty slice")
+	}
+
+	versions := []SemVersion{
+		mustParse(t, "1.2.0"),
+		mustParse(t, "1.10.0-rc.1"),
+		mustParse(t, "1.9.9"),
+	}
+
+	got, ok := Max(versions)
+	if !ok {
+		t.Fatal("expected ok=true")
+	}
+	if got.String() != "1.10.0-rc.1" {
+		t.Errorf("expected 1.10.0-rc.1, got %s", got)
+	}
+}
+
+func mustParse(t *testing.T, s string) SemVersion {
+	t.Helper()
+	v, err := ParseVersion(s)
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", s, err)
+	}
+	return v
+}
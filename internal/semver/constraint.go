@@ -0,0 +1,420 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// constraintOp is a primitive comparison operator used by a comparator.
+type constraintOp int
+
+const (
+	opEQ constraintOp = iota
+	opNE
+	opGT
+	opGTE
+	opLT
+	opLTE
+)
+
+// String returns the textual form of the operator.
+func (o constraintOp) String() string {
+	switch o {
+	case opNE:
+		return "!="
+	case opGT:
+		return ">"
+	case opGTE:
+		return ">="
+	case opLT:
+		return "<"
+	case opLTE:
+		return "<="
+	default:
+		return "="
+	}
+}
+
+// comparator is a single primitive comparison against a full version.
+// All range syntaxes (caret, tilde, hyphen, wildcards) are desugared into comparators.
+type comparator struct {
+	op      constraintOp
+	version SemVersion
+}
+
+// matches reports whether v satisfies the comparator.
+func (c comparator) matches(v SemVersion) bool {
+	cmp := Compare(v, c.version)
+	switch c.op {
+	case opNE:
+		return cmp != 0
+	case opGT:
+		return cmp > 0
+	case opGTE:
+		return cmp >= 0
+	case opLT:
+		return cmp < 0
+	case opLTE:
+		return cmp <= 0
+	default:
+		return cmp == 0
+	}
+}
+
+// String returns the textual form of the comparator (e.g., ">=1.2.3").
+func (c comparator) String() string {
+	return c.op.String() + c.version.String()
+}
+
+// Constraints is a parsed version constraint expression.
+//
+// It is a disjunction (||) of comparator sets; a version satisfies the
+// constraints when it satisfies every comparator of at least one set.
+type Constraints struct {
+	raw    string
+	groups [][]comparator
+}
+
+var (
+	// errInvalidConstraint is returned when a constraint expression cannot be parsed.
+	errInvalidConstraint = errors.New("invalid constraint")
+
+	// partialVersionRegex matches a possibly partial version used inside a constraint,
+	// where any numeric component may be omitted or replaced by a wildcard (x, X, *).
+	partialVersionRegex = regexp.MustCompile(
+		`^[vV]?(\d+|[xX*])(?:\.(\d+|[xX*]))?(?:\.(\d+|[xX*]))?` +
+			`(?:-([0-9A-Za-z\-\.]+))?` +
+			`(?:\+([0-9A-Za-z\-\.]+))?$`,
+	)
+
+	// constraintOperators lists recognized operator prefixes, longest first.
+	constraintOperators = []string{">=", "<=", "!=", "==", ">", "<", "=", "^", "~"}
+)
+
+// ParseConstraints parses a constraint expression such as "^1.2", "~1.2.3",
+// ">=1.0.0 <2.0.0", "1.2.3 - 1.4", "1.x" or "^1.0 || ^2.0".
+//
+// Comparators within a set are separated by whitespace or commas; sets are
+// separated by "||". A bare version means an exact match, and partial or
+// wildcard versions match the whole range they describe.
+func ParseConstraints(s string) (*Constraints, error) {
+	c := &Constraints{raw: strings.TrimSpace(s)}
+
+	for group := range strings.SplitSeq(s, "||") {
+		comparators, err := parseComparatorSet(group)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %v", errInvalidConstraint, c.raw, err)
+		}
+		c.groups = append(c.groups, comparators)
+	}
+
+	return c, nil
+}
+
+// Satisfies reports whether v satisfies the given constraint expression.
+func Satisfies(v SemVersion, constraint string) (bool, error) {
+	c, err := ParseConstraints(constraint)
+	if err != nil {
+		return false, err
+	}
+	return c.Check(v), nil
+}
+
+// Check reports whether v satisfies the constraints.
+//
+// Pre-release versions follow the npm and Cargo rule: a version with a
+// pre-release only matches a comparator set if at least one comparator in
+// that set carries a pre-release on the same major.minor.patch tuple.
+// For example, 1.2.3-rc.2 satisfies ">=1.2.3-rc.1" but not ">=1.2.0".
+func (c *Constraints) Check(v SemVersion) bool {
+	for _, group := range c.groups {
+		if groupMatches(group, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the constraint expression as originally provided.
+func (c *Constraints) String() string {
+	return c.raw
+}
+
+// groupMatches reports whether v satisfies every comparator in the set,
+// honoring the pre-release matching rule.
+func groupMatches(group []comparator, v SemVersion) bool {
+	for _, cmp := range group {
+		if !cmp.matches(v) {
+			return false
+		}
+	}
+
+	if v.PreRelease == "" {
+		return true
+	}
+
+	for _, cmp := range group {
+		cv := cmp.version
+		if cv.PreRelease != "" && cv.Major == v.Major && cv.Minor == v.Minor && cv.Patch == v.Patch {
+			return true
+		}
+	}
+	return false
+}
+
+// parseComparatorSet parses a single whitespace/comma separated set of constraints.
+func parseComparatorSet(group string) ([]comparator, error) {
+	tokens := tokenizeConstraint(group)
+	if len(tokens) == 0 {
+		// An empty set matches any release version, like "*".
+		return []comparator{{op: opGTE}}, nil
+	}
+
+	var result []comparator
+	for i := 0; i < len(tokens); i++ {
+		// Hyphen range: "A - B"
+		if i+2 < len(tokens) && tokens[i+1] == "-" {
+			comparators, err := desugarHyphen(tokens[i], tokens[i+2])
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, comparators...)
+			i += 2
+			continue
+		}
+
+		comparators, err := desugarToken(tokens[i])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, comparators...)
+	}
+
+	return result, nil
+}
+
+// tokenizeConstraint splits a comparator set into tokens, joining operators
+// separated from their version by whitespace (e.g., ">= 1.2.3").
+func tokenizeConstraint(group string) []string {
+	fields := strings.Fields(strings.ReplaceAll(group, ",", " "))
+
+	tokens := make([]string, 0, len(fields))
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+		if isBareOperator(field) && i+1 < len(fields) && fields[i+1] != "-" {
+			field += fields[i+1]
+			i++
+		}
+		tokens = append(tokens, field)
+	}
+	return tokens
+}
+
+// isBareOperator reports whether s is an operator without a version.
+func isBareOperator(s string) bool {
+	return slices.Contains(constraintOperators, s)
+}
+
+// splitOperator separates the operator prefix from the version part of a token.
+func splitOperator(token string) (string, string) {
+	for _, op := range constraintOperators {
+		if strings.HasPrefix(token, op) {
+			return op, strings.TrimSpace(token[len(op):])
+		}
+	}
+	return "", token
+}
+
+// partialVersion is a version whose trailing components may be unspecified.
+type partialVersion struct {
+	major, minor, patch int
+	// parts is the number of specified numeric components (0 to 3).
+	parts int
+	pre   string
+}
+
+// full returns the partial version with unspecified components set to zero.
+func (p partialVersion) full() SemVersion {
+	return SemVersion{Major: p.major, Minor: p.minor, Patch: p.patch, PreRelease: p.pre}
+}
+
+// parsePartialVersion parses a possibly partial or wildcard version.
+func parsePartialVersion(s string) (partialVersion, error) {
+	if s == "" {
+		return partialVersion{}, errors.New("missing version")
+	}
+
+	matches := partialVersionRegex.FindStringSubmatch(s)
+	if matches == nil {
+		return partialVersion{}, fmt.Errorf("malformed version %q", s)
+	}
+
+	var p partialVersion
+	targets := []*int{&p.major, &p.minor, &p.patch}
+	wildcard := false
+
+	for i, raw := range matches[1:4] {
+		if raw == "" || raw == "x" || raw == "X" || raw == "*" {
+			wildcard = true
+			continue
+		}
+		if wildcard {
+			return partialVersion{}, fmt.Errorf("malformed version %q: numeric component after wildcard", s)
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return partialVersion{}, fmt.Errorf("malformed version %q: %v", s, err)
+		}
+		*targets[i] = n
+		p.parts++
+	}
+
+	p.pre = matches[4]
+	if p.pre != "" && p.parts < 3 {
+		return partialVersion{}, fmt.Errorf("malformed version %q: pre-release requires a full version", s)
+	}
+
+	return p, nil
+}
+
+// desugarToken converts a single operator+version token into comparators.
+func desugarToken(token string) ([]comparator, error) {
+	op, raw := splitOperator(token)
+
+	p, err := parsePartialVersion(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "", "=", "==":
+		return desugarExact(p), nil
+	case "!=":
+		if p.parts < 3 {
+			return nil, fmt.Errorf("operator != requires a full version, got %q", raw)
+		}
+		return []comparator{{op: opNE, version: p.full()}}, nil
+	case ">":
+		return desugarGreater(p), nil
+	case ">=":
+		return []comparator{{op: opGTE, version: p.full()}}, nil
+	case "<":
+		return []comparator{{op: opLT, version: lowerBound(p)}}, nil
+	case "<=":
+		if p.parts == 3 {
+			return []comparator{{op: opLTE, version: p.full()}}, nil
+		}
+		return upperExclusive(p), nil
+	case "~":
+		return desugarTilde(p), nil
+	case "^":
+		return desugarCaret(p), nil
+	default:
+		return nil, fmt.Errorf("unknown operator %q", op)
+	}
+}
+
+// desugarExact expands "1.2.3", "1.2" or "1.x" into comparators.
+func desugarExact(p partialVersion) []comparator {
+	if p.parts == 3 {
+		return []comparator{{op: opEQ, version: p.full()}}
+	}
+	return append([]comparator{{op: opGTE, version: p.full()}}, upperExclusive(p)...)
+}
+
+// desugarGreater expands ">1.2.3", ">1.2" or ">1".
+func desugarGreater(p partialVersion) []comparator {
+	switch p.parts {
+	case 3:
+		return []comparator{{op: opGT, version: p.full()}}
+	case 2:
+		return []comparator{{op: opGTE, version: SemVersion{Major: p.major, Minor: p.minor + 1}}}
+	case 1:
+		return []comparator{{op: opGTE, version: SemVersion{Major: p.major + 1}}}
+	default:
+		// Nothing is greater than every version.
+		return []comparator{{op: opLT, version: SemVersion{PreRelease: "0"}}}
+	}
+}
+
+// desugarTilde expands "~1.2.3" (patch-level changes) into comparators.
+func desugarTilde(p partialVersion) []comparator {
+	if p.parts < 3 {
+		return desugarExact(p)
+	}
+	return []comparator{
+		{op: opGTE, version: p.full()},
+		{op: opLT, version: SemVersion{Major: p.major, Minor: p.minor + 1, PreRelease: "0"}},
+	}
+}
+
+// desugarCaret expands "^1.2.3" (changes that do not modify the left-most
+// non-zero component) into comparators.
+func desugarCaret(p partialVersion) []comparator {
+	var upper SemVersion
+	switch {
+	case p.parts == 0:
+		return desugarExact(p)
+	case p.major > 0 || p.parts == 1:
+		upper = SemVersion{Major: p.major + 1, PreRelease: "0"}
+	case p.minor > 0 || p.parts == 2:
+		upper = SemVersion{Minor: p.minor + 1, PreRelease: "0"}
+	default:
+		upper = SemVersion{Patch: p.patch + 1, PreRelease: "0"}
+	}
+	return []comparator{
+		{op: opGTE, version: p.full()},
+		{op: opLT, version: upper},
+	}
+}
+
+// desugarHyphen expands an inclusive hyphen range "A - B".
+func desugarHyphen(from, to string) ([]comparator, error) {
+	lower, err := parsePartialVersion(from)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := parsePartialVersion(to)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []comparator{{op: opGTE, version: lower.full()}}
+	switch upper.parts {
+	case 0:
+		// Open-ended upper bound.
+	case 3:
+		result = append(result, comparator{op: opLTE, version: upper.full()})
+	default:
+		result = append(result, upperExclusive(upper)...)
+	}
+	return result, nil
+}
+
+// lowerBound returns the smallest version matching the partial version,
+// excluding pre-releases of that version for partial inputs (e.g., "<1.2"
+// becomes "<1.2.0-0").
+func lowerBound(p partialVersion) SemVersion {
+	v := p.full()
+	if p.parts < 3 {
+		v.PreRelease = "0"
+	}
+	return v
+}
+
+// upperExclusive returns the exclusive upper bound for a partial version,
+// e.g., "1.2" -> "<1.3.0-0" and "1" -> "<2.0.0-0".
+func upperExclusive(p partialVersion) []comparator {
+	switch p.parts {
+	case 2:
+		return []comparator{{op: opLT, version: SemVersion{Major: p.major, Minor: p.minor + 1, PreRelease: "0"}}}
+	case 1:
+		return []comparator{{op: opLT, version: SemVersion{Major: p.major + 1, PreRelease: "0"}}}
+	default:
+		return nil
+	}
+}
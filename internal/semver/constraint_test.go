@@ -0,0 +1,157 @@
+package semver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConstraints_Check(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		// Exact and primitive operators
+		{"1.2.3", "1.2.3", true},
+		{"=1.2.3", "1.2.4", false},
+		{"v1.2.3", "1.2.3", true},
+		{"!=1.2.3", "1.2.4", true},
+		{"!=1.2.3", "1.2.3", false},
+		{">1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.3", false},
+		{"<1.2.3", "1.2.2", true},
+		{"<=1.2.3", "1.2.3", true},
+		{">= 1.2.3", "1.2.3", true},
+
+		// Ranges
+		{">=1.0.0 <2.0.0", "1.9.9", true},
+		{">=1.0.0 <2.0.0", "2.0.0", false},
+		{">=1.0.0, <2.0.0", "1.5.0", true},
+
+		// Caret
+		{"^1.2", "1.9.0", true},
+		{"^1.2", "1.1.9", false},
+		{"^1.2", "2.0.0", false},
+		{"^1.2.3", "1.2.3", true},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.3", true},
+		{"^0.0.3", "0.0.4", false},
+		{"^0", "0.9.9", true},
+		{"^0.0", "0.0.9", true},
+		{"^0.0", "0.1.0", false},
+
+		// Tilde
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"~1.2", "1.2.0", true},
+		{"~1", "1.9.0", true},
+		{"~1", "2.0.0", false},
+
+		// Partial comparators
+		{">1.2", "1.2.9", false},
+		{">1.2", "1.3.0", true},
+		{"<1.2", "1.1.9", true},
+		{"<1.2", "1.2.0", false},
+		{"<=1.2", "1.2.9", true},
+		{"<=1.2", "1.3.0", false},
+
+		// Wildcards
+		{"*", "3.4.5", true},
+		{"", "3.4.5", true},
+		{"1.x", "1.5.0", true},
+		{"1.x", "2.0.0", false},
+		{"1.2.*", "1.2.7", true},
+		{"1.2.X", "1.3.0", false},
+
+		// Hyphen ranges
+		{"1.2.3 - 2.3.4", "2.3.4", true},
+		{"1.2.3 - 2.3.4", "2.3.5", false},
+		{"1.2 - 2.3", "2.3.9", true},
+		{"1.2 - 2.3", "2.4.0", false},
+		{"1.2 - 2.3", "1.1.0", false},
+
+		// Disjunctions
+		{"^1.0 || ^3.0", "3.1.0", true},
+		{"^1.0 || ^3.0", "2.1.0", false},
+		{"<1.0.0 || >=2.0.0", "0.5.0", true},
+
+		// Pre-release matching
+		{">=1.2.3-rc.1", "1.2.3-rc.2", true},
+		{">=1.2.3-rc.1", "1.2.4-rc.1", false},
+		{">=1.0.0", "1.2.3-rc.1", false},
+		{"^1.2.3-beta.2", "1.2.3-beta.4", true},
+		{"^1.2.3-beta.2", "1.2.3-beta.1", false},
+		{"^1.2.3-beta.2", "1.2.4", true},
+		{"<2.0.0", "2.0.0-rc.1", false},
+		{"*", "1.0.0-alpha", false},
+		{"1.2.3-rc.1", "1.2.3-rc.1", true},
+
+		// Build metadata is ignored
+		{"1.2.3", "1.2.3+build.7", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+"_"+tt.version, func(t *testing.T) {
+			c, err := ParseConstraints(tt.constraint)
+			if err != nil {
+				t.Fatalf("ParseConstraints(%q) unexpected error: %v", tt.constraint, err)
+			}
+
+			if got := c.Check(mustParse(t, tt.version)); got != tt.want {
+				t.Errorf("%q.Check(%s) = %v, want %v", tt.constraint, tt.version, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConstraints_Invalid(t *testing.T) {
+	tests := []string{
+		"abc",
+		">=",
+		"1.2.3.4",
+		"^1.x.3",
+		"1.2-rc.1",
+		"!=1.2",
+		"~>1.2",
+		">=1.0.0 <",
+		"^1 || foo",
+		"99999999999999999999.0.0",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseConstraints(input)
+			if err == nil {
+				t.Fatalf("expected error for %q", input)
+			}
+			if !errors.Is(err, errInvalidConstraint) {
+				t.Errorf("expected errInvalidConstraint, got %v", err)
+			}
+		})
+	}
+}
+
+func TestConstraints_String(t *testing.T) {
+	c, err := ParseConstraints("  ^1.2 || ~2.0  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := c.String(); got != "^1.2 || ~2.0" {
+		t.Errorf("expected %q, got %q", "^1.2 || ~2.0", got)
+	}
+}
+
+func TestSatisfies(t *testing.T) {
+	ok, err := Satisfies(mustParse(t, "1.4.0"), "^1.2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ok {
+		t.Error("expected 1.4.0 to satisfy ^1.2")
+	}
+
+	if _, err := Satisfies(mustParse(t, "1.4.0"), "not-a-range"); err == nil {
+		t.Error("expected error for invalid constraint")
+	}
+}
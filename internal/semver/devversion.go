@@ -0,0 +1,209 @@
+package semver
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/indaco/verso/internal/apperrors"
+)
+
+// DefaultDevTemplate renders the pre-release and build metadata of development
+// versions, e.g. "dev.7+g3f2a1c9" for 1.2.4-dev.7+g3f2a1c9. A pre-release of
+// the base version is kept in front (1.3.0-rc.1.dev.2+g3f2a1c9).
+const DefaultDevTemplate = `{{if .PreRelease}}{{.PreRelease}}.{{end}}dev.{{.Commits}}+g{{.Hash}}{{if .Dirty}}.dirty{{end}}`
+
+// WorktreeState describes HEAD relative to the most recent release tag.
+type WorktreeState struct {
+	// Tag is the most recent matching tag, or empty if there is none.
+	Tag string
+	// Commits is the number of commits since Tag (or since the first commit).
+	Commits int
+	// Hash is the abbreviated hash of HEAD.
+	Hash string
+	// Dirty reports uncommitted changes to tracked files.
+	Dirty bool
+}
+
+// WorktreeReader is implemented by git readers that can describe HEAD
+// relative to the most recent tag.
+type WorktreeReader interface {
+	// DescribeWorktree describes HEAD relative to the most recent tag matching
+	// the glob pattern.
+	DescribeWorktree(ctx context.Context, pattern string) (WorktreeState, error)
+}
+
+// DevVersionOptions configures development versions.
+type DevVersionOptions struct {
+	// FormatTag formats the release tag of a version, such as the tag
+	// manager's FormatTagName; nil formats "v" + version.
+	FormatTag func(SemVersion) string
+	// Template renders "pre-release[+build]"; empty uses DefaultDevTemplate.
+	Template string
+}
+
+// DevTemplateData is the data available to dev version templates.
+type DevTemplateData struct {
+	// Version is major.minor.patch of the base version.
+	Version string
+	// PreRelease is the pre-release of the base version, if any.
+	PreRelease string
+	// Tag is the most recent release tag, or empty.
+	Tag     string
+	Commits int
+	Hash    string
+	Dirty   bool
+}
+
+// DevVersion returns a unique, ordered development version for the version
+// file at path, based on the commits since the most recent release tag.
+func (m *VersionManager) DevVersion(ctx context.Context, path string, opts DevVersionOptions) (SemVersion, error) {
+	current, err := m.Read(path)
+	if err != nil {
+		return SemVersion{}, err
+	}
+
+	git, ok := m.git.(WorktreeReader)
+	if !ok {
+		return SemVersion{}, fmt.Errorf("development versions require git")
+	}
+	state, err := git.DescribeWorktree(ctx, m.tagPattern(opts))
+	if err != nil {
+		return SemVersion{}, err
+	}
+
+	return m.renderDevVersion(current, state, opts)
+}
+
+// RenderDevVersion builds a development version from the current version and
+// the worktree state, using the active scheme.
+//
+// If current is already tagged (FormatTag of current), the development version
+// is based on the next patch release, so it sorts after the release; at the
+// tagged commit of a clean worktree, current is returned unchanged. Otherwise
+// current is an unreleased version and is used as the base. Pre-release bases
+// keep their label, so 1.3.0-rc.1 becomes 1.3.0-rc.1.dev.N.
+func RenderDevVersion(current SemVersion, state WorktreeState, opts DevVersionOptions) (SemVersion, error) {
+	return defaultManager.renderDevVersion(current, state, opts)
+}
+
+func (m *VersionManager) renderDevVersion(current SemVersion, state WorktreeState, opts DevVersionOptions) (SemVersion, error) {
+	scheme := m.Scheme()
+
+	released := state.Tag != "" && state.Tag == m.tagName(opts, current)
+	if released && state.Commits == 0 && !state.Dirty {
+		return current, nil
+	}
+
+	// A released pre-release keeps its label: 1.3.0-rc.1 becomes 1.3.0-rc.1.dev.N.
+	base := SemVersion{Major: current.Major, Minor: current.Minor, Patch: current.Patch, PreRelease: current.PreRelease}
+	if released && current.PreRelease == "" {
+		next, err := scheme.Bump(current, "patch")
+		if err != nil {
+			return SemVersion{}, err
+		}
+		base = SemVersion{Major: next.Major, Minor: next.Minor, Patch: next.Patch}
+	}
+
+	text := opts.Template
+	if text == "" {
+		text = DefaultDevTemplate
+	}
+	tmpl, err := template.New("dev-version").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return SemVersion{}, fmt.Errorf("invalid dev version template: %w", err)
+	}
+
+	data := DevTemplateData{
+		Version:    scheme.Format(SemVersion{Major: base.Major, Minor: base.Minor, Patch: base.Patch}),
+		PreRelease: base.PreRelease,
+		Tag:        state.Tag,
+		Commits:    state.Commits,
+		Hash:       state.Hash,
+		Dirty:      state.Dirty,
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return SemVersion{}, fmt.Errorf("invalid dev version template: %w", err)
+	}
+
+	suffix := sb.String()
+	out := data.Version
+	if suffix != "" && !strings.HasPrefix(suffix, "+") {
+		out += "-"
+	}
+	out += suffix
+
+	// Parse leniently (scheme formats may be zero-padded), then require valid SemVer.
+	v, err := ParseVersion(out)
+	if err == nil {
+		_, err = ParseVersionStrict(formatSemVer(v))
+	}
+	if err != nil {
+		return SemVersion{}, &apperrors.InvalidVersionError{Version: out, Reason: "produced by the dev version template"}
+	}
+	return v, nil
+}
+
+// tagName returns the release tag of v.
+func (m *VersionManager) tagName(opts DevVersionOptions, v SemVersion) string {
+	if opts.FormatTag != nil {
+		return opts.FormatTag(v)
+	}
+	return "v" + m.Scheme().Format(v)
+}
+
+// tagPattern returns the glob matching release tags: the tag of a placeholder
+// version, with the version replaced by a wildcard starting with a digit
+// ("v[0-9]*" for "v1.2.3"). Tags not containing the version match anything.
+func (m *VersionManager) tagPattern(opts DevVersionOptions) string {
+	placeholder := SemVersion{Major: 1, Minor: 2, Patch: 3}
+	tag := m.tagName(opts, placeholder)
+	prefix, suffix, ok := strings.Cut(tag, m.Scheme().Format(placeholder))
+	if !ok {
+		return "*"
+	}
+	return globEscape(prefix) + "[0-9]*" + globEscape(suffix)
+}
+
+// globEscape escapes the glob metacharacters of s.
+func globEscape(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(`*?[\`, r) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// parseDescribe parses "git describe --tags --long --dirty" output, such as
+// "v1.2.3-7-g3f2a1c9-dirty".
+func parseDescribe(out string) (WorktreeState, error) {
+	var state WorktreeState
+
+	out, state.Dirty = strings.CutSuffix(strings.TrimSpace(out), "-dirty")
+
+	hashIdx := strings.LastIndex(out, "-g")
+	if hashIdx < 0 {
+		return WorktreeState{}, fmt.Errorf("unexpected git describe output %q", out)
+	}
+	state.Hash = out[hashIdx+2:]
+
+	rest := out[:hashIdx]
+	countIdx := strings.LastIndex(rest, "-")
+	if countIdx < 0 {
+		return WorktreeState{}, fmt.Errorf("unexpected git describe output %q", out)
+	}
+	commits, err := strconv.Atoi(rest[countIdx+1:])
+	if err != nil {
+		return WorktreeState{}, fmt.Errorf("unexpected git describe output %q", out)
+	}
+	state.Commits = commits
+	state.Tag = rest[:countIdx]
+
+	return state, nil
+}
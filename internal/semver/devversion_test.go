@@ -0,0 +1,233 @@
+package semver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/indaco/verso/internal/apperrors"
+	"github.com/indaco/verso/internal/core"
+)
+
+func TestRenderDevVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		state    WorktreeState
+		template string
+		want     string
+	}{
+		{
+			name:    "released version bumps patch",
+			current: "1.2.3",
+			state:   WorktreeState{Tag: "v1.2.3", Commits: 7, Hash: "3f2a1c9"},
+			want:    "1.2.4-dev.7+g3f2a1c9",
+		},
+		{
+			name:    "released version with dirty worktree",
+			current: "1.2.3",
+			state:   WorktreeState{Tag: "v1.2.3", Commits: 7, Hash: "3f2a1c9", Dirty: true},
+			want:    "1.2.4-dev.7+g3f2a1c9.dirty",
+		},
+		{
+			name:    "tagged commit of clean worktree",
+			current: "1.2.3",
+			state:   WorktreeState{Tag: "v1.2.3", Commits: 0, Hash: "3f2a1c9"},
+			want:    "1.2.3",
+		},
+		{
+			name:    "tagged commit of dirty worktree",
+			current: "1.2.3",
+			state:   WorktreeState{Tag: "v1.2.3", Commits: 0, Hash: "3f2a1c9", Dirty: true},
+			want:    "1.2.4-dev.0+g3f2a1c9.dirty",
+		},
+		{
+			name:    "unreleased version is kept as base",
+			current: "1.3.0",
+			state:   WorktreeState{Tag: "v1.2.3", Commits: 4, Hash: "abc1234"},
+			want:    "1.3.0-dev.4+gabc1234",
+		},
+		{
+			name:    "no tag",
+			current: "0.1.0",
+			state:   WorktreeState{Commits: 12, Hash: "abc1234"},
+			want:    "0.1.0-dev.12+gabc1234",
+		},
+		{
+			name:    "released pre-release keeps its label",
+			current: "1.3.0-rc.1",
+			state:   WorktreeState{Tag: "v1.3.0-rc.1", Commits: 2, Hash: "3f2a1c9"},
+			want:    "1.3.0-rc.1.dev.2+g3f2a1c9",
+		},
+		{
+			name:    "build metadata of current is dropped",
+			current: "1.3.0+ci.5",
+			state:   WorktreeState{Commits: 2, Hash: "3f2a1c9"},
+			want:    "1.3.0-dev.2+g3f2a1c9",
+		},
+		{
+			name:     "custom template",
+			current:  "1.2.3",
+			state:    WorktreeState{Tag: "v1.2.3", Commits: 7, Hash: "3f2a1c9"},
+			template: "snapshot.{{.Commits}}",
+			want:     "1.2.4-snapshot.7",
+		},
+		{
+			name:     "build-only template",
+			current:  "1.2.3",
+			state:    WorktreeState{Tag: "v1.2.3", Commits: 7, Hash: "3f2a1c9"},
+			template: "+{{.Hash}}",
+			want:     "1.2.4+3f2a1c9",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DevVersionOptions{Template: tt.template}
+			got, err := RenderDevVersion(mustParse(t, tt.current), tt.state, opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("RenderDevVersion() = %q, want %q", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderDevVersion_Errors(t *testing.T) {
+	state := WorktreeState{Tag: "v1.2.3", Commits: 7, Hash: "3f2a1c9"}
+
+	_, err := RenderDevVersion(mustParse(t, "1.2.3"), state, DevVersionOptions{Template: "{{.Commits"})
+	if err == nil || !strings.Contains(err.Error(), "invalid dev version template") {
+		t.Errorf("expected template parse error, got %v", err)
+	}
+
+	_, err = RenderDevVersion(mustParse(t, "1.2.3"), state, DevVersionOptions{Template: "{{.Branch}}"})
+	if err == nil || !strings.Contains(err.Error(), "invalid dev version template") {
+		t.Errorf("expected template execution error, got %v", err)
+	}
+
+	_, err = RenderDevVersion(mustParse(t, "1.2.3"), state, DevVersionOptions{Template: "dev..{{.Commits}}"})
+	var verr *apperrors.InvalidVersionError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected *apperrors.InvalidVersionError, got %v", err)
+	}
+	if verr.Version != "1.2.4-dev..7" {
+		t.Errorf("expected rendered version in error, got %q", verr.Version)
+	}
+}
+
+func TestParseDescribe(t *testing.T) {
+	tests := []struct {
+		input string
+		want  WorktreeState
+	}{
+		{"v1.2.3-7-g3f2a1c9", WorktreeState{Tag: "v1.2.3", Commits: 7, Hash: "3f2a1c9"}},
+		{"v1.2.3-0-g3f2a1c9-dirty\n", WorktreeState{Tag: "v1.2.3", Commits: 0, Hash: "3f2a1c9", Dirty: true}},
+		{"release-1.3.0-rc.1-2-gabc1234", WorktreeState{Tag: "release-1.3.0-rc.1", Commits: 2, Hash: "abc1234"}},
+	}
+
+	for _, tt := range tests {
+		got, err := parseDescribe(tt.input)
+		if err != nil {
+			t.Fatalf("parseDescribe(%q) failed: %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Errorf("parseDescribe(%q) = %+v, want %+v", tt.input, got, tt.want)
+		}
+	}
+
+	for _, input := range []string{"", "v1.2.3", "v1.2.3-x-g3f2a1c9"} {
+		if _, err := parseDescribe(input); err == nil {
+			t.Errorf("parseDescribe(%q): expected error", input)
+		}
+	}
+}
+
+func TestVersionManager_DevVersion_FormatTag(t *testing.T) {
+	mockFS := core.NewMockFileSystem()
+	mockFS.SetFile("/test/.version", []byte("1.2.3\n"))
+
+	tests := []struct {
+		name        string
+		formatTag   func(SemVersion) string
+		tag         string
+		wantPattern string
+		want        string
+	}{
+		{
+			name:        "prefix",
+			formatTag:   func(v SemVersion) string { return "release-" + v.String() },
+			tag:         "release-1.2.3",
+			wantPattern: "release-[0-9]*",
+			want:        "1.2.4-dev.2+gabc1234",
+		},
+		{
+			name:        "module tag",
+			formatTag:   func(v SemVersion) string { return "api/v" + v.String() },
+			tag:         "api/v1.2.3",
+			wantPattern: "api/v[0-9]*",
+			want:        "1.2.4-dev.2+gabc1234",
+		},
+		{
+			name:        "suffix with glob characters",
+			formatTag:   func(v SemVersion) string { return v.String() + "[stable]" },
+			tag:         "1.2.3[stable]",
+			wantPattern: `[0-9]*\[stable]`,
+			want:        "1.2.4-dev.2+gabc1234",
+		},
+		{
+			name:        "other format does not count as released",
+			formatTag:   func(v SemVersion) string { return "release-" + v.String() },
+			tag:         "v1.2.3",
+			wantPattern: "release-[0-9]*",
+			want:        "1.2.3-dev.2+gabc1234",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			git := &MockGitTagReader{Worktree: WorktreeState{Tag: tt.tag, Commits: 2, Hash: "abc1234"}}
+			got, err := NewVersionManager(mockFS, git).DevVersion(context.Background(), "/test/.version", DevVersionOptions{FormatTag: tt.formatTag})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if git.Pattern != tt.wantPattern {
+				t.Errorf("describe pattern = %q, want %q", git.Pattern, tt.wantPattern)
+			}
+			if got.String() != tt.want {
+				t.Errorf("DevVersion() = %q, want %q", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionManager_DevVersion(t *testing.T) {
+	mockFS := core.NewMockFileSystem()
+	mockFS.SetFile("/test/.version", []byte("version: 1.2.3\ncodename: Aurora\n"))
+
+	git := &MockGitTagReader{Worktree: WorktreeState{Tag: "v1.2.3", Commits: 7, Hash: "3f2a1c9"}}
+	mgr := NewVersionManager(mockFS, git)
+
+	got, err := mgr.DevVersion(context.Background(), "/test/.version", DevVersionOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.String() != "1.2.4-dev.7+g3f2a1c9" {
+		t.Errorf("DevVersion() = %q, want %q", got.String(), "1.2.4-dev.7+g3f2a1c9")
+	}
+	if git.Pattern != "v[0-9]*" {
+		t.Errorf("describe pattern = %q, want %q", git.Pattern, "v[0-9]*")
+	}
+
+	git.Err = errors.New("not a git repository")
+	if _, err := mgr.DevVersion(context.Background(), "/test/.version", DevVersionOptions{}); err == nil {
+		t.Error("expected git error")
+	}
+
+	if _, err := NewVersionManager(mockFS, nil).DevVersion(context.Background(), "/test/.version", DevVersionOptions{}); err == nil || !strings.Contains(err.Error(), "require git") {
+		t.Errorf("expected missing git error, got %v", err)
+	}
+}
@@ -0,0 +1,380 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/indaco/verso/internal/apperrors"
+)
+
+// Version dialect names accepted in configuration.
+//
+// A dialect is the native version syntax of a packaging ecosystem. Converting
+// between a SemVersion and a dialect lets verso write 1.4.0-rc.2 as 1.4.0rc2 in
+// a pyproject.toml or 1.4.0-RC2 in a pom.xml.
+const (
+	DialectSemVer = "semver"
+	DialectPEP440 = "pep440"
+	DialectMaven  = "maven"
+	DialectNuGet  = "nuget"
+)
+
+var (
+	// pep440Regex matches PEP 440 public and local versions, including the
+	// alternative spellings that PEP 440 normalizes (e.g., "1.4.0-RC.2").
+	pep440Regex = regexp.MustCompile(
+		`(?i)^v?(?:(\d+)!)?(\d+(?:\.\d+)*)` + // epoch and release segments
+			`(?:[-_.]?(alpha|a|beta|b|preview|pre|c|rc)[-_.]?(\d+)?)?` + // pre-release
+			`(?:-(\d+)|[-_.]?(post|rev|r)[-_.]?(\d+)?)?` + // post-release
+			`(?:[-_.]?(dev)[-_.]?(\d+)?)?` + // development release
+			`(?:\+([a-z0-9]+(?:[-_.][a-z0-9]+)*))?$`, // local version
+	)
+
+	// mavenRegex matches Maven versions: up to three numeric segments and an optional qualifier.
+	mavenRegex = regexp.MustCompile(`^(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:-(.+))?$`)
+
+	// mavenRCRegex matches Maven release candidate qualifiers (e.g., "RC2", "cr-1").
+	mavenRCRegex = regexp.MustCompile(`(?i)^(?:rc|cr)[-.]?(\d*)$`)
+
+	// nugetRegex matches NuGet versions, which allow an optional fourth (revision) segment.
+	nugetRegex = regexp.MustCompile(
+		`^v?(\d+)\.(\d+)(?:\.(\d+))?(?:\.(\d+))?` +
+			`(?:-([0-9A-Za-z\-\.]+))?` +
+			`(?:\+([0-9A-Za-z\-\.]+))?$`,
+	)
+
+	// labelNumberRegex splits a pre-release identifier such as "rc2" into label and number.
+	labelNumberRegex = regexp.MustCompile(`^([A-Za-z]+)(\d*)$`)
+)
+
+// pep440Phases maps pre-release labels to their normalized PEP 440 spelling.
+var pep440Phases = map[string]string{
+	"alpha":   "a",
+	"a":       "a",
+	"beta":    "b",
+	"b":       "b",
+	"rc":      "rc",
+	"c":       "rc",
+	"pre":     "rc",
+	"preview": "rc",
+}
+
+// pep440Labels maps normalized PEP 440 phases back to SemVer pre-release labels.
+var pep440Labels = map[string]string{
+	"a":  "alpha",
+	"b":  "beta",
+	"rc": "rc",
+}
+
+// FormatDialect renders v in the native syntax of the given dialect.
+// An empty dialect or "semver" returns v.String().
+//
+// Build metadata becomes a PEP 440 local version and is dropped for Maven,
+// which has no equivalent. Pre-release labels that a dialect cannot express
+// (e.g., "1.0.0-foo" in PEP 440) return an error.
+func FormatDialect(v SemVersion, dialect string) (string, error) {
+	switch strings.ToLower(dialect) {
+	case "", DialectSemVer:
+		return v.String(), nil
+	case DialectPEP440:
+		return formatPEP440(v)
+	case DialectMaven:
+		return formatMaven(v), nil
+	case DialectNuGet:
+		return formatNuGet(v), nil
+	default:
+		return "", unknownDialectError(dialect)
+	}
+}
+
+// ParseDialect parses a version written in the native syntax of the given dialect.
+// An empty dialect or "semver" uses ParseVersion.
+func ParseDialect(s, dialect string) (SemVersion, error) {
+	s = strings.TrimSpace(s)
+
+	var (
+		v   SemVersion
+		err error
+	)
+	switch strings.ToLower(dialect) {
+	case "", DialectSemVer:
+		return ParseVersion(s)
+	case DialectPEP440:
+		v, err = parsePEP440(s)
+	case DialectMaven:
+		v, err = parseMaven(s)
+	case DialectNuGet:
+		v, err = parseNuGet(s)
+	default:
+		return SemVersion{}, unknownDialectError(dialect)
+	}
+	if err != nil {
+		return SemVersion{}, err
+	}
+
+	// Whatever the dialect accepted must still be a valid SemVer.
+	if _, err := ParseVersionStrict(formatSemVer(v)); err != nil {
+		return SemVersion{}, &apperrors.InvalidVersionError{
+			Version: s,
+			Reason:  fmt.Sprintf("%s version has no SemVer equivalent", dialect),
+		}
+	}
+	return v, nil
+}
+
+func unknownDialectError(dialect string) error {
+	return fmt.Errorf("unknown version dialect %q (expected %q, %q, %q or %q)",
+		dialect, DialectSemVer, DialectPEP440, DialectMaven, DialectNuGet)
+}
+
+// formatCore renders major.minor.patch without scheme-specific padding.
+func formatCore(v SemVersion) string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// splitPreRelease splits a pre-release into (label, number) pairs, accepting both
+// "rc.2" and "rc2". A number is empty when the label has none.
+func splitPreRelease(pre string) ([][2]string, bool) {
+	var pairs [][2]string
+	for id := range strings.SplitSeq(pre, ".") {
+		if _, err := strconv.Atoi(id); err == nil {
+			if len(pairs) == 0 || pairs[len(pairs)-1][1] != "" {
+				return nil, false
+			}
+			pairs[len(pairs)-1][1] = id
+			continue
+		}
+		m := labelNumberRegex.FindStringSubmatch(id)
+		if m == nil {
+			return nil, false
+		}
+		pairs = append(pairs, [2]string{strings.ToLower(m[1]), m[2]})
+	}
+	return pairs, true
+}
+
+// formatPEP440 renders v as a normalized PEP 440 version.
+// Supported pre-releases are an alpha, beta or rc phase, a dev release, or a
+// phase followed by a dev release (e.g., "rc.1.dev.3" becomes "rc1.dev3").
+func formatPEP440(v SemVersion) (string, error) {
+	out := formatCore(v)
+
+	if v.PreRelease != "" {
+		unsupported := &apperrors.InvalidVersionError{
+			Version: v.String(),
+			Reason:  fmt.Sprintf("pre-release %q cannot be represented in PEP 440 (use alpha, beta, rc or dev)", v.PreRelease),
+		}
+
+		pairs, ok := splitPreRelease(v.PreRelease)
+		if !ok || len(pairs) > 2 {
+			return "", unsupported
+		}
+		for i, pair := range pairs {
+			label, num := pair[0], pair[1]
+			if num == "" {
+				num = "0"
+			}
+			if phase, ok := pep440Phases[label]; ok && i == 0 {
+				out += phase + num
+				continue
+			}
+			if label == "dev" && i == len(pairs)-1 {
+				out += ".dev" + num
+				continue
+			}
+			return "", unsupported
+		}
+	}
+
+	if v.Build != "" {
+		out += "+" + strings.ToLower(strings.ReplaceAll(v.Build, "-", "."))
+	}
+	return out, nil
+}
+
+// parsePEP440 parses a PEP 440 version. Epochs, post-releases and releases with
+// more than three non-zero segments have no SemVer equivalent and are rejected.
+func parsePEP440(s string) (SemVersion, error) {
+	m := pep440Regex.FindStringSubmatch(s)
+	if m == nil {
+		return SemVersion{}, &apperrors.InvalidVersionError{Version: s, Reason: "not a valid PEP 440 version"}
+	}
+	invalid := func(reason string) error {
+		return &apperrors.InvalidVersionError{Version: s, Reason: reason}
+	}
+
+	if m[1] != "" && strings.TrimLeft(m[1], "0") != "" {
+		return SemVersion{}, invalid("PEP 440 epochs have no SemVer equivalent")
+	}
+	if m[5] != "" || m[6] != "" {
+		return SemVersion{}, invalid("PEP 440 post-releases have no SemVer equivalent")
+	}
+
+	release, err := parseReleaseSegments(m[2])
+	if err != nil {
+		return SemVersion{}, invalid(err.Error())
+	}
+	v := SemVersion{Major: release[0], Minor: release[1], Patch: release[2]}
+
+	var pre []string
+	if m[3] != "" {
+		pre = append(pre, pep440Labels[pep440Phases[strings.ToLower(m[3])]], numberOrZero(m[4]))
+	}
+	if m[8] != "" {
+		pre = append(pre, "dev", numberOrZero(m[9]))
+	}
+	v.PreRelease = strings.Join(pre, ".")
+	v.Build = strings.ToLower(strings.NewReplacer("_", ".", "-", ".").Replace(m[10]))
+
+	return v, nil
+}
+
+// parseReleaseSegments parses dot-separated release numbers into major, minor
+// and patch. Missing segments are zero; extra segments must be zero.
+func parseReleaseSegments(s string) ([3]int, error) {
+	var release [3]int
+	for i, seg := range strings.Split(s, ".") {
+		n, err := strconv.Atoi(seg)
+		if err != nil {
+			return release, fmt.Errorf("release segment %q is out of range", seg)
+		}
+		if i >= 3 {
+			if n != 0 {
+				return release, fmt.Errorf("release has more than three segments")
+			}
+			continue
+		}
+		release[i] = n
+	}
+	return release, nil
+}
+
+// numberOrZero returns s, or "0" when s is empty, with leading zeros removed.
+func numberOrZero(s string) string {
+	n, _ := strconv.Atoi(s)
+	return strconv.Itoa(n)
+}
+
+// formatMaven renders v as a Maven version. Release candidates use the "RC<n>"
+// qualifier, a trailing "snapshot" identifier becomes "-SNAPSHOT", and other
+// identifiers are joined with dashes (e.g., "alpha.1" becomes "alpha-1").
+func formatMaven(v SemVersion) string {
+	out := formatCore(v)
+	if v.PreRelease == "" {
+		return out
+	}
+
+	ids := strings.Split(v.PreRelease, ".")
+	snapshot := strings.EqualFold(ids[len(ids)-1], "snapshot")
+	if snapshot {
+		ids = ids[:len(ids)-1]
+	}
+
+	if len(ids) > 0 {
+		qualifier := strings.Join(ids, "-")
+		if m := mavenRCRegex.FindStringSubmatch(qualifier); m != nil {
+			qualifier = "RC" + m[1]
+		}
+		out += "-" + qualifier
+	}
+	if snapshot {
+		out += "-SNAPSHOT"
+	}
+	return out
+}
+
+// parseMaven parses a Maven version. The "final", "ga" and "release" qualifiers
+// denote a release; other qualifiers become the pre-release.
+func parseMaven(s string) (SemVersion, error) {
+	m := mavenRegex.FindStringSubmatch(s)
+	if m == nil {
+		return SemVersion{}, &apperrors.InvalidVersionError{Version: s, Reason: "not a valid Maven version"}
+	}
+
+	var release [3]int
+	for i, seg := range m[1:4] {
+		if seg == "" {
+			continue
+		}
+		n, err := strconv.Atoi(seg)
+		if err != nil {
+			return SemVersion{}, &apperrors.InvalidVersionError{Version: s, Reason: fmt.Sprintf("segment %q is out of range", seg)}
+		}
+		release[i] = n
+	}
+	v := SemVersion{Major: release[0], Minor: release[1], Patch: release[2]}
+
+	qualifier := m[4]
+	var suffix string
+	if upper := strings.ToUpper(qualifier); upper == "SNAPSHOT" || strings.HasSuffix(upper, "-SNAPSHOT") {
+		suffix = "SNAPSHOT"
+		qualifier = strings.TrimSuffix(qualifier[:len(qualifier)-len("SNAPSHOT")], "-")
+	}
+
+	var pre []string
+	switch strings.ToLower(qualifier) {
+	case "", "final", "ga", "release":
+	default:
+		if rc := mavenRCRegex.FindStringSubmatch(qualifier); rc != nil {
+			pre = append(pre, "rc")
+			if rc[1] != "" {
+				pre = append(pre, numberOrZero(rc[1]))
+			}
+		} else {
+			pre = append(pre, strings.NewReplacer("-", ".", "_", ".").Replace(qualifier))
+		}
+	}
+	if suffix != "" {
+		pre = append(pre, suffix)
+	}
+	v.PreRelease = strings.Join(pre, ".")
+
+	return v, nil
+}
+
+// formatNuGet renders v as a NuGet version. NuGet follows SemVer 2.0.0, so only
+// scheme-specific padding is removed.
+func formatNuGet(v SemVersion) string {
+	out := formatCore(v)
+	if v.PreRelease != "" {
+		out += "-" + v.PreRelease
+	}
+	if v.Build != "" {
+		out += "+" + v.Build
+	}
+	return out
+}
+
+// parseNuGet parses a NuGet version. A non-zero fourth (revision) segment has
+// no SemVer equivalent and is rejected.
+func parseNuGet(s string) (SemVersion, error) {
+	m := nugetRegex.FindStringSubmatch(s)
+	if m == nil {
+		return SemVersion{}, &apperrors.InvalidVersionError{Version: s, Reason: "not a valid NuGet version"}
+	}
+
+	var segments [4]int
+	for i, seg := range m[1:5] {
+		if seg == "" {
+			continue
+		}
+		n, err := strconv.Atoi(seg)
+		if err != nil {
+			return SemVersion{}, &apperrors.InvalidVersionError{Version: s, Reason: fmt.Sprintf("segment %q is out of range", seg)}
+		}
+		segments[i] = n
+	}
+	if segments[3] != 0 {
+		return SemVersion{}, &apperrors.InvalidVersionError{Version: s, Reason: "NuGet revision segment has no SemVer equivalent"}
+	}
+
+	return SemVersion{
+		Major:      segments[0],
+		Minor:      segments[1],
+		Patch:      segments[2],
+		PreRelease: m[5],
+		Build:      m[6],
+	}, nil
+}
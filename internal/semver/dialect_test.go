@@ -0,0 +1,179 @@
+package semver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/indaco/verso/internal/apperrors"
+)
+
+func TestFormatDialect(t *testing.T) {
+	tests := []struct {
+		version string
+		dialect string
+		want    string
+	}{
+		{"1.4.0", "", "1.4.0"},
+		{"1.4.0-rc.2+ci.7", DialectSemVer, "1.4.0-rc.2+ci.7"},
+
+		{"1.4.0", DialectPEP440, "1.4.0"},
+		{"1.4.0-rc.2", DialectPEP440, "1.4.0rc2"},
+		{"1.4.0-alpha.1", DialectPEP440, "1.4.0a1"},
+		{"1.4.0-beta", DialectPEP440, "1.4.0b0"},
+		{"1.4.0-RC3", DialectPEP440, "1.4.0rc3"},
+		{"1.4.0-dev.5", DialectPEP440, "1.4.0.dev5"},
+		{"1.4.0-rc.1.dev.3", DialectPEP440, "1.4.0rc1.dev3"},
+		{"1.4.0+Build-7", DialectPEP440, "1.4.0+build.7"},
+
+		{"1.4.0", DialectMaven, "1.4.0"},
+		{"1.4.0-rc.2", DialectMaven, "1.4.0-RC2"},
+		{"1.4.0-rc", DialectMaven, "1.4.0-RC"},
+		{"1.4.0-SNAPSHOT", DialectMaven, "1.4.0-SNAPSHOT"},
+		{"1.4.0-rc.2.snapshot", DialectMaven, "1.4.0-RC2-SNAPSHOT"},
+		{"1.4.0-alpha.1+ci.7", DialectMaven, "1.4.0-alpha-1"},
+
+		{"1.4.0-rc.2+ci.7", DialectNuGet, "1.4.0-rc.2+ci.7"},
+		{"1.4.0-rc2", "NuGet", "1.4.0-rc2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect+"/"+tt.version, func(t *testing.T) {
+			got, err := FormatDialect(mustParse(t, tt.version), tt.dialect)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatDialect(%s, %q) = %q, want %q", tt.version, tt.dialect, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatDialect_Errors(t *testing.T) {
+	tests := []struct {
+		version string
+		dialect string
+		reason  string
+	}{
+		{"1.4.0-foo.1", DialectPEP440, `pre-release "foo.1" cannot be represented in PEP 440`},
+		{"1.4.0-dev.1.rc.2", DialectPEP440, "cannot be represented in PEP 440"},
+		{"1.4.0-rc.1.2", DialectPEP440, "cannot be represented in PEP 440"},
+	}
+
+	for _, tt := range tests {
+		_, err := FormatDialect(mustParse(t, tt.version), tt.dialect)
+		var verr *apperrors.InvalidVersionError
+		if !errors.As(err, &verr) {
+			t.Errorf("%s: expected *apperrors.InvalidVersionError, got %v", tt.version, err)
+			continue
+		}
+		if !strings.Contains(verr.Reason, tt.reason) {
+			t.Errorf("%s: expected reason containing %q, got %q", tt.version, tt.reason, verr.Reason)
+		}
+	}
+
+	if _, err := FormatDialect(SemVersion{Major: 1}, "cargo"); err == nil || !strings.Contains(err.Error(), `unknown version dialect "cargo"`) {
+		t.Errorf("expected unknown dialect error, got %v", err)
+	}
+}
+
+func TestParseDialect(t *testing.T) {
+	tests := []struct {
+		input   string
+		dialect string
+		want    SemVersion
+	}{
+		{"v1.4.0-rc.2", "", SemVersion{Major: 1, Minor: 4, PreRelease: "rc.2"}},
+
+		{"1.4.0rc2", DialectPEP440, SemVersion{Major: 1, Minor: 4, PreRelease: "rc.2"}},
+		{"1.4.0-RC.2", DialectPEP440, SemVersion{Major: 1, Minor: 4, PreRelease: "rc.2"}},
+		{"1.4a1", DialectPEP440, SemVersion{Major: 1, Minor: 4, PreRelease: "alpha.1"}},
+		{"1.4.0.0b", DialectPEP440, SemVersion{Major: 1, Minor: 4, PreRelease: "beta.0"}},
+		{"1.4.0preview1", DialectPEP440, SemVersion{Major: 1, Minor: 4, PreRelease: "rc.1"}},
+		{"1.4.0rc1.dev3", DialectPEP440, SemVersion{Major: 1, Minor: 4, PreRelease: "rc.1.dev.3"}},
+		{"0!1.4.0.dev05", DialectPEP440, SemVersion{Major: 1, Minor: 4, PreRelease: "dev.5"}},
+		{"1.4.0+ubuntu_1", DialectPEP440, SemVersion{Major: 1, Minor: 4, Build: "ubuntu.1"}},
+
+		{"1.4.0-RC2", DialectMaven, SemVersion{Major: 1, Minor: 4, PreRelease: "rc.2"}},
+		{"1.4-SNAPSHOT", DialectMaven, SemVersion{Major: 1, Minor: 4, PreRelease: "SNAPSHOT"}},
+		{"1.4.0-RC2-SNAPSHOT", DialectMaven, SemVersion{Major: 1, Minor: 4, PreRelease: "rc.2.SNAPSHOT"}},
+		{"1.4.0-alpha-1", DialectMaven, SemVersion{Major: 1, Minor: 4, PreRelease: "alpha.1"}},
+		{"1.4.0-Final", DialectMaven, SemVersion{Major: 1, Minor: 4}},
+		{"2", DialectMaven, SemVersion{Major: 2}},
+
+		{"1.4.0-rc.2+ci.7", DialectNuGet, SemVersion{Major: 1, Minor: 4, PreRelease: "rc.2", Build: "ci.7"}},
+		{"1.04.0.0", DialectNuGet, SemVersion{Major: 1, Minor: 4}},
+		{"1.4", DialectNuGet, SemVersion{Major: 1, Minor: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect+"/"+tt.input, func(t *testing.T) {
+			got, err := ParseDialect(tt.input, tt.dialect)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDialect(%q, %q) = %+v, want %+v", tt.input, tt.dialect, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDialect_Errors(t *testing.T) {
+	tests := []struct {
+		input   string
+		dialect string
+		reason  string
+	}{
+		{"1.4.0rc2!", DialectPEP440, "not a valid PEP 440 version"},
+		{"1!1.4.0", DialectPEP440, "epochs have no SemVer equivalent"},
+		{"1.4.0.post1", DialectPEP440, "post-releases have no SemVer equivalent"},
+		{"1.4.0-1", DialectPEP440, "post-releases have no SemVer equivalent"},
+		{"1.4.0.1", DialectPEP440, "more than three segments"},
+		{"1.4.0-", DialectMaven, "not a valid Maven version"},
+		{"1.4.0-alpha-01", DialectMaven, "maven version has no SemVer equivalent"},
+		{"1.4.0.1", DialectNuGet, "revision segment has no SemVer equivalent"},
+		{"1.4.0-rc..1", DialectNuGet, "nuget version has no SemVer equivalent"},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseDialect(tt.input, tt.dialect)
+		var verr *apperrors.InvalidVersionError
+		if !errors.As(err, &verr) {
+			t.Errorf("%s %q: expected *apperrors.InvalidVersionError, got %v", tt.dialect, tt.input, err)
+			continue
+		}
+		if !strings.Contains(verr.Reason, tt.reason) {
+			t.Errorf("%s %q: expected reason containing %q, got %q", tt.dialect, tt.input, tt.reason, verr.Reason)
+		}
+	}
+
+	if _, err := ParseDialect("1.0.0", "cargo"); err == nil {
+		t.Error("expected error for unknown dialect")
+	}
+}
+
+func TestDialect_RoundTrip(t *testing.T) {
+	versions := []string{"1.4.0", "1.4.0-alpha.1", "1.4.0-beta.2", "1.4.0-rc.3", "1.4.0-dev.1", "1.4.0-rc.1.dev.2"}
+
+	for _, dialect := range []string{DialectPEP440, DialectMaven, DialectNuGet} {
+		for _, s := range versions {
+			if dialect != DialectPEP440 && strings.Contains(s, "dev") {
+				continue
+			}
+			v := mustParse(t, s)
+			native, err := FormatDialect(v, dialect)
+			if err != nil {
+				t.Fatalf("%s: FormatDialect(%s) failed: %v", dialect, s, err)
+			}
+			back, err := ParseDialect(native, dialect)
+			if err != nil {
+				t.Fatalf("%s: ParseDialect(%q) failed: %v", dialect, native, err)
+			}
+			if Compare(back, v) != 0 {
+				t.Errorf("%s: %s -> %q -> %s, want %s", dialect, s, native, back, s)
+			}
+		}
+	}
+}
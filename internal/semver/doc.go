@@ -39,11 +39,55 @@
 //	v2, _ := semver.BumpByLabel(v, "minor")
 //	fmt.Println(v2) // 1.3.0
 //
+// Compare versions by SemVer 2.0.0 precedence (build metadata is ignored):
+//
+//	a, _ := semver.ParseVersion("1.0.0-rc.1")
+//	b, _ := semver.ParseVersion("1.0.0")
+//	fmt.Println(a.Less(b))             // true
+//	fmt.Println(semver.Compare(b, a)) // 1
+//
+// Check a version against a constraint expression (npm/Cargo style ranges):
+//
+//	c, _ := semver.ParseConstraints("^1.2 || >=2.0.0 <2.5.0")
+//	v, _ := semver.ParseVersion("1.4.0")
+//	fmt.Println(c.Check(v)) // true
+//
 // Read and write version files:
 //
 //	v, err := semver.ReadVersion(".version")
 //	semver.SaveVersion(".version", v)
 //
+// Version files are either a plain version string or a structured YAML/JSON
+// document with release metadata (see VersionFile). Reading detects the
+// variant; saving keeps it, along with keys verso does not know:
+//
+//	f, _ := semver.ReadVersionFile(".version")
+//	fmt.Println(f.Version, f.ReleaseDate, f.Commit)
+//
+// Render a version in the native syntax of another ecosystem:
+//
+//	v, _ := semver.ParseVersion("1.4.0-rc.2")
+//	s, _ := semver.FormatDialect(v, semver.DialectPEP440)
+//	fmt.Println(s) // 1.4.0rc2
+//
+// Derive a development version from git (commits since the release tag,
+// formatted by the tag manager):
+//
+//	v, _ := semver.DevVersion(ctx, ".version", semver.DevVersionOptions{FormatTag: tm.FormatTagName})
+//	fmt.Println(v) // 1.2.4-dev.7+g3f2a1c9
+//
+// # Version Schemes
+//
+// Bumping and rendering are delegated to a Scheme. SemVerScheme is the
+// default; CalVerScheme implements calendar versions such as YYYY.MM.MICRO,
+// stored in the same SemVersion fields. The active scheme is selected once at
+// startup with SetDefaultScheme:
+//
+//	scheme, _ := semver.NewScheme("calver", "YY.0M.MICRO")
+//	semver.SetDefaultScheme(scheme)
+//	v, _ := semver.Next(semver.SemVersion{Major: 26, Minor: 3, Patch: 4})
+//	fmt.Println(v) // 26.04.0 in April 2026, 26.03.5 in March 2026
+//
 // # Thread Safety
 //
 // The parsing functions (ParseVersion, BumpByLabel, BumpNext, etc.) are
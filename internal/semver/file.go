@@ -1,5 +1,11 @@
 package semver
 
+import (
+	"context"
+
+	"github.com/indaco/verso/internal/core"
+)
+
 // VersionFilePerm defines secure file permissions for version files (owner read/write only).
 const VersionFilePerm = 0600
 
@@ -10,6 +16,44 @@ func ReadVersion(path string) (SemVersion, error) {
 	return defaultManager.Read(path)
 }
 
+// ReadVersionStrict reads a version file and parses it with ParseVersionStrict.
+// This is a convenience function that uses the default VersionManager.
+// For better testability, use VersionManager.ReadStrict() instead.
+func ReadVersionStrict(path string) (SemVersion, error) {
+	return defaultManager.ReadStrict(path)
+}
+
+// ReadVersionFile reads a version file, including the metadata of the
+// structured YAML and JSON variants.
+// This is a convenience function that uses the default VersionManager.
+// For better testability, use VersionManager.ReadFile() instead.
+func ReadVersionFile(path string) (*VersionFile, error) {
+	return defaultManager.ReadFile(path)
+}
+
+// SaveVersionFile writes a version file in its format.
+// This is a convenience function that uses the default VersionManager.
+// For better testability, use VersionManager.SaveFile() instead.
+func SaveVersionFile(path string, file *VersionFile) error {
+	return defaultManager.SaveFile(path, file)
+}
+
+// LockVersionFile takes the advisory lock on a version file for a
+// read-modify-write cycle spanning several calls (e.g., a whole bump).
+// This is a convenience function that uses the default VersionManager.
+// For better testability, use VersionManager.Lock() instead.
+func LockVersionFile(path string) (*core.FileLock, error) {
+	return defaultManager.Lock(path)
+}
+
+// DevVersion returns a development version for the version file at path,
+// such as 1.2.4-dev.7+g3f2a1c9, based on the commits since the last release tag.
+// This is a convenience function that uses the default VersionManager.
+// For better testability, use VersionManager.DevVersion() instead.
+func DevVersion(ctx context.Context, path string, opts DevVersionOptions) (SemVersion, error) {
+	return defaultManager.DevVersion(ctx, path, opts)
+}
+
 // SaveVersion writes a SemVersion to the given file path.
 // This is a convenience function that uses the default VersionManager.
 // For better testability, use VersionManager.Save() instead.
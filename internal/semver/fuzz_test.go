@@ -112,6 +112,39 @@ func verifyConsistency(t *testing.T, input string, v SemVersion, err error) {
 	}
 }
 
+// FuzzParseVersionStrict tests the strict parser with random inputs.
+// Any accepted input must be accepted by ParseVersion and round-trip exactly.
+// Run with: go test -fuzz=FuzzParseVersionStrict -fuzztime=30s
+func FuzzParseVersionStrict(f *testing.F) {
+	seeds := []string{
+		"1.2.3",
+		"1.0.0-alpha.1+build.5",
+		"01.2.3",
+		"1.2.3-rc.01",
+		"1.2.3-rc..1",
+		"v1.2.3",
+		"1.2.3+",
+	}
+
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		v, err := ParseVersionStrict(input)
+		if err != nil {
+			return
+		}
+
+		if v.String() != input {
+			t.Errorf("strict roundtrip mismatch: %q -> %q", input, v.String())
+		}
+		if _, err := ParseVersion(input); err != nil {
+			t.Errorf("strict parser accepted %q but ParseVersion rejected it: %v", input, err)
+		}
+	})
+}
+
 // FuzzIncrementPreRelease tests the pre-release increment logic with random inputs.
 func FuzzIncrementPreRelease(f *testing.F) {
 	seeds := []struct {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/indaco/verso/internal/core"
@@ -13,8 +14,9 @@ import (
 // VersionManager handles version file operations with injected dependencies.
 // This enables proper testing without global state mutation.
 type VersionManager struct {
-	fs  core.FileSystem
-	git GitTagReader
+	fs     core.FileSystem
+	git    GitTagReader
+	scheme Scheme
 }
 
 // GitTagReader abstracts git tag reading for testability.
@@ -23,42 +25,146 @@ type GitTagReader interface {
 	DescribeTags(ctx context.Context) (string, error)
 }
 
+// HeadCommitReader is implemented by git readers that can resolve the current
+// commit. Structured version files record it when the version changes.
+type HeadCommitReader interface {
+	// HeadCommit returns the abbreviated hash of HEAD.
+	HeadCommit(ctx context.Context) (string, error)
+}
+
 // NewVersionManager creates a VersionManager with the given dependencies.
 func NewVersionManager(fs core.FileSystem, git GitTagReader) *VersionManager {
 	return &VersionManager{fs: fs, git: git}
 }
 
+// WithScheme sets the version scheme used by this manager and returns it.
+// Managers without an explicit scheme use the active default (see SetDefaultScheme).
+func (m *VersionManager) WithScheme(s Scheme) *VersionManager {
+	m.scheme = s
+	return m
+}
+
+// Scheme returns the version scheme used by this manager.
+func (m *VersionManager) Scheme() Scheme {
+	if m.scheme != nil {
+		return m.scheme
+	}
+	return defaultScheme
+}
+
 // DefaultVersionManager returns a VersionManager using real OS and git.
 func DefaultVersionManager() *VersionManager {
 	return NewVersionManager(core.NewOSFileSystem(), &realGitClient{})
 }
 
 // Read reads a version from the given path.
+// Both the plain and the structured (YAML or JSON) variants are supported.
 func (m *VersionManager) Read(path string) (SemVersion, error) {
 	data, err := m.fs.ReadFile(path)
 	if err != nil {
 		return SemVersion{}, err
 	}
-	return ParseVersion(string(data))
+	file, _, err := parseVersionFile(data)
+	if err != nil {
+		return SemVersion{}, err
+	}
+	return file.Version, nil
+}
+
+// ReadStrict reads and parses the version from the given path, rejecting
+// anything that is not an exact version of the manager's scheme
+// (ParseVersionStrict for SemVer).
+func (m *VersionManager) ReadStrict(path string) (SemVersion, error) {
+	data, err := m.fs.ReadFile(path)
+	if err != nil {
+		return SemVersion{}, err
+	}
+	file, raw, err := parseVersionFile(data)
+	if err != nil && (file == nil || file.IsStructured()) {
+		return SemVersion{}, err
+	}
+	return m.Scheme().ParseStrict(raw)
+}
+
+// ReadFile reads the version file at path, including the metadata of the
+// structured variants.
+func (m *VersionManager) ReadFile(path string) (*VersionFile, error) {
+	data, err := m.fs.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file, _, err := parseVersionFile(data)
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// Lock takes the advisory lock on a version file (a ".lock" file next to it)
+// for a read-modify-write cycle. Callers must release it when done. Concurrent
+// processes wait up to core.LockTimeout and then fail with *apperrors.LockError.
+func (m *VersionManager) Lock(path string) (*core.FileLock, error) {
+	return core.AcquireLock(m.fs, path)
 }
 
 // Save writes a version to the given path.
+// If the file is a structured variant, its format, metadata and unknown keys
+// are kept; when the version changes, the release date and commit are updated.
 func (m *VersionManager) Save(path string, version SemVersion) error {
+	lock, err := m.Lock(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = lock.Release() }()
+
+	file := &VersionFile{Format: FormatPlain}
+
+	if existing, err := m.ReadFile(path); err == nil && existing.IsStructured() {
+		file = existing
+		if existing.Version != version {
+			m.stampRelease(file)
+		}
+	}
+
+	file.Version = version
+	return m.SaveFile(path, file)
+}
+
+// SaveFile writes a version file in its format, replacing it atomically.
+func (m *VersionManager) SaveFile(path string, file *VersionFile) error {
+	data, err := file.encode(m.Scheme().Format)
+	if err != nil {
+		return fmt.Errorf("failed to encode version file: %w", err)
+	}
+
 	// Ensure parent directory exists
 	if err := m.fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
 		return err
 	}
-	return m.fs.WriteFile(path, []byte(version.String()+"\n"), VersionFilePerm)
+	return m.fs.WriteFile(path, data, VersionFilePerm)
+}
+
+// stampRelease records the release date and, when git is available, the
+// commit the release was cut from.
+func (m *VersionManager) stampRelease(file *VersionFile) {
+	file.ReleaseDate = timeNow().Format(ReleaseDateLayout)
+
+	if git, ok := m.git.(HeadCommitReader); ok {
+		if commit, err := git.HeadCommit(context.Background()); err == nil {
+			file.Commit = strings.TrimSpace(commit)
+		}
+	}
 }
 
 // Initialize creates a version file if it doesn't exist.
-// It tries to use the latest git tag, or falls back to 0.1.0.
+// It tries to use the latest git tag, or falls back to the scheme's initial
+// version (0.1.0 for SemVer).
 func (m *VersionManager) Initialize(ctx context.Context, path string) error {
 	if _, err := m.fs.Stat(path); err == nil {
 		return nil // Already exists
 	}
 
-	version := SemVersion{Major: 0, Minor: 1, Patch: 0} // Default
+	version := m.Scheme().Initial()
 
 	if m.git != nil {
 		tag, err := m.git.DescribeTags(ctx)
@@ -89,34 +195,37 @@ func (m *VersionManager) InitializeWithFeedback(ctx context.Context, path string
 
 // Update reads, bumps, and saves the version.
 func (m *VersionManager) Update(path string, bumpType string, pre string, meta string, preserve bool) error {
+	lock, err := m.Lock(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = lock.Release() }()
+
 	version, err := m.Read(path)
 	if err != nil {
 		return err
 	}
 
+	var next SemVersion
 	switch bumpType {
-	case "patch":
-		version.Patch++
-	case "minor":
-		version.Minor++
-		version.Patch = 0
-	case "major":
-		version.Major++
-		version.Minor = 0
-		version.Patch = 0
+	case "patch", "minor", "major":
+		next, err = m.Scheme().Bump(version, bumpType)
+		if err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("invalid bump type: %s", bumpType)
 	}
 
-	version.PreRelease = pre
+	next.PreRelease = pre
 
 	if meta != "" {
-		version.Build = meta
-	} else if !preserve {
-		version.Build = ""
+		next.Build = meta
+	} else if preserve {
+		next.Build = version.Build
 	}
 
-	return m.Save(path, version)
+	return m.Save(path, next)
 }
 
 // UpdatePreRelease updates only the pre-release portion of the version.
@@ -124,6 +233,12 @@ func (m *VersionManager) Update(path string, bumpType string, pre string, meta s
 // If label is empty, it increments the existing pre-release number.
 // Returns an error if no label is provided and the version has no pre-release.
 func (m *VersionManager) UpdatePreRelease(path string, label string, meta string, preserve bool) error {
+	lock, err := m.Lock(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = lock.Release() }()
+
 	version, err := m.Read(path)
 	if err != nil {
 		return err
@@ -199,21 +314,81 @@ func (g *realGitClient) DescribeTags(ctx context.Context) (string, error) {
 	return string(output), nil
 }
 
+func (g *realGitClient) HeadCommit(ctx context.Context) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--short", "HEAD")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+// DescribeWorktree runs "git describe" against tags matching the glob pattern.
+// Without a matching tag, all commits reachable from HEAD are counted.
+func (g *realGitClient) DescribeWorktree(ctx context.Context, pattern string) (WorktreeState, error) {
+	out, err := exec.CommandContext(ctx, "git", "describe", "--tags", "--long", "--dirty", "--abbrev=7", "--match", pattern).Output()
+	if err == nil {
+		return parseDescribe(string(out))
+	}
+
+	hash, err := exec.CommandContext(ctx, "git", "rev-parse", "--short=7", "HEAD").Output()
+	if err != nil {
+		return WorktreeState{}, fmt.Errorf("failed to resolve HEAD (not a git repository or no commits): %w", err)
+	}
+	count, err := exec.CommandContext(ctx, "git", "rev-list", "--count", "HEAD").Output()
+	if err != nil {
+		return WorktreeState{}, fmt.Errorf("failed to count commits: %w", err)
+	}
+	commits, err := strconv.Atoi(strings.TrimSpace(string(count)))
+	if err != nil {
+		return WorktreeState{}, fmt.Errorf("failed to count commits: %w", err)
+	}
+	status, err := exec.CommandContext(ctx, "git", "status", "--porcelain", "--untracked-files=no").Output()
+	if err != nil {
+		return WorktreeState{}, fmt.Errorf("failed to read worktree status: %w", err)
+	}
+
+	return WorktreeState{
+		Commits: commits,
+		Hash:    strings.TrimSpace(string(hash)),
+		Dirty:   len(strings.TrimSpace(string(status))) > 0,
+	}, nil
+}
+
 // MockGitTagReader is a test helper for mocking git tag reading.
 type MockGitTagReader struct {
-	Tag string
-	Err error
+	Tag      string
+	Commit   string
+	Worktree WorktreeState
+	Err      error
+
+	// Pattern records the pattern of the last DescribeWorktree call.
+	Pattern string
 }
 
 func (m *MockGitTagReader) DescribeTags(ctx context.Context) (string, error) {
 	return m.Tag, m.Err
 }
 
+func (m *MockGitTagReader) HeadCommit(ctx context.Context) (string, error) {
+	return m.Commit, m.Err
+}
+
+func (m *MockGitTagReader) DescribeWorktree(ctx context.Context, pattern string) (WorktreeState, error) {
+	m.Pattern = pattern
+	return m.Worktree, m.Err
+}
+
 // Ensure interfaces are satisfied.
 var (
 	_ core.FileSystem = (*core.OSFileSystem)(nil)
 	_ GitTagReader    = (*realGitClient)(nil)
 	_ GitTagReader    = (*MockGitTagReader)(nil)
+
+	_ HeadCommitReader = (*realGitClient)(nil)
+	_ HeadCommitReader = (*MockGitTagReader)(nil)
+	_ WorktreeReader   = (*realGitClient)(nil)
+	_ WorktreeReader   = (*MockGitTagReader)(nil)
 )
 
 // defaultManager is the singleton used by legacy functions.
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/indaco/verso/internal/apperrors"
 	"github.com/indaco/verso/internal/core"
 )
 
@@ -253,3 +255,39 @@ func TestDefaultVersionManager(t *testing.T) {
 		t.Error("git is nil")
 	}
 }
+
+func TestVersionManager_Update_Locked(t *testing.T) {
+	origTimeout := core.LockTimeout
+	core.LockTimeout = 10 * time.Millisecond
+	defer func() { core.LockTimeout = origTimeout }()
+
+	mockFS := core.NewMockFileSystem()
+	mockFS.SetFile("/test/.version", []byte("1.2.3\n"))
+	mockFS.SetFile("/test/.version.lock", []byte("pid 42 on ci-runner"))
+
+	mgr := NewVersionManager(mockFS, nil)
+	err := mgr.Update("/test/.version", "patch", "", "", false)
+
+	var lockErr *apperrors.LockError
+	if !errors.As(err, &lockErr) {
+		t.Fatalf("expected *apperrors.LockError, got %v", err)
+	}
+
+	data, _ := mockFS.ReadFile("/test/.version")
+	if string(data) != "1.2.3\n" {
+		t.Errorf("expected version file to be untouched, got %q", string(data))
+	}
+}
+
+func TestVersionManager_Update_ReleasesLock(t *testing.T) {
+	mockFS := core.NewMockFileSystem()
+	mockFS.SetFile("/test/.version", []byte("1.2.3\n"))
+
+	mgr := NewVersionManager(mockFS, nil)
+	if err := mgr.Update("/test/.version", "patch", "", "", false); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if _, ok := mockFS.GetFile("/test/.version.lock"); ok {
+		t.Error("expected lock file to be removed after update")
+	}
+}
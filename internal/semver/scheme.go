@@ -0,0 +1,106 @@
+package semver
+
+import (
+	"fmt"
+)
+
+// Version scheme names accepted in configuration.
+const (
+	SchemeSemVer = "semver"
+	SchemeCalVer = "calver"
+)
+
+// Scheme defines how versions are bumped, rendered, and validated.
+//
+// Versions of every scheme are stored in a SemVersion, whose three numeric
+// components map to the scheme's three segments (e.g., YYYY.MM.MICRO for CalVer).
+type Scheme interface {
+	// Name returns the scheme name (e.g., "semver", "calver").
+	Name() string
+
+	// Bump returns the version after an explicit bump (patch, minor, major).
+	Bump(v SemVersion, label string) (SemVersion, error)
+
+	// Next returns the version chosen by "bump auto" when no label applies.
+	Next(v SemVersion) (SemVersion, error)
+
+	// Format renders a version as it is written to files and tags.
+	Format(v SemVersion) string
+
+	// ParseStrict parses s and rejects anything that is not an exact version of the scheme.
+	ParseStrict(s string) (SemVersion, error)
+
+	// Initial returns the version used when initializing a new version file.
+	Initial() SemVersion
+}
+
+// SemVerScheme implements Scheme for Semantic Versioning 2.0.0.
+type SemVerScheme struct{}
+
+// Name returns "semver".
+func (SemVerScheme) Name() string { return SchemeSemVer }
+
+// Bump bumps the version by label using BumpByLabel.
+func (SemVerScheme) Bump(v SemVersion, label string) (SemVersion, error) {
+	return BumpByLabel(v, label)
+}
+
+// Next applies the BumpNext heuristic.
+func (SemVerScheme) Next(v SemVersion) (SemVersion, error) {
+	return BumpNext(v)
+}
+
+// Format renders the version as major.minor.patch[-pre][+build].
+func (SemVerScheme) Format(v SemVersion) string {
+	return formatSemVer(v)
+}
+
+// ParseStrict parses s with ParseVersionStrict.
+func (SemVerScheme) ParseStrict(s string) (SemVersion, error) {
+	return ParseVersionStrict(s)
+}
+
+// Initial returns 0.1.0.
+func (SemVerScheme) Initial() SemVersion {
+	return SemVersion{Major: 0, Minor: 1, Patch: 0}
+}
+
+// NewScheme returns the scheme for the given name.
+// An empty name selects SemVer; format is only used by CalVer.
+func NewScheme(name, format string) (Scheme, error) {
+	switch name {
+	case "", SchemeSemVer:
+		return SemVerScheme{}, nil
+	case SchemeCalVer:
+		return NewCalVerScheme(format, nil)
+	default:
+		return nil, fmt.Errorf("unknown version scheme %q (expected %q or %q)", name, SchemeSemVer, SchemeCalVer)
+	}
+}
+
+// defaultScheme is the scheme used by SemVersion.String, the package-level
+// bump helpers, and version managers without an explicit scheme.
+var defaultScheme Scheme = SemVerScheme{}
+
+// DefaultScheme returns the active version scheme.
+func DefaultScheme() Scheme {
+	return defaultScheme
+}
+
+// SetDefaultScheme sets the active version scheme, typically once at startup
+// from the configuration. Returns a function to restore the previous scheme.
+func SetDefaultScheme(s Scheme) func() {
+	old := defaultScheme
+	defaultScheme = s
+	return func() { defaultScheme = old }
+}
+
+// Next returns the next version for "bump auto" using the active scheme.
+func Next(v SemVersion) (SemVersion, error) {
+	return defaultScheme.Next(v)
+}
+
+// Bump bumps the version by label using the active scheme.
+func Bump(v SemVersion, label string) (SemVersion, error) {
+	return defaultScheme.Bump(v, label)
+}
@@ -0,0 +1,93 @@
+package semver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/indaco/verso/internal/core"
+)
+
+func TestNewScheme(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		wantName string
+		wantErr  bool
+	}{
+		{"", "", SchemeSemVer, false},
+		{"semver", "", SchemeSemVer, false},
+		{"calver", "YY.0M.MICRO", SchemeCalVer, false},
+		{"calver", "bogus", "", true},
+		{"romver", "", "", true},
+	}
+
+	for _, tt := range tests {
+		s, err := NewScheme(tt.name, tt.format)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("NewScheme(%q, %q): expected error", tt.name, tt.format)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("NewScheme(%q, %q): unexpected error: %v", tt.name, tt.format, err)
+		}
+		if s.Name() != tt.wantName {
+			t.Errorf("NewScheme(%q).Name() = %q, want %q", tt.name, s.Name(), tt.wantName)
+		}
+	}
+}
+
+func TestSetDefaultScheme(t *testing.T) {
+	calver := mustCalVer(t, "YY.0M.MICRO", fixedClock(2026, time.March, 2))
+	v := SemVersion{Major: 26, Minor: 3, Patch: 0}
+
+	restore := SetDefaultScheme(calver)
+	if got := v.String(); got != "26.03.0" {
+		t.Errorf("expected String() to use calver format, got %q", got)
+	}
+	if got, _ := BumpNextFunc(SemVersion{Major: 26, Minor: 2, Patch: 7}); got != v {
+		t.Errorf("expected BumpNextFunc to use calver scheme, got %+v", got)
+	}
+
+	restore()
+	if got := v.String(); got != "26.3.0" {
+		t.Errorf("expected String() to use semver format after restore, got %q", got)
+	}
+	if DefaultScheme().Name() != SchemeSemVer {
+		t.Errorf("expected semver default scheme after restore, got %q", DefaultScheme().Name())
+	}
+}
+
+func TestVersionManager_WithCalVerScheme(t *testing.T) {
+	mockFS := core.NewMockFileSystem()
+	mockFS.SetFile("/test/.version", []byte("26.02.4\n"))
+
+	calver := mustCalVer(t, "YY.0M.MICRO", fixedClock(2026, time.March, 2))
+	mgr := NewVersionManager(mockFS, nil).WithScheme(calver)
+
+	if err := mgr.Update("/test/.version", "patch", "", "", false); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	data, _ := mockFS.ReadFile("/test/.version")
+	if string(data) != "26.03.0\n" {
+		t.Errorf("expected 26.03.0 after period change, got %q", string(data))
+	}
+
+	if err := mgr.Update("/test/.version", "minor", "", "", false); err == nil {
+		t.Error("expected error for minor bump under calver")
+	}
+
+	if _, err := mgr.ReadStrict("/test/.version"); err != nil {
+		t.Errorf("expected padded calver version to pass strict read, got %v", err)
+	}
+
+	if err := mgr.Initialize(context.Background(), "/new/.version"); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	data, _ = mockFS.ReadFile("/new/.version")
+	if string(data) != "26.03.0\n" {
+		t.Errorf("expected initial calver version 26.03.0, got %q", string(data))
+	}
+}
@@ -377,6 +377,91 @@ func TestIncrementPreRelease(t *testing.T) {
 	}
 }
 
+func TestInitialDevelopmentLabel(t *testing.T) {
+	cases := []struct {
+		current string
+		label   string
+		want    string
+	}{
+		{"0.4.2", "major", "minor"},
+		{"0.4.2", "minor", "patch"},
+		{"0.4.2", "patch", "patch"},
+		{"0.4.2-rc.1", "major", "minor"},
+		{"1.4.2", "major", "major"},
+		{"1.4.2", "minor", "minor"},
+	}
+
+	for _, c := range cases {
+		if got := InitialDevelopmentLabel(mustParse(t, c.current), c.label); got != c.want {
+			t.Errorf("InitialDevelopmentLabel(%s, %q) = %q, want %q", c.current, c.label, got, c.want)
+		}
+	}
+}
+
+func TestBumpStable(t *testing.T) {
+	got, err := BumpStable(mustParse(t, "0.9.3-rc.1+ci.2"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != (SemVersion{Major: 1}) {
+		t.Errorf("BumpStable() = %s, want 1.0.0", got)
+	}
+
+	if _, err := BumpStable(mustParse(t, "1.0.0")); err == nil || !strings.Contains(err.Error(), "already stable") {
+		t.Errorf("expected already stable error, got %v", err)
+	}
+}
+
+func TestStartPreRelease(t *testing.T) {
+	cases := []struct {
+		current string
+		label   string
+		want    string
+	}{
+		{"", "rc", "rc.1"},
+		{"rc", "rc", "rc.1"},
+		{"rc.4", "rc", "rc.1"},
+		{"rc-4", "rc", "rc-1"},
+		{"rc4", "rc", "rc1"},
+		{"beta-2", "rc", "rc.1"},
+	}
+
+	for _, c := range cases {
+		if got := StartPreRelease(c.current, c.label); got != c.want {
+			t.Errorf("StartPreRelease(%q, %q) = %q, want %q", c.current, c.label, got, c.want)
+		}
+	}
+}
+
+func TestBumpPre(t *testing.T) {
+	cases := []struct {
+		current string
+		level   string
+		label   string
+		want    string
+	}{
+		{"1.2.5", "patch", "", "1.2.6-rc.1"},
+		{"1.2.5", "minor", "rc", "1.3.0-rc.1"},
+		{"1.2.5+ci.7", "major", "alpha", "2.0.0-alpha.1"},
+		{"1.3.0-beta.2", "minor", "", "1.4.0-beta.1"},
+		{"1.3.0-beta-2", "patch", "", "1.3.1-beta-1"},
+	}
+
+	for _, c := range cases {
+		got, err := BumpPre(mustParse(t, c.current), c.level, c.label)
+		if err != nil {
+			t.Fatalf("BumpPre(%s, %s, %q) failed: %v", c.current, c.level, c.label, err)
+		}
+		if got.String() != c.want {
+			t.Errorf("BumpPre(%s, %s, %q) = %s, want %s", c.current, c.level, c.label, got, c.want)
+		}
+	}
+
+	if _, err := BumpPre(SemVersion{Major: 1}, "build", "rc"); err == nil {
+		t.Error("expected error for invalid level")
+	}
+}
+
 /* ------------------------------------------------------------------------- */
 /* VERSION FILE INITIALIZATION WITH MOCKS                                    */
 /* ------------------------------------------------------------------------- */
@@ -564,12 +649,12 @@ func TestBumpNext(t *testing.T) {
 			},
 		},
 		{
-			name: "optional heuristic bump from 0.9.0 to 0.10.0",
+			name: "0.9.0 bumps patch like any other version",
 			current: SemVersion{
 				Major: 0, Minor: 9, Patch: 0,
 			},
 			expected: SemVersion{
-				Major: 0, Minor: 10, Patch: 0,
+				Major: 0, Minor: 9, Patch: 1,
 			},
 		},
 	}
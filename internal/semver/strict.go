@@ -0,0 +1,169 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/indaco/verso/internal/apperrors"
+)
+
+// ParseVersionStrict parses s as an exact SemVer 2.0.0 version string.
+//
+// Unlike ParseVersion, it does not trim whitespace or accept a "v" prefix, and it
+// rejects numeric identifiers with leading zeros (e.g., "01.2.3", "1.2.3-rc.01")
+// and empty identifiers (e.g., "1.2.3-rc..1"). Errors are returned as
+// *apperrors.InvalidVersionError whose reason points at the offending
+// position (1-based).
+func ParseVersionStrict(s string) (SemVersion, error) {
+	p := &strictParser{input: s}
+	return p.parse()
+}
+
+// strictParser is a single-pass scanner over a version string.
+type strictParser struct {
+	input string
+	pos   int
+}
+
+// parse scans the full input as major.minor.patch[-pre][+build].
+func (p *strictParser) parse() (SemVersion, error) {
+	if p.input == "" {
+		return SemVersion{}, &apperrors.InvalidVersionError{Version: p.input, Reason: "empty version string"}
+	}
+	if p.input[0] == 'v' || p.input[0] == 'V' {
+		return SemVersion{}, p.fail(0, fmt.Sprintf("prefix %q is not part of a semantic version", p.input[0]))
+	}
+
+	var v SemVersion
+	var err error
+
+	if v.Major, err = p.numeric("major"); err != nil {
+		return SemVersion{}, err
+	}
+	if err := p.expectDot("major"); err != nil {
+		return SemVersion{}, err
+	}
+	if v.Minor, err = p.numeric("minor"); err != nil {
+		return SemVersion{}, err
+	}
+	if err := p.expectDot("minor"); err != nil {
+		return SemVersion{}, err
+	}
+	if v.Patch, err = p.numeric("patch"); err != nil {
+		return SemVersion{}, err
+	}
+
+	if p.peek('-') {
+		p.pos++
+		if v.PreRelease, err = p.identifiers("pre-release", true); err != nil {
+			return SemVersion{}, err
+		}
+	}
+
+	if p.peek('+') {
+		p.pos++
+		if v.Build, err = p.identifiers("build metadata", false); err != nil {
+			return SemVersion{}, err
+		}
+	}
+
+	if p.pos < len(p.input) {
+		return SemVersion{}, p.fail(p.pos, fmt.Sprintf("unexpected character %q", p.input[p.pos]))
+	}
+
+	return v, nil
+}
+
+// numeric scans a major, minor, or patch component.
+func (p *strictParser) numeric(name string) (int, error) {
+	start := p.pos
+	for p.pos < len(p.input) && isDigit(p.input[p.pos]) {
+		p.pos++
+	}
+
+	digits := p.input[start:p.pos]
+	switch {
+	case digits == "" && p.pos >= len(p.input):
+		return 0, p.fail(start, fmt.Sprintf("missing %s version", name))
+	case digits == "":
+		return 0, p.fail(start, fmt.Sprintf("unexpected character %q in %s version", p.input[p.pos], name))
+	case len(digits) > 1 && digits[0] == '0':
+		return 0, p.fail(start, fmt.Sprintf("leading zero in %s version %q", name, digits))
+	}
+
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, p.fail(start, fmt.Sprintf("%s version %q is out of range", name, digits))
+	}
+	return n, nil
+}
+
+// expectDot consumes the "." separating two version core components.
+func (p *strictParser) expectDot(after string) error {
+	if p.pos >= len(p.input) {
+		return p.fail(p.pos, fmt.Sprintf("expected \".\" after %s version, got end of input", after))
+	}
+	if p.input[p.pos] != '.' {
+		return p.fail(p.pos, fmt.Sprintf("expected \".\" after %s version, got %q", after, p.input[p.pos]))
+	}
+	p.pos++
+	return nil
+}
+
+// identifiers scans a dot-separated list of pre-release or build identifiers.
+// Pre-release identifiers may be followed by build metadata, and their numeric
+// identifiers must not have leading zeros.
+func (p *strictParser) identifiers(kind string, preRelease bool) (string, error) {
+	start := p.pos
+	for {
+		idStart := p.pos
+		for p.pos < len(p.input) && isIdentifierChar(p.input[p.pos]) {
+			p.pos++
+		}
+
+		id := p.input[idStart:p.pos]
+		if id == "" {
+			if p.pos < len(p.input) && p.input[p.pos] != '.' && p.input[p.pos] != '+' {
+				return "", p.fail(p.pos, fmt.Sprintf("invalid character %q in %s", p.input[p.pos], kind))
+			}
+			return "", p.fail(idStart, fmt.Sprintf("empty %s identifier", kind))
+		}
+		if preRelease && len(id) > 1 && id[0] == '0' && isNumericIdentifier(id) {
+			return "", p.fail(idStart, fmt.Sprintf("leading zero in numeric %s identifier %q", kind, id))
+		}
+
+		if !p.peek('.') {
+			break
+		}
+		p.pos++
+	}
+
+	if p.pos < len(p.input) && !(preRelease && p.input[p.pos] == '+') {
+		return "", p.fail(p.pos, fmt.Sprintf("invalid character %q in %s", p.input[p.pos], kind))
+	}
+
+	return p.input[start:p.pos], nil
+}
+
+// peek reports whether the next character is c.
+func (p *strictParser) peek(c byte) bool {
+	return p.pos < len(p.input) && p.input[p.pos] == c
+}
+
+// fail returns an InvalidVersionError for the given 0-based offset.
+func (p *strictParser) fail(offset int, reason string) error {
+	return &apperrors.InvalidVersionError{
+		Version: p.input,
+		Reason:  fmt.Sprintf("position %d: %s", offset+1, reason),
+	}
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isIdentifierChar reports whether c is allowed in a pre-release or build identifier.
+func isIdentifierChar(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '-'
+}
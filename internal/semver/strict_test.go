@@ -0,0 +1,110 @@
+package semver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/indaco/verso/internal/apperrors"
+	"github.com/indaco/verso/internal/core"
+)
+
+func TestParseVersionStrict_Valid(t *testing.T) {
+	tests := []struct {
+		input string
+		want  SemVersion
+	}{
+		{"0.0.0", SemVersion{}},
+		{"1.2.3", SemVersion{Major: 1, Minor: 2, Patch: 3}},
+		{"10.20.30", SemVersion{Major: 10, Minor: 20, Patch: 30}},
+		{"1.0.0-alpha", SemVersion{Major: 1, PreRelease: "alpha"}},
+		{"1.0.0-0.3.7", SemVersion{Major: 1, PreRelease: "0.3.7"}},
+		{"1.0.0-x.7.z.92", SemVersion{Major: 1, PreRelease: "x.7.z.92"}},
+		{"1.0.0-x-y-z.--", SemVersion{Major: 1, PreRelease: "x-y-z.--"}},
+		{"1.0.0-rc.01a", SemVersion{Major: 1, PreRelease: "rc.01a"}},
+		{"1.0.0+001", SemVersion{Major: 1, Build: "001"}},
+		{"1.0.0-beta+exp.sha.5114f85", SemVersion{Major: 1, PreRelease: "beta", Build: "exp.sha.5114f85"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseVersionStrict(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseVersionStrict_Invalid(t *testing.T) {
+	tests := []struct {
+		input  string
+		reason string
+	}{
+		{"", "empty version string"},
+		{"v1.2.3", `position 1: prefix 'v' is not part of a semantic version`},
+		{"01.2.3", `position 1: leading zero in major version "01"`},
+		{"1.02.3", `position 3: leading zero in minor version "02"`},
+		{"1.2.00", `position 5: leading zero in patch version "00"`},
+		{"1.2", `position 4: expected "." after minor version, got end of input`},
+		{"1.2.", "position 5: missing patch version"},
+		{"1..3", `position 3: unexpected character '.' in minor version`},
+		{"1.2.3.4", `position 6: unexpected character '.'`},
+		{"1.2.3-", "position 7: empty pre-release identifier"},
+		{"1.2.3-rc..1", "position 10: empty pre-release identifier"},
+		{"1.2.3-rc.", "position 10: empty pre-release identifier"},
+		{"1.2.3-rc.01", `position 10: leading zero in numeric pre-release identifier "01"`},
+		{"1.2.3-rc_1", `position 9: invalid character '_' in pre-release`},
+		{"1.2.3+", "position 7: empty build metadata identifier"},
+		{"1.2.3+a..b", "position 9: empty build metadata identifier"},
+		{"1.0.0+inv@lid", `position 10: invalid character '@' in build metadata`},
+		{"1.2.3 ", `position 6: unexpected character ' '`},
+		{"99999999999999999999.0.0", `position 1: major version "99999999999999999999" is out of range`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseVersionStrict(tt.input)
+			if err == nil {
+				t.Fatalf("expected error for %q", tt.input)
+			}
+
+			var verr *apperrors.InvalidVersionError
+			if !errors.As(err, &verr) {
+				t.Fatalf("expected *apperrors.InvalidVersionError, got %T", err)
+			}
+			if verr.Version != tt.input {
+				t.Errorf("expected Version %q, got %q", tt.input, verr.Version)
+			}
+			if verr.Reason != tt.reason {
+				t.Errorf("expected reason %q, got %q", tt.reason, verr.Reason)
+			}
+		})
+	}
+}
+
+func TestVersionManager_ReadStrict(t *testing.T) {
+	mockFS := core.NewMockFileSystem()
+	mockFS.SetFile("/ok/.version", []byte("1.2.3-rc.1\n"))
+	mockFS.SetFile("/bad/.version", []byte("1.2.3-rc.01\n"))
+
+	mgr := NewVersionManager(mockFS, nil)
+
+	v, err := mgr.ReadStrict("/ok/.version")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.String() != "1.2.3-rc.1" {
+		t.Errorf("expected 1.2.3-rc.1, got %s", v)
+	}
+
+	if _, err := mgr.ReadStrict("/bad/.version"); err == nil {
+		t.Error("expected error for leading zero in pre-release identifier")
+	}
+
+	if _, err := mgr.ReadStrict("/missing/.version"); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
@@ -37,16 +37,22 @@ var (
 	errInvalidVersion = errors.New("invalid version format")
 
 	// BumpNextFunc is a function variable for performing heuristic-based version bumps.
-	// It defaults to BumpNext but can be overridden in tests to simulate errors.
-	BumpNextFunc = BumpNext
+	// It defaults to Next (BumpNext under SemVer) but can be overridden in tests to simulate errors.
+	BumpNextFunc = Next
 
 	// BumpByLabelFunc is a function variable for bumping a version using an explicit label (patch, minor, major).
-	// It defaults to BumpByLabel but can be overridden in tests to simulate errors.
-	BumpByLabelFunc = BumpByLabel
+	// It defaults to Bump (BumpByLabel under SemVer) but can be overridden in tests to simulate errors.
+	BumpByLabelFunc = Bump
 )
 
-// String returns the string representation of the semantic version.
+// String returns the string representation of the version, rendered by the
+// active version scheme (see SetDefaultScheme).
 func (v SemVersion) String() string {
+	return defaultScheme.Format(v)
+}
+
+// formatSemVer renders a version as major.minor.patch[-pre][+build].
+func formatSemVer(v SemVersion) string {
 	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
 	if v.PreRelease != "" {
 		s += "-" + v.PreRelease
@@ -95,10 +101,6 @@ func BumpNext(v SemVersion) (SemVersion, error) {
 		return promoted, nil
 	}
 
-	if v.Major == 0 && v.Minor == 9 && v.Patch == 0 {
-		return SemVersion{Major: v.Major, Minor: v.Minor + 1, Patch: 0}, nil
-	}
-
 	// Default case: bump patch
 	return SemVersion{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}, nil
 }
@@ -163,6 +165,78 @@ func IncrementPreRelease(current, base string) string {
 	return formatPreReleaseWithSep(base, 1, ".")
 }
 
+// InitialDevelopmentLabel applies the initial development policy (SemVer
+// major version zero) to an inferred bump label: while v.Major is 0, a
+// breaking change bumps minor and a feature bumps patch. Other labels and
+// versions from 1.0.0 on are returned unchanged.
+func InitialDevelopmentLabel(v SemVersion, label string) string {
+	if v.Major != 0 {
+		return label
+	}
+	switch label {
+	case "major":
+		return "minor"
+	case "minor":
+		return "patch"
+	}
+	return label
+}
+
+// BumpStable graduates an initial development version to 1.0.0, keeping
+// neither pre-release nor build metadata. It fails for versions from 1.0.0 on.
+func BumpStable(v SemVersion) (SemVersion, error) {
+	if v.Major != 0 {
+		return SemVersion{}, fmt.Errorf("version %s is already stable (major version is not 0)", v.String())
+	}
+	return SemVersion{Major: 1}, nil
+}
+
+// DefaultPreReleaseLabel is the label used by compound pre-release bumps when
+// neither a label nor an existing pre-release is available.
+const DefaultPreReleaseLabel = "rc"
+
+// StartPreRelease returns the first pre-release for label, e.g. "rc.1".
+// If current uses the same label, its separator style is kept:
+// "rc-4" -> "rc-1", "rc4" -> "rc1".
+func StartPreRelease(current, label string) string {
+	next := IncrementPreRelease(current, label)
+	sep := "."
+	if rest, ok := strings.CutPrefix(next, label); ok && rest != "" {
+		switch rest[0] {
+		case '.', '-':
+			sep = rest[:1]
+		default:
+			sep = ""
+		}
+	}
+	return formatPreReleaseWithSep(label, 1, sep)
+}
+
+// BumpPre performs a compound pre-release bump: it bumps level (patch, minor,
+// major) and starts a new pre-release, so 1.2.5 becomes 1.3.0-rc.1 for
+// ("minor", "rc"). An empty label reuses the base label of the current
+// pre-release, falling back to DefaultPreReleaseLabel. Build metadata is cleared.
+func BumpPre(v SemVersion, level, label string) (SemVersion, error) {
+	next, err := BumpByLabelFunc(v, level)
+	if err != nil {
+		return SemVersion{}, err
+	}
+
+	if label == "" {
+		label = DefaultPreReleaseLabel
+		if v.PreRelease != "" {
+			label = extractPreReleaseBase(v.PreRelease)
+		}
+	}
+
+	return SemVersion{
+		Major:      next.Major,
+		Minor:      next.Minor,
+		Patch:      next.Patch,
+		PreRelease: StartPreRelease(v.PreRelease, label),
+	}, nil
+}
+
 func formatPreReleaseWithSep(base string, num int, sep string) string {
 	return fmt.Sprintf("%s%s%d", base, sep, num)
 }
@@ -0,0 +1,281 @@
+package semver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-yaml"
+)
+
+// VersionFileFormat identifies how a version file is encoded.
+type VersionFileFormat string
+
+const (
+	// FormatPlain is the classic file holding only the version string.
+	FormatPlain VersionFileFormat = "plain"
+	// FormatYAML is a YAML mapping with a "version" key and optional metadata.
+	FormatYAML VersionFileFormat = "yaml"
+	// FormatJSON is a JSON object with a "version" key and optional metadata.
+	FormatJSON VersionFileFormat = "json"
+)
+
+// Keys of the structured version file known to verso.
+const (
+	keyVersion     = "version"
+	keyReleaseDate = "release-date"
+	keyCodename    = "codename"
+	keyChannel     = "channel"
+	keyCommit      = "commit"
+)
+
+// knownKeys lists the known keys in the order they are written to new files.
+var knownKeys = []string{keyVersion, keyReleaseDate, keyCodename, keyChannel, keyCommit}
+
+// ReleaseDateLayout is the layout of the release-date field.
+const ReleaseDateLayout = "2006-01-02"
+
+// timeNow returns the current time; tests replace it for deterministic release dates.
+var timeNow = time.Now
+
+// VersionFile is the content of a version file: the version and, for the
+// structured YAML and JSON variants, release metadata kept next to it.
+//
+// Example (YAML):
+//
+//	version: 1.4.0
+//	release-date: "2026-10-16"
+//	codename: Aurora
+//	channel: stable
+//	commit: 3f2a1c9
+type VersionFile struct {
+	Version     SemVersion
+	ReleaseDate string
+	Codename    string
+	Channel     string
+	Commit      string
+
+	// Format is the encoding used when the file is saved.
+	Format VersionFileFormat
+
+	// order holds the keys in file order; extra holds the values of keys
+	// verso does not know, so both survive a read/save round trip.
+	order []string
+	extra map[string]any
+}
+
+// IsStructured reports whether the file uses the YAML or JSON variant.
+func (f *VersionFile) IsStructured() bool {
+	return f.Format == FormatYAML || f.Format == FormatJSON
+}
+
+// Extra returns the value of a key verso does not know, if present.
+func (f *VersionFile) Extra(key string) (any, bool) {
+	v, ok := f.extra[key]
+	return v, ok
+}
+
+// parseVersionFile detects the variant of a version file and decodes it.
+// Content that is neither a JSON object nor a YAML mapping is parsed as a
+// plain version string, preserving the errors of ParseVersion.
+func parseVersionFile(data []byte) (*VersionFile, string, error) {
+	trimmed := strings.TrimSpace(string(data))
+
+	if strings.HasPrefix(trimmed, "{") {
+		return decodeJSONVersionFile([]byte(trimmed))
+	}
+
+	if v, err := ParseVersion(trimmed); err == nil || !strings.Contains(trimmed, ":") {
+		return &VersionFile{Version: v, Format: FormatPlain}, trimmed, err
+	}
+
+	return decodeYAMLVersionFile([]byte(trimmed))
+}
+
+// decodeJSONVersionFile decodes a JSON version file, keeping the key order.
+// It returns the file and the raw version string.
+func decodeJSONVersionFile(data []byte) (*VersionFile, string, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return nil, "", fmt.Errorf("invalid JSON version file: %w", err)
+	}
+
+	var fields []orderedField
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid JSON version file: %w", err)
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, "", fmt.Errorf("invalid JSON version file: %w", err)
+		}
+
+		key := tok.(string)
+		var value any = raw
+		if slices.Contains(knownKeys, key) {
+			var s any
+			if err := json.Unmarshal(raw, &s); err != nil {
+				return nil, "", fmt.Errorf("invalid JSON version file: %w", err)
+			}
+			value = s
+		}
+		fields = append(fields, orderedField{key: key, value: value})
+	}
+
+	return newStructuredVersionFile(FormatJSON, fields)
+}
+
+// decodeYAMLVersionFile decodes a YAML version file, keeping the key order.
+func decodeYAMLVersionFile(data []byte) (*VersionFile, string, error) {
+	var ms yaml.MapSlice
+	if err := yaml.UnmarshalWithOptions(data, &ms, yaml.UseOrderedMap()); err != nil {
+		return nil, "", fmt.Errorf("invalid YAML version file: %w", err)
+	}
+
+	fields := make([]orderedField, 0, len(ms))
+	for _, item := range ms {
+		fields = append(fields, orderedField{key: fmt.Sprint(item.Key), value: item.Value})
+	}
+
+	return newStructuredVersionFile(FormatYAML, fields)
+}
+
+// orderedField is a key/value pair of a structured version file.
+type orderedField struct {
+	key   string
+	value any
+}
+
+// newStructuredVersionFile builds a VersionFile from decoded fields.
+func newStructuredVersionFile(format VersionFileFormat, fields []orderedField) (*VersionFile, string, error) {
+	f := &VersionFile{Format: format, extra: map[string]any{}}
+	raw := ""
+	found := false
+
+	for _, field := range fields {
+		f.order = append(f.order, field.key)
+
+		if !slices.Contains(knownKeys, field.key) {
+			f.extra[field.key] = field.value
+			continue
+		}
+
+		var s string
+		switch v := field.value.(type) {
+		case nil:
+		case string:
+			s = v
+		case map[string]any, []any, yaml.MapSlice:
+			return nil, "", fmt.Errorf("invalid %s version file: %q must be a string", format, field.key)
+		default:
+			s = fmt.Sprint(v)
+		}
+
+		switch field.key {
+		case keyVersion:
+			raw, found = s, true
+		case keyReleaseDate:
+			f.ReleaseDate = s
+		case keyCodename:
+			f.Codename = s
+		case keyChannel:
+			f.Channel = s
+		case keyCommit:
+			f.Commit = s
+		}
+	}
+
+	if !found {
+		return nil, "", fmt.Errorf("invalid %s version file: missing %q key", format, keyVersion)
+	}
+
+	v, err := ParseVersion(raw)
+	if err != nil {
+		return nil, "", err
+	}
+	f.Version = v
+	return f, raw, nil
+}
+
+// known returns the value of a known key and whether it should be written.
+func (f *VersionFile) known(key string, format func(SemVersion) string) (string, bool) {
+	switch key {
+	case keyVersion:
+		return format(f.Version), true
+	case keyReleaseDate:
+		return f.ReleaseDate, f.ReleaseDate != ""
+	case keyCodename:
+		return f.Codename, f.Codename != ""
+	case keyChannel:
+		return f.Channel, f.Channel != ""
+	case keyCommit:
+		return f.Commit, f.Commit != ""
+	}
+	return "", false
+}
+
+// fields returns the fields to write: keys in file order, then newly set
+// known keys in their default order.
+func (f *VersionFile) fields(format func(SemVersion) string) []orderedField {
+	keys := slices.Clone(f.order)
+	for _, key := range knownKeys {
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	out := make([]orderedField, 0, len(keys))
+	for _, key := range keys {
+		if value, ok := f.extra[key]; ok {
+			out = append(out, orderedField{key: key, value: value})
+			continue
+		}
+		if value, ok := f.known(key, format); ok {
+			out = append(out, orderedField{key: key, value: value})
+		}
+	}
+	return out
+}
+
+// encode renders the file in its format, using format to render the version.
+func (f *VersionFile) encode(format func(SemVersion) string) ([]byte, error) {
+	switch f.Format {
+	case FormatYAML:
+		fields := f.fields(format)
+		ms := make(yaml.MapSlice, 0, len(fields))
+		for _, field := range fields {
+			ms = append(ms, yaml.MapItem{Key: field.key, Value: field.value})
+		}
+		return yaml.Marshal(ms)
+	case FormatJSON:
+		var buf bytes.Buffer
+		buf.WriteByte('{')
+		for i, field := range f.fields(format) {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			key, _ := json.Marshal(field.key)
+			value, err := json.Marshal(field.value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode %q: %w", field.key, err)
+			}
+			buf.Write(key)
+			buf.WriteByte(':')
+			buf.Write(value)
+		}
+		buf.WriteByte('}')
+
+		var out bytes.Buffer
+		if err := json.Indent(&out, buf.Bytes(), "", "  "); err != nil {
+			return nil, err
+		}
+		out.WriteByte('\n')
+		return out.Bytes(), nil
+	default:
+		return []byte(format(f.Version) + "\n"), nil
+	}
+}
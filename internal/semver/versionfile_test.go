@@ -0,0 +1,212 @@
+package semver
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/indaco/verso/internal/core"
+)
+
+func fixedTimeNow(t *testing.T, year int, month time.Month, day int) {
+	t.Helper()
+	original := timeNow
+	timeNow = fixedClock(year, month, day)
+	t.Cleanup(func() { timeNow = original })
+}
+
+func TestVersionManager_ReadFile_Variants(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		format  VersionFileFormat
+	}{
+		{"plain", "1.4.0-rc.2\n", FormatPlain},
+		{"yaml", "version: 1.4.0-rc.2\ncodename: Aurora\nchannel: beta\n", FormatYAML},
+		{"json", "{\n  \"version\": \"1.4.0-rc.2\",\n  \"codename\": \"Aurora\",\n  \"channel\": \"beta\"\n}\n", FormatJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockFS := core.NewMockFileSystem()
+			mockFS.SetFile("/test/.version", []byte(tt.content))
+			mgr := NewVersionManager(mockFS, nil)
+
+			file, err := mgr.ReadFile("/test/.version")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if file.Format != tt.format {
+				t.Errorf("expected format %q, got %q", tt.format, file.Format)
+			}
+			if file.Version.String() != "1.4.0-rc.2" {
+				t.Errorf("expected version 1.4.0-rc.2, got %s", file.Version)
+			}
+			if file.IsStructured() && (file.Codename != "Aurora" || file.Channel != "beta") {
+				t.Errorf("expected metadata to be read, got %+v", file)
+			}
+
+			v, err := mgr.Read("/test/.version")
+			if err != nil {
+				t.Fatalf("Read failed: %v", err)
+			}
+			if v != file.Version {
+				t.Errorf("Read() = %s, want %s", v, file.Version)
+			}
+		})
+	}
+}
+
+func TestVersionManager_ReadFile_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"yaml missing version", "codename: Aurora\n", `missing "version" key`},
+		{"json missing version", `{"codename": "Aurora"}`, `missing "version" key`},
+		{"json malformed", `{"version": }`, "invalid JSON version file"},
+		{"yaml version not a string", "version:\n  major: 1\n", `"version" must be a string`},
+		{"yaml invalid version", "version: latest\n", "invalid version format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockFS := core.NewMockFileSystem()
+			mockFS.SetFile("/test/.version", []byte(tt.content))
+
+			_, err := NewVersionManager(mockFS, nil).Read("/test/.version")
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestVersionManager_Save_PreservesYAML(t *testing.T) {
+	fixedTimeNow(t, 2026, time.October, 16)
+
+	mockFS := core.NewMockFileSystem()
+	mockFS.SetFile("/test/.version", []byte(strings.Join([]string{
+		"version: 1.3.9",
+		"codename: Aurora",
+		"support:",
+		"  until: 2027-06",
+		"  contact: ops@example.com",
+		"release-date: \"2026-01-05\"",
+		"commit: \"0123456\"",
+		"",
+	}, "\n")))
+
+	mgr := NewVersionManager(mockFS, &MockGitTagReader{Commit: "3f2a1c9\n"})
+	if err := mgr.Update("/test/.version", "minor", "", "", false); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	data, _ := mockFS.ReadFile("/test/.version")
+	want := strings.Join([]string{
+		"version: 1.4.0",
+		"codename: Aurora",
+		"support:",
+		"  until: 2027-06",
+		"  contact: ops@example.com",
+		"release-date: \"2026-10-16\"",
+		"commit: 3f2a1c9",
+		"",
+	}, "\n")
+	if string(data) != want {
+		t.Errorf("unexpected file content:\n%s\nwant:\n%s", data, want)
+	}
+}
+
+func TestVersionManager_Save_PreservesJSON(t *testing.T) {
+	fixedTimeNow(t, 2026, time.October, 16)
+
+	mockFS := core.NewMockFileSystem()
+	mockFS.SetFile("/test/.version", []byte(`{"build": {"number": 7, "tags": ["a", "b"]}, "version": "1.3.9", "channel": "stable"}`))
+
+	mgr := NewVersionManager(mockFS, nil)
+	if err := mgr.Save("/test/.version", SemVersion{Major: 1, Minor: 4}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	data, _ := mockFS.ReadFile("/test/.version")
+	want := `{
+  "build": {
+    "number": 7,
+    "tags": [
+      "a",
+      "b"
+    ]
+  },
+  "version": "1.4.0",
+  "channel": "stable",
+  "release-date": "2026-10-16"
+}
+`
+	if string(data) != want {
+		t.Errorf("unexpected file content:\n%s\nwant:\n%s", data, want)
+	}
+
+	file, err := mgr.ReadFile("/test/.version")
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if _, ok := file.Extra("build"); !ok {
+		t.Error("expected unknown key \"build\" to be preserved")
+	}
+}
+
+func TestVersionManager_Save_UnchangedVersionKeepsStamp(t *testing.T) {
+	fixedTimeNow(t, 2026, time.October, 16)
+
+	content := "version: 1.4.0\nrelease-date: \"2026-01-05\"\n"
+	mockFS := core.NewMockFileSystem()
+	mockFS.SetFile("/test/.version", []byte(content))
+
+	mgr := NewVersionManager(mockFS, nil)
+	if err := mgr.Save("/test/.version", SemVersion{Major: 1, Minor: 4}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	data, _ := mockFS.ReadFile("/test/.version")
+	if string(data) != content {
+		t.Errorf("expected file to be unchanged, got:\n%s", data)
+	}
+}
+
+func TestVersionManager_SaveFile_NewStructured(t *testing.T) {
+	mockFS := core.NewMockFileSystem()
+	mgr := NewVersionManager(mockFS, nil)
+
+	file := &VersionFile{
+		Version:  SemVersion{Major: 2, Minor: 0, Patch: 0},
+		Codename: "Borealis",
+		Channel:  "stable",
+		Format:   FormatYAML,
+	}
+	if err := mgr.SaveFile("/test/.version", file); err != nil {
+		t.Fatalf("SaveFile failed: %v", err)
+	}
+
+	data, _ := mockFS.ReadFile("/test/.version")
+	want := "version: 2.0.0\ncodename: Borealis\nchannel: stable\n"
+	if string(data) != want {
+		t.Errorf("unexpected file content:\n%s\nwant:\n%s", data, want)
+	}
+}
+
+func TestVersionManager_ReadStrict_Structured(t *testing.T) {
+	mockFS := core.NewMockFileSystem()
+	mgr := NewVersionManager(mockFS, nil)
+
+	mockFS.SetFile("/test/.version", []byte("version: 1.4.0\n"))
+	if _, err := mgr.ReadStrict("/test/.version"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	mockFS.SetFile("/test/.version", []byte(`{"version": "v1.4.0"}`))
+	if _, err := mgr.ReadStrict("/test/.version"); err == nil || !strings.Contains(err.Error(), "position 1") {
+		t.Errorf("expected strict error for \"v\" prefix, got %v", err)
+	}
+}
@@ -21,7 +21,7 @@ func BuildCLIForTests(path string, subCmds []*cli.Command) *cli.Command {
 			&cli.BoolFlag{
 				Name:    "strict",
 				Aliases: []string{"no-auto-init"},
-				Usage:   "Fail if .version file is missing (disable auto-initialization)",
+				Usage:   "Fail if .version file is missing or not strict SemVer 2.0.0 (disable auto-initialization)",
 			},
 		},
 		Commands: subCmds,
@@ -23,6 +23,15 @@ type MockPrompter struct {
 	// ConfirmError is the error to return from ConfirmOperation.
 	ConfirmError error
 
+	// BumpLevels maps module names to the levels returned by PromptBumpLevel.
+	BumpLevels map[string]string
+
+	// SummaryResult is the summary to return from PromptSummary.
+	SummaryResult string
+
+	// ChangeError is the error to return from PromptBumpLevel and PromptSummary.
+	ChangeError error
+
 	// Calls records all method invocations for assertion.
 	Calls []MockCall
 }
@@ -41,8 +50,11 @@ func NewMockPrompter() *MockPrompter {
 	}
 }
 
-// Ensure MockPrompter implements Prompter.
-var _ Prompter = (*MockPrompter)(nil)
+// Ensure MockPrompter implements Prompter and ChangePrompter.
+var (
+	_ Prompter       = (*MockPrompter)(nil)
+	_ ChangePrompter = (*MockPrompter)(nil)
+)
 
 // PromptModuleSelection returns the pre-configured SelectionResult.
 func (m *MockPrompter) PromptModuleSelection(modules []*workspace.Module) (Selection, error) {
@@ -78,6 +90,37 @@ func (m *MockPrompter) ConfirmOperation(message string) (bool, error) {
 	return m.ConfirmResult, nil
 }
 
+// PromptBumpLevel returns the pre-configured level of the module.
+func (m *MockPrompter) PromptBumpLevel(module string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Calls = append(m.Calls, MockCall{
+		Method:  "PromptBumpLevel",
+		Message: module,
+	})
+
+	if m.ChangeError != nil {
+		return "", m.ChangeError
+	}
+
+	return m.BumpLevels[module], nil
+}
+
+// PromptSummary returns the pre-configured SummaryResult.
+func (m *MockPrompter) PromptSummary() (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Calls = append(m.Calls, MockCall{Method: "PromptSummary"})
+
+	if m.ChangeError != nil {
+		return "", m.ChangeError
+	}
+
+	return m.SummaryResult, nil
+}
+
 // Reset clears all recorded calls and resets state.
 func (m *MockPrompter) Reset() {
 	m.mu.Lock()
@@ -88,6 +131,9 @@ func (m *MockPrompter) Reset() {
 	m.SelectionError = nil
 	m.ConfirmResult = false
 	m.ConfirmError = nil
+	m.BumpLevels = nil
+	m.SummaryResult = ""
+	m.ChangeError = nil
 }
 
 // CallCount returns the number of times a method was called.
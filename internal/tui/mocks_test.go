@@ -113,6 +113,43 @@ func TestMockPrompter_ConfirmOperation(t *testing.T) {
 	})
 }
 
+func TestMockPrompter_ChangePrompts(t *testing.T) {
+	t.Run("returns pre-configured levels and summary", func(t *testing.T) {
+		mock := NewMockPrompter()
+		mock.BumpLevels = map[string]string{"api": "minor"}
+		mock.SummaryResult = "Add search."
+
+		level, err := mock.PromptBumpLevel("api")
+		if err != nil || level != "minor" {
+			t.Errorf("PromptBumpLevel() = %q, %v, want minor", level, err)
+		}
+		summary, err := mock.PromptSummary()
+		if err != nil || summary != "Add search." {
+			t.Errorf("PromptSummary() = %q, %v", summary, err)
+		}
+
+		if call := mock.LastCall("PromptBumpLevel"); call == nil || call.Message != "api" {
+			t.Errorf("LastCall(PromptBumpLevel) = %+v, want module api", call)
+		}
+		if mock.CallCount("PromptSummary") != 1 {
+			t.Errorf("CallCount(PromptSummary) = %d, want 1", mock.CallCount("PromptSummary"))
+		}
+	})
+
+	t.Run("returns pre-configured error", func(t *testing.T) {
+		mock := NewMockPrompter()
+		expectedErr := errors.New("test error")
+		mock.ChangeError = expectedErr
+
+		if _, err := mock.PromptBumpLevel("api"); err != expectedErr {
+			t.Errorf("PromptBumpLevel() error = %v, want %v", err, expectedErr)
+		}
+		if _, err := mock.PromptSummary(); err != expectedErr {
+			t.Errorf("PromptSummary() error = %v, want %v", err, expectedErr)
+		}
+	})
+}
+
 func TestMockPrompter_Reset(t *testing.T) {
 	mock := NewMockPrompter()
 	mock.SelectionResult = AllModules()
@@ -22,8 +22,11 @@ func NewModulePrompt(modules []*workspace.Module) *ModulePrompt {
 	return &ModulePrompt{modules: modules}
 }
 
-// Ensure ModulePrompt implements Prompter.
-var _ Prompter = (*ModulePrompt)(nil)
+// Ensure ModulePrompt implements Prompter and ChangePrompter.
+var (
+	_ Prompter       = (*ModulePrompt)(nil)
+	_ ChangePrompter = (*ModulePrompt)(nil)
+)
 
 // PromptModuleSelection shows an interactive module selection UI.
 // First, it presents a choice: apply to all, select specific, or cancel.
@@ -134,6 +137,50 @@ func (p *ModulePrompt) ConfirmOperation(message string) (bool, error) {
 	return confirmed, nil
 }
 
+// PromptBumpLevel asks for the bump level of a module.
+func (p *ModulePrompt) PromptBumpLevel(module string) (string, error) {
+	level := "patch"
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(fmt.Sprintf("Bump level for %s", module)).
+				Options(
+					huh.NewOption("patch", "patch"),
+					huh.NewOption("minor", "minor"),
+					huh.NewOption("major", "major"),
+				).
+				Value(&level),
+		),
+	)
+
+	if err := form.Run(); err != nil {
+		return "", fmt.Errorf("bump level prompt failed: %w", err)
+	}
+
+	return level, nil
+}
+
+// PromptSummary asks for the summary of a change.
+func (p *ModulePrompt) PromptSummary() (string, error) {
+	var summary string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewText().
+				Title("Summary").
+				Description("Describe the change for the changelog").
+				Value(&summary),
+		),
+	)
+
+	if err := form.Run(); err != nil {
+		return "", fmt.Errorf("summary prompt failed: %w", err)
+	}
+
+	return strings.TrimSpace(summary), nil
+}
+
 // formatModuleList returns a formatted list of modules for display.
 func (p *ModulePrompt) formatModuleList() string {
 	if len(p.modules) == 0 {
@@ -17,6 +17,16 @@ type Prompter interface {
 	ConfirmOperation(message string) (bool, error)
 }
 
+// ChangePrompter abstracts the prompts recording a change file: the bump
+// level of each module and a summary of the change.
+type ChangePrompter interface {
+	// PromptBumpLevel asks for the bump level of a module: patch, minor or major.
+	PromptBumpLevel(module string) (string, error)
+
+	// PromptSummary asks for the summary of the change.
+	PromptSummary() (string, error)
+}
+
 // Selection represents the user's module selection from the TUI prompt.
 type Selection struct {
 	// All indicates if the user selected "apply to all modules".
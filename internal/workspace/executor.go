@@ -3,7 +3,7 @@ package workspace
 import (
 	"context"
 	"fmt"
-	"sync"
+	"slices"
 	"time"
 )
 
@@ -36,6 +36,14 @@ type ExecutionResult struct {
 	// Error contains the error if the operation failed.
 	Error error
 
+	// Skipped indicates the operation did not run, because a module it
+	// depends on failed or execution stopped. Error holds the reason.
+	Skipped bool
+
+	// RolledBack indicates the operation succeeded, but its changes were
+	// undone because the transaction it was part of failed.
+	RolledBack bool
+
 	// Duration is how long the operation took.
 	Duration time.Duration
 }
@@ -57,11 +65,30 @@ func WithFailFast(failFast bool) ExecutorOption {
 	}
 }
 
+// WithJobs limits how many modules are processed at the same time in parallel
+// mode. Zero or less means no limit.
+func WithJobs(jobs int) ExecutorOption {
+	return func(e *Executor) {
+		e.jobs = jobs
+	}
+}
+
+// WithGraph runs modules in dependency order: a module runs after the modules
+// it depends on, and is skipped if one of them fails. Independent modules
+// still run concurrently in parallel mode.
+func WithGraph(graph *Graph) ExecutorOption {
+	return func(e *Executor) {
+		e.graph = graph
+	}
+}
+
 // Executor executes operations on multiple modules.
 // It supports both sequential and parallel execution with error handling strategies.
 type Executor struct {
 	parallel bool
 	failFast bool
+	jobs     int
+	graph    *Graph
 }
 
 // NewExecutor creates a new Executor with the given options.
@@ -90,8 +117,15 @@ func (e *Executor) Run(ctx context.Context, modules []*Module, op Operation) ([]
 		return nil, fmt.Errorf("operation is nil")
 	}
 
-	if e.parallel {
-		return e.runParallel(ctx, modules, op)
+	if e.parallel || e.graph != nil {
+		jobs := 1
+		if e.parallel {
+			jobs = e.jobs
+			if jobs <= 0 {
+				jobs = len(modules)
+			}
+		}
+		return e.runScheduled(ctx, modules, op, jobs)
 	}
 
 	return e.runSequential(ctx, modules, op)
@@ -121,52 +155,161 @@ func (e *Executor) runSequential(ctx context.Context, modules []*Module, op Oper
 	return results, nil
 }
 
-// runParallel executes the operation on all modules in parallel.
-func (e *Executor) runParallel(ctx context.Context, modules []*Module, op Operation) ([]ExecutionResult, error) {
-	results := make([]ExecutionResult, len(modules))
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-	var firstError error
+// runScheduled executes the operation on up to jobs modules at a time. With a
+// dependency graph, a module starts once the modules it depends on succeeded,
+// and is skipped if one of them failed. Results are in module order.
+func (e *Executor) runScheduled(ctx context.Context, modules []*Module, op Operation, jobs int) ([]ExecutionResult, error) {
+	deps, err := e.dependencies(modules)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(modules)
+	pending := make([]int, n)
+	dependents := make([][]int, n)
+	for i, ds := range deps {
+		pending[i] = len(ds)
+		for _, d := range ds {
+			dependents[d] = append(dependents[d], i)
+		}
+	}
+
+	var ready []int
+	for i := range modules {
+		if pending[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
 
 	// Create a context that we can cancel if fail-fast is triggered
 	execCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	for i, mod := range modules {
-		wg.Add(1)
+	type completion struct {
+		idx    int
+		result ExecutionResult
+	}
+	completed := make(chan completion)
 
-		go func(idx int, module *Module) {
-			defer wg.Done()
+	results := make([]ExecutionResult, n)
+	settled := make([]bool, n)
+	running, remaining := 0, n
+	var firstError error
 
-			// Check if we should skip due to fail-fast
-			if e.failFast {
-				mu.Lock()
-				if firstError != nil {
-					mu.Unlock()
-					return
-				}
-				mu.Unlock()
+	// skip settles module i and its dependents as skipped
+	var skip func(i int, reason error)
+	skip = func(i int, reason error) {
+		if settled[i] {
+			return
+		}
+		settled[i] = true
+		remaining--
+		results[i] = ExecutionResult{Module: modules[i], OldVersion: modules[i].CurrentVersion, Skipped: true, Error: reason}
+		for _, j := range dependents[i] {
+			skip(j, fmt.Errorf("skipped: dependency %s failed", modules[i].Name))
+		}
+	}
+
+	stopped := false
+	for remaining > 0 {
+		if !stopped && ctx.Err() != nil {
+			stopped = true
+			firstError = ctx.Err()
+		}
+
+		for !stopped && running < jobs && len(ready) > 0 {
+			idx := ready[0]
+			ready = ready[1:]
+			if settled[idx] {
+				continue
+			}
+			running++
+			go func() {
+				completed <- completion{idx: idx, result: e.executeOperation(execCtx, modules[idx], op)}
+			}()
+		}
+
+		if running == 0 {
+			// Nothing left to run: settle what was never started
+			reason := firstError
+			if reason == nil {
+				reason = fmt.Errorf("skipped: not started")
+			}
+			for i := range modules {
+				skip(i, reason)
 			}
+			break
+		}
 
-			result := e.executeOperation(execCtx, module, op)
+		c := <-completed
+		running--
+		settled[c.idx] = true
+		remaining--
+		results[c.idx] = c.result
 
-			mu.Lock()
-			results[idx] = result
-			if e.failFast && !result.Success && firstError == nil {
-				firstError = result.Error
+		if !c.result.Success {
+			if e.failFast {
+				if firstError == nil {
+					firstError = fmt.Errorf("operation failed on module %s: %w", modules[c.idx].Name, c.result.Error)
+				}
+				stopped = true
 				cancel() // Cancel all other operations
+				continue
+			}
+			for _, j := range dependents[c.idx] {
+				skip(j, fmt.Errorf("skipped: dependency %s failed", modules[c.idx].Name))
+			}
+			continue
+		}
+
+		for _, j := range dependents[c.idx] {
+			pending[j]--
+			if pending[j] == 0 && !settled[j] {
+				ready = append(ready, j)
 			}
-			mu.Unlock()
-		}(i, mod)
+		}
 	}
 
-	wg.Wait()
+	if firstError != nil && (e.failFast || ctx.Err() != nil) {
+		return results, firstError
+	}
+	return results, nil
+}
 
-	if e.failFast && firstError != nil {
-		return results, fmt.Errorf("operation failed: %w", firstError)
+// dependencies returns, for each module, the indexes of the modules it depends
+// on among modules, directly or through modules that are not in the list.
+func (e *Executor) dependencies(modules []*Module) ([][]int, error) {
+	deps := make([][]int, len(modules))
+	if e.graph == nil {
+		return deps, nil
+	}
+	if _, err := e.graph.Order(); err != nil {
+		return nil, err
 	}
 
-	return results, nil
+	index := make(map[string]int, len(modules))
+	for i, mod := range modules {
+		index[mod.Name] = i
+	}
+
+	for i, mod := range modules {
+		seen := map[string]bool{}
+		queue := slices.Clone(e.graph.DependsOn(mod.Name))
+		for len(queue) > 0 {
+			name := queue[0]
+			queue = queue[1:]
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			if j, ok := index[name]; ok {
+				deps[i] = append(deps[i], j)
+				continue
+			}
+			queue = append(queue, e.graph.DependsOn(name)...)
+		}
+	}
+	return deps, nil
 }
 
 // executeOperation runs the operation on a single module and captures the result.
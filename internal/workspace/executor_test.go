@@ -6,6 +6,9 @@ import (
 	"sync"
 	"testing"
 	"time"
+
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
 )
 
 // mockOperation is a test helper that implements Operation.
@@ -826,3 +829,157 @@ func TestExecutor_RaceDetector_MixedSuccessFailure(t *testing.T) {
 		t.Error("Expected at least one success")
 	}
 }
+
+// chainGraph builds a graph where web depends on api, api on shared, and
+// docs is independent.
+func chainGraph(t *testing.T) ([]*Module, *Graph) {
+	t.Helper()
+	modules := []*Module{{Name: "web"}, {Name: "docs"}, {Name: "api"}, {Name: "shared"}}
+	cfg := &config.Config{Workspace: &config.WorkspaceConfig{
+		Modules: []config.ModuleConfig{
+			{Name: "web", DependsOn: []string{"api"}},
+			{Name: "api", DependsOn: []string{"shared"}},
+		},
+	}}
+	g, err := BuildGraph(core.NewMockFileSystem(), modules, cfg)
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+	return modules, g
+}
+
+func TestExecutor_Graph_DependencyOrder(t *testing.T) {
+	for _, parallel := range []bool{false, true} {
+		modules, g := chainGraph(t)
+
+		var mu sync.Mutex
+		var order []string
+		op := &mockOperation{
+			execFunc: func(ctx context.Context, mod *Module) error {
+				mu.Lock()
+				order = append(order, mod.Name)
+				mu.Unlock()
+				return nil
+			},
+		}
+
+		e := NewExecutor(WithParallel(parallel), WithGraph(g))
+		results, err := e.Run(context.Background(), modules, op)
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		if len(results) != len(modules) || SuccessCount(results) != len(modules) {
+			t.Fatalf("parallel=%v: expected %d successful results, got %+v", parallel, len(modules), results)
+		}
+
+		pos := map[string]int{}
+		for i, name := range order {
+			pos[name] = i
+		}
+		if pos["shared"] > pos["api"] || pos["api"] > pos["web"] {
+			t.Errorf("parallel=%v: expected dependencies first, got %v", parallel, order)
+		}
+	}
+}
+
+func TestExecutor_Graph_SkipsDependentsOnFailure(t *testing.T) {
+	modules, g := chainGraph(t)
+
+	var mu sync.Mutex
+	executed := map[string]bool{}
+	op := &mockOperation{
+		execFunc: func(ctx context.Context, mod *Module) error {
+			mu.Lock()
+			executed[mod.Name] = true
+			mu.Unlock()
+			if mod.Name == "shared" {
+				return errors.New("boom")
+			}
+			return nil
+		},
+	}
+
+	e := NewExecutor(WithParallel(true), WithGraph(g))
+	results, err := e.Run(context.Background(), modules, op)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if executed["api"] || executed["web"] {
+		t.Errorf("expected dependents of shared to be skipped, executed %v", executed)
+	}
+	if !executed["docs"] {
+		t.Error("expected independent module docs to run")
+	}
+
+	for _, result := range results {
+		switch result.Module.Name {
+		case "api", "web":
+			if !result.Skipped || result.Success || result.Error == nil {
+				t.Errorf("%s: expected skipped result, got %+v", result.Module.Name, result)
+			}
+		case "docs":
+			if !result.Success {
+				t.Errorf("docs: expected success, got %+v", result)
+			}
+		}
+	}
+	if ErrorCount(results) != 3 {
+		t.Errorf("expected 3 failed results (1 failure, 2 skipped), got %d", ErrorCount(results))
+	}
+}
+
+func TestExecutor_Jobs(t *testing.T) {
+	modules := make([]*Module, 8)
+	for i := range modules {
+		modules[i] = &Module{Name: string(rune('a' + i))}
+	}
+
+	var mu sync.Mutex
+	running, peak := 0, 0
+	op := &mockOperation{
+		execFunc: func(ctx context.Context, mod *Module) error {
+			mu.Lock()
+			running++
+			peak = max(peak, running)
+			mu.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+			return nil
+		},
+	}
+
+	e := NewExecutor(WithParallel(true), WithJobs(2))
+	results, err := e.Run(context.Background(), modules, op)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if SuccessCount(results) != len(modules) {
+		t.Errorf("expected %d successful results, got %d", len(modules), SuccessCount(results))
+	}
+	if peak > 2 {
+		t.Errorf("expected at most 2 concurrent modules, got %d", peak)
+	}
+}
+
+func TestExecutor_Graph_Cycle(t *testing.T) {
+	modules := []*Module{{Name: "a"}, {Name: "b"}}
+	cfg := &config.Config{Workspace: &config.WorkspaceConfig{
+		Modules: []config.ModuleConfig{
+			{Name: "a", DependsOn: []string{"b"}},
+			{Name: "b", DependsOn: []string{"a"}},
+		},
+	}}
+	g, err := BuildGraph(core.NewMockFileSystem(), modules, cfg)
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+
+	if _, err := NewExecutor(WithGraph(g)).Run(context.Background(), modules, &mockOperation{}); err == nil {
+		t.Error("expected dependency cycle error")
+	}
+}
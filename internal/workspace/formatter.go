@@ -14,6 +14,9 @@ type OutputFormatter interface {
 
 	// FormatModuleList formats a list of modules for display.
 	FormatModuleList(modules []*Module) string
+
+	// FormatPlan formats the entries of a release plan.
+	FormatPlan(entries []PlanEntry) string
 }
 
 // TextFormatter formats output as human-readable text.
@@ -102,6 +105,64 @@ func (f *TextFormatter) FormatModuleList(modules []*Module) string {
 	return sb.String()
 }
 
+// FormatPlan formats a release plan as text, with the reason, tags, files and
+// changelog entry of each module.
+func (f *TextFormatter) FormatPlan(entries []PlanEntry) string {
+	if len(entries) == 0 {
+		return "No modules found."
+	}
+
+	var sb strings.Builder
+	if f.operation != "" {
+		sb.WriteString(fmt.Sprintf("%s\n", f.operation))
+	}
+
+	for _, entry := range entries {
+		switch {
+		case entry.Error != nil:
+			sb.WriteString(fmt.Sprintf("  ✗ %s: %v\n", entry.Module.Name, entry.Error))
+			continue
+		case entry.Bumped():
+			sb.WriteString(fmt.Sprintf("  • %s: %s -> %s (%s, from %s)\n",
+				entry.Module.Name, entry.CurrentVersion, entry.NextVersion, entry.BumpType, entry.Source))
+		default:
+			sb.WriteString(fmt.Sprintf("  • %s: %s, not bumped\n", entry.Module.Name, entry.CurrentVersion))
+		}
+
+		if entry.Reason != "" {
+			sb.WriteString(fmt.Sprintf("      %s\n", entry.Reason))
+		}
+		if entry.Note != "" {
+			sb.WriteString(fmt.Sprintf("      %s\n", entry.Note))
+		}
+		if len(entry.Tags) > 0 {
+			sb.WriteString(fmt.Sprintf("      tags: %s\n", strings.Join(entry.Tags, ", ")))
+		}
+		if len(entry.Files) > 0 {
+			sb.WriteString(fmt.Sprintf("      files: %s\n", strings.Join(entry.Files, ", ")))
+		}
+		if entry.Changelog != "" {
+			sb.WriteString("      changelog:\n")
+			for line := range strings.SplitSeq(strings.TrimRight(entry.Changelog, "\n"), "\n") {
+				if line == "" {
+					sb.WriteString("\n")
+					continue
+				}
+				sb.WriteString(fmt.Sprintf("        %s\n", line))
+			}
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("Plan: %d of %d module%s to bump\n",
+		BumpedCount(entries),
+		len(entries),
+		pluralize(len(entries)),
+	))
+
+	return sb.String()
+}
+
 // JSONFormatter formats output as JSON.
 type JSONFormatter struct{}
 
@@ -118,6 +179,8 @@ type resultJSON struct {
 	NewVersion string `json:"new_version,omitempty"`
 	Success    bool   `json:"success"`
 	Error      string `json:"error,omitempty"`
+	Skipped    bool   `json:"skipped,omitempty"`
+	RolledBack bool   `json:"rolled_back,omitempty"`
 	Duration   string `json:"duration"`
 }
 
@@ -142,6 +205,8 @@ func (f *JSONFormatter) FormatResults(results []ExecutionResult) string {
 			OldVersion: result.OldVersion,
 			NewVersion: result.NewVersion,
 			Success:    result.Success,
+			Skipped:    result.Skipped,
+			RolledBack: result.RolledBack,
 			Duration:   result.Duration.String(),
 		}
 		if result.Error != nil {
@@ -212,6 +277,67 @@ func (f *JSONFormatter) FormatModuleList(modules []*Module) string {
 	return string(data)
 }
 
+// planEntryJSON is the JSON representation of a plan entry.
+type planEntryJSON struct {
+	Module         string   `json:"module"`
+	Path           string   `json:"path"`
+	CurrentVersion string   `json:"current_version,omitempty"`
+	NextVersion    string   `json:"next_version,omitempty"`
+	BumpType       string   `json:"bump_type,omitempty"`
+	Source         string   `json:"source,omitempty"`
+	Reason         string   `json:"reason,omitempty"`
+	Note           string   `json:"note,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+	Files          []string `json:"files,omitempty"`
+	Changelog      string   `json:"changelog,omitempty"`
+	Error          string   `json:"error,omitempty"`
+}
+
+// planJSON is the JSON representation of a release plan.
+type planJSON struct {
+	Modules []planEntryJSON `json:"modules"`
+	Total   int             `json:"total"`
+	Bumped  int             `json:"bumped"`
+}
+
+// FormatPlan formats a release plan as JSON.
+func (f *JSONFormatter) FormatPlan(entries []PlanEntry) string {
+	jsonEntries := make([]planEntryJSON, len(entries))
+
+	for i, entry := range entries {
+		e := planEntryJSON{
+			Module:         entry.Module.Name,
+			Path:           entry.Module.Path,
+			CurrentVersion: entry.CurrentVersion,
+			NextVersion:    entry.NextVersion,
+			BumpType:       entry.BumpType,
+			Source:         entry.Source,
+			Reason:         entry.Reason,
+			Note:           entry.Note,
+			Tags:           entry.Tags,
+			Files:          entry.Files,
+			Changelog:      entry.Changelog,
+		}
+		if entry.Error != nil {
+			e.Error = entry.Error.Error()
+		}
+		jsonEntries[i] = e
+	}
+
+	output := planJSON{
+		Modules: jsonEntries,
+		Total:   len(entries),
+		Bumped:  BumpedCount(entries),
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Sprintf(`{"error": "failed to marshal JSON: %s"}`, err.Error())
+	}
+
+	return string(data)
+}
+
 // formatDuration formats a duration in a human-readable way.
 func formatDuration(d time.Duration) string {
 	if d < time.Millisecond {
@@ -413,6 +539,103 @@ func (f *TableFormatter) FormatModuleList(modules []*Module) string {
 	return sb.String()
 }
 
+// FormatPlan formats a release plan as a table. Long reasons are truncated
+// and changelog entries are only counted; the text and JSON formats show
+// them in full.
+func (f *TableFormatter) FormatPlan(entries []PlanEntry) string {
+	if len(entries) == 0 {
+		return "No modules found."
+	}
+
+	var sb strings.Builder
+
+	// Header
+	if f.operation != "" {
+		sb.WriteString(fmt.Sprintf("%s\n\n", f.operation))
+	}
+
+	headers := []string{"Module", "Version", "Bump", "Source", "Tags", "Files", "Reason"}
+	rows := make([][]string, len(entries))
+	changelogs := 0
+	for i, entry := range entries {
+		version, bump, source, reason := entry.CurrentVersion, "-", "-", entry.Reason
+		switch {
+		case entry.Error != nil:
+			bump, reason = "FAILED", entry.Error.Error()
+		case entry.Bumped():
+			version = fmt.Sprintf("%s -> %s", entry.CurrentVersion, entry.NextVersion)
+			bump, source = entry.BumpType, entry.Source
+		}
+
+		// Truncate long reasons
+		maxReasonWidth := 50
+		if len(reason) > maxReasonWidth {
+			reason = reason[:maxReasonWidth-3] + "..."
+		}
+
+		if entry.Changelog != "" {
+			changelogs++
+		}
+		rows[i] = []string{entry.Module.Name, orDash(version), bump, source,
+			orDash(strings.Join(entry.Tags, ", ")), orDash(strings.Join(entry.Files, ", ")), orDash(reason)}
+	}
+
+	// Calculate column widths, with padding
+	widths := make([]int, len(headers))
+	for i, header := range headers {
+		widths[i] = len(header)
+		for _, row := range rows {
+			widths[i] = max(widths[i], len(row[i]))
+		}
+		widths[i] += 2
+	}
+
+	divider := "+"
+	for _, width := range widths {
+		divider += strings.Repeat("-", width+2) + "+"
+	}
+	divider += "\n"
+	writeRow := func(cells []string) {
+		sb.WriteString("|")
+		for i, cell := range cells {
+			sb.WriteString(fmt.Sprintf(" %-*s |", widths[i], cell))
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(divider)
+	writeRow(headers)
+	sb.WriteString(divider)
+	for _, row := range rows {
+		writeRow(row)
+	}
+	sb.WriteString(divider)
+
+	// Summary
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("Plan: %d of %d module%s to bump\n",
+		BumpedCount(entries),
+		len(entries),
+		pluralize(len(entries)),
+	))
+	if changelogs > 0 {
+		sb.WriteString(fmt.Sprintf("Changelog entries for %d module%s not shown; use the text or json format to see them\n",
+			changelogs,
+			pluralize(changelogs),
+		))
+	}
+
+	return sb.String()
+}
+
+// orDash returns s, or "-" when empty.
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
 // GetFormatter returns the appropriate formatter based on the format string.
 func GetFormatter(format string, operation string) OutputFormatter {
 	switch format {
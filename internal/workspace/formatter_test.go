@@ -619,3 +619,111 @@ func TestTableFormatter_FormatModuleList_LongPath(t *testing.T) {
 		t.Error("Long paths should be truncated with ...")
 	}
 }
+
+// planEntries returns a bumped, an unchanged and a failed plan entry.
+func planEntries() []PlanEntry {
+	return []PlanEntry{
+		{
+			Module:         &Module{Name: "api", Path: "/ws/api/.version"},
+			CurrentVersion: "1.2.0",
+			NextVersion:    "1.3.0",
+			BumpType:       "minor",
+			Source:         "commits",
+			Reason:         "commits touching api imply minor",
+			Tags:           []string{"api/v1.3.0"},
+			Files:          []string{"api/package.json"},
+			Changelog:      "## v1.3.0 - 2026-10-16\n\n### Features\n\n- add search\n",
+		},
+		{
+			Module:         &Module{Name: "web", Path: "/ws/web/.version"},
+			CurrentVersion: "2.0.0",
+			Reason:         "no commits touching web since its last tag",
+		},
+		{
+			Module: &Module{Name: "docs", Path: "/ws/docs/.version"},
+			Error:  errors.New("invalid version"),
+		},
+	}
+}
+
+func TestFormatPlan(t *testing.T) {
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{"text", []string{
+			"Release plan\n",
+			"  • api: 1.2.0 -> 1.3.0 (minor, from commits)\n      commits touching api imply minor\n",
+			"      tags: api/v1.3.0\n      files: api/package.json\n",
+			"      changelog:\n        ## v1.3.0 - 2026-10-16\n\n        ### Features\n",
+			"  • web: 2.0.0, not bumped\n",
+			"  ✗ docs: invalid version\n",
+			"Plan: 1 of 3 modules to bump\n",
+		}},
+		{"table", []string{
+			"| Module   | Version          | Bump     | Source    | Tags         | Files              | Reason                                       |",
+			"| api      | 1.2.0 -> 1.3.0   | minor    | commits   | api/v1.3.0   | api/package.json   | commits touching api imply minor             |",
+			"| web      | 2.0.0            | -        | -         | -            | -                  | no commits touching web since its last tag   |",
+			"| docs     | -                | FAILED   | -         | -            | -                  | invalid version                              |",
+			"Plan: 1 of 3 modules to bump\n",
+			"Changelog entries for 1 module not shown; use the text or json format to see them\n",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			output := GetFormatter(tt.format, "Release plan").FormatPlan(planEntries())
+			for _, want := range tt.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("output does not contain %q:\n%s", want, output)
+				}
+			}
+		})
+	}
+
+	for _, format := range []string{"text", "table"} {
+		if got := GetFormatter(format, "").FormatPlan(nil); got != "No modules found." {
+			t.Errorf("%s: FormatPlan(nil) = %q", format, got)
+		}
+	}
+}
+
+func TestTableFormatter_FormatPlan_LongReason(t *testing.T) {
+	entries := []PlanEntry{{
+		Module:         &Module{Name: "api"},
+		CurrentVersion: "1.2.0",
+		Reason:         "no commits touching api since its last tag api/v1.2.0 was created",
+	}}
+
+	output := NewTableFormatter("").FormatPlan(entries)
+
+	if !strings.Contains(output, "| no commits touching api since its last tag api/...   |") {
+		t.Errorf("long reason should be truncated with ...:\n%s", output)
+	}
+	if strings.Contains(output, "Changelog entries") {
+		t.Errorf("plan without changelog entries should not mention them:\n%s", output)
+	}
+}
+
+func TestJSONFormatter_FormatPlan(t *testing.T) {
+	output := NewJSONFormatter().FormatPlan(planEntries())
+
+	var plan planJSON
+	if err := json.Unmarshal([]byte(output), &plan); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, output)
+	}
+	if plan.Total != 3 || plan.Bumped != 1 {
+		t.Errorf("total = %d, bumped = %d, want 3 and 1", plan.Total, plan.Bumped)
+	}
+
+	api := plan.Modules[0]
+	if api.NextVersion != "1.3.0" || api.Source != "commits" || len(api.Tags) != 1 || !strings.Contains(api.Changelog, "add search") {
+		t.Errorf("unexpected api entry: %+v", api)
+	}
+	if plan.Modules[1].NextVersion != "" || plan.Modules[1].Reason == "" {
+		t.Errorf("unexpected web entry: %+v", plan.Modules[1])
+	}
+	if plan.Modules[2].Error != "invalid version" {
+		t.Errorf("docs error = %q", plan.Modules[2].Error)
+	}
+}
@@ -0,0 +1,227 @@
+package workspace
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
+)
+
+// Graph holds the dependencies between the modules of a workspace.
+type Graph struct {
+	modules   []*Module
+	byName    map[string]*Module
+	deps      map[string][]string
+	manifests map[string]*manifest
+}
+
+// BuildGraph builds the dependency graph of modules. Dependencies come from the
+// depends-on entries of configured modules and, unless detection is disabled,
+// from the go.mod and package.json files in the module directories.
+func BuildGraph(fs core.FileSystem, modules []*Module, cfg *config.Config) (*Graph, error) {
+	g := &Graph{
+		modules:   modules,
+		byName:    make(map[string]*Module, len(modules)),
+		deps:      make(map[string][]string, len(modules)),
+		manifests: make(map[string]*manifest, len(modules)),
+	}
+	for _, mod := range modules {
+		g.byName[mod.Name] = mod
+	}
+
+	if cfg != nil && cfg.Workspace != nil {
+		for _, mc := range cfg.Workspace.Modules {
+			if _, ok := g.byName[mc.Name]; !ok {
+				continue
+			}
+			for _, dep := range mc.DependsOn {
+				if _, ok := g.byName[dep]; !ok {
+					return nil, fmt.Errorf("module %q depends on unknown module %q", mc.Name, dep)
+				}
+				g.addDependency(mc.Name, dep)
+			}
+		}
+	}
+
+	var depsCfg *config.DependenciesConfig
+	if cfg != nil && cfg.Workspace != nil {
+		depsCfg = cfg.Workspace.Dependencies
+	}
+	if depsCfg.GetDetect() {
+		for _, mod := range modules {
+			m, err := readManifest(fs, mod.Dir)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read manifests of module %s: %w", mod.Name, err)
+			}
+			g.manifests[mod.Name] = m
+		}
+		for _, mod := range modules {
+			for _, dep := range modules {
+				if dep != mod && g.manifests[mod.Name].references(g.manifests[dep.Name]) {
+					g.addDependency(mod.Name, dep.Name)
+				}
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// addDependency records that module name depends on module dep.
+func (g *Graph) addDependency(name, dep string) {
+	if name == dep || slices.Contains(g.deps[name], dep) {
+		return
+	}
+	g.deps[name] = append(g.deps[name], dep)
+	slices.Sort(g.deps[name])
+}
+
+// Modules returns the modules of the graph.
+func (g *Graph) Modules() []*Module {
+	return g.modules
+}
+
+// Module returns the module with the given name, or nil.
+func (g *Graph) Module(name string) *Module {
+	return g.byName[name]
+}
+
+// DependsOn returns the names of the modules the named module depends on.
+func (g *Graph) DependsOn(name string) []string {
+	return g.deps[name]
+}
+
+// Dependents returns the names of the modules that depend on the named module.
+func (g *Graph) Dependents(name string) []string {
+	var dependents []string
+	for _, mod := range g.modules {
+		if slices.Contains(g.deps[mod.Name], name) {
+			dependents = append(dependents, mod.Name)
+		}
+	}
+	return dependents
+}
+
+// Order returns the modules sorted so that every module comes after the
+// modules it depends on. Modules without a dependency between them keep their
+// order. It returns an error if the dependencies form a cycle.
+func (g *Graph) Order() ([]*Module, error) {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(g.modules))
+	order := make([]*Module, 0, len(g.modules))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case done:
+			return nil
+		case visiting:
+			start := slices.Index(path, name)
+			cycle := append(slices.Clone(path[start:]), name)
+			return fmt.Errorf("dependency cycle between modules: %s", strings.Join(cycle, " -> "))
+		}
+		state[name] = visiting
+		for _, dep := range g.deps[name] {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = done
+		order = append(order, g.byName[name])
+		return nil
+	}
+
+	for _, mod := range g.modules {
+		if err := visit(mod.Name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// UpdateReferences updates the references of the named module to the named
+// dependency in its go.mod and package.json files to version. It returns the
+// paths of the updated files.
+func (g *Graph) UpdateReferences(fs core.FileSystem, name, dependency, version string) ([]string, error) {
+	m, dep := g.manifests[name], g.manifests[dependency]
+	if m == nil || dep == nil {
+		return nil, nil
+	}
+	return m.updateReferences(fs, dep, version)
+}
+
+// ManifestPaths returns the paths of the go.mod and package.json files of the
+// named module that UpdateReferences may change.
+func (g *Graph) ManifestPaths(name string) []string {
+	m := g.manifests[name]
+	if m == nil {
+		return nil
+	}
+	var paths []string
+	for _, path := range []string{m.goModPath, m.pkgPath} {
+		if path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// FormatText renders the graph as a list of modules with their dependencies.
+func (g *Graph) FormatText() string {
+	var sb strings.Builder
+	for _, mod := range g.modules {
+		sb.WriteString(mod.Name)
+		if deps := g.deps[mod.Name]; len(deps) > 0 {
+			fmt.Fprintf(&sb, " -> %s", strings.Join(deps, ", "))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// FormatDOT renders the graph in the Graphviz DOT language. Edges point from
+// a module to its dependencies.
+func (g *Graph) FormatDOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph modules {\n")
+	for _, mod := range g.modules {
+		fmt.Fprintf(&sb, "  %q;\n", mod.Name)
+	}
+	for _, mod := range g.modules {
+		for _, dep := range g.deps[mod.Name] {
+			fmt.Fprintf(&sb, "  %q -> %q;\n", mod.Name, dep)
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// mermaidIDRe matches characters not allowed in Mermaid node IDs.
+var mermaidIDRe = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// FormatMermaid renders the graph as a Mermaid flowchart. Edges point from a
+// module to its dependencies.
+func (g *Graph) FormatMermaid() string {
+	id := func(name string) string {
+		return mermaidIDRe.ReplaceAllString(name, "_")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("graph TD\n")
+	for _, mod := range g.modules {
+		fmt.Fprintf(&sb, "  %s[%q]\n", id(mod.Name), mod.Name)
+	}
+	for _, mod := range g.modules {
+		for _, dep := range g.deps[mod.Name] {
+			fmt.Fprintf(&sb, "  %s --> %s\n", id(mod.Name), id(dep))
+		}
+	}
+	return sb.String()
+}
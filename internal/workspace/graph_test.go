@@ -0,0 +1,151 @@
+package workspace
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/indaco/verso/internal/config"
+)
+
+func graphModules() []*Module {
+	return []*Module{
+		{Name: "web", Dir: "/repo/web", Path: "/repo/web/.version"},
+		{Name: "api", Dir: "/repo/api", Path: "/repo/api/.version"},
+		{Name: "shared", Dir: "/repo/shared", Path: "/repo/shared/.version"},
+	}
+}
+
+func TestBuildGraph_Detection(t *testing.T) {
+	fs := setupTestFS(map[string]string{
+		"/repo/shared/go.mod":       "module example.com/repo/shared\n\ngo 1.22\n",
+		"/repo/api/go.mod":          "module example.com/repo/api\n\nrequire (\n\texample.com/repo/shared v1.2.0\n\tgithub.com/x/y v0.1.0\n)\n",
+		"/repo/shared/package.json": `{"name": "@repo/shared"}`,
+		"/repo/web/package.json":    `{"name": "@repo/web", "dependencies": {"@repo/shared": "^1.2.0", "react": "^18.0.0"}}`,
+	})
+
+	g, err := BuildGraph(fs, graphModules(), &config.Config{})
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+
+	if got := g.DependsOn("api"); len(got) != 1 || got[0] != "shared" {
+		t.Errorf("DependsOn(api) = %v, want [shared]", got)
+	}
+	if got := g.DependsOn("web"); len(got) != 1 || got[0] != "shared" {
+		t.Errorf("DependsOn(web) = %v, want [shared]", got)
+	}
+	if got := g.Dependents("shared"); len(got) != 2 || got[0] != "web" || got[1] != "api" {
+		t.Errorf("Dependents(shared) = %v, want [web api]", got)
+	}
+}
+
+func TestGraph_ManifestPaths(t *testing.T) {
+	fs := setupTestFS(map[string]string{
+		"/repo/api/go.mod":          "module example.com/repo/api\n",
+		"/repo/api/package.json":    `{"name": "@repo/api"}`,
+		"/repo/shared/package.json": `{"name": "@repo/shared"}`,
+	})
+
+	g, err := BuildGraph(fs, graphModules(), &config.Config{})
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+
+	if got := g.ManifestPaths("api"); len(got) != 2 || got[0] != "/repo/api/go.mod" || got[1] != "/repo/api/package.json" {
+		t.Errorf("ManifestPaths(api) = %v", got)
+	}
+	if got := g.ManifestPaths("shared"); len(got) != 1 || got[0] != "/repo/shared/package.json" {
+		t.Errorf("ManifestPaths(shared) = %v", got)
+	}
+	if got := g.ManifestPaths("web"); len(got) != 0 {
+		t.Errorf("ManifestPaths(web) = %v, want none", got)
+	}
+}
+
+func TestBuildGraph_Config(t *testing.T) {
+	detect := false
+	cfg := &config.Config{Workspace: &config.WorkspaceConfig{
+		Modules: []config.ModuleConfig{
+			{Name: "web", Path: "web/.version", DependsOn: []string{"api"}},
+			{Name: "api", Path: "api/.version", DependsOn: []string{"shared"}},
+		},
+		Dependencies: &config.DependenciesConfig{Detect: &detect},
+	}}
+	fs := setupTestFS(map[string]string{
+		"/repo/web/package.json": `{"dependencies": {"@repo/shared": "1.0.0"}}`,
+	})
+
+	g, err := BuildGraph(fs, graphModules(), cfg)
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+	if got := g.DependsOn("web"); len(got) != 1 || got[0] != "api" {
+		t.Errorf("DependsOn(web) = %v, want [api]", got)
+	}
+
+	cfg.Workspace.Modules[0].DependsOn = []string{"billing"}
+	if _, err := BuildGraph(fs, graphModules(), cfg); err == nil || !strings.Contains(err.Error(), "unknown module") {
+		t.Errorf("expected unknown module error, got %v", err)
+	}
+}
+
+func TestGraph_Order(t *testing.T) {
+	cfg := &config.Config{Workspace: &config.WorkspaceConfig{
+		Modules: []config.ModuleConfig{
+			{Name: "web", DependsOn: []string{"api", "shared"}},
+			{Name: "api", DependsOn: []string{"shared"}},
+		},
+	}}
+
+	g, err := BuildGraph(setupTestFS(nil), graphModules(), cfg)
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+
+	order, err := g.Order()
+	if err != nil {
+		t.Fatalf("Order() error = %v", err)
+	}
+	var names []string
+	for _, mod := range order {
+		names = append(names, mod.Name)
+	}
+	if strings.Join(names, ",") != "api,shared,web" && strings.Join(names, ",") != "shared,api,web" {
+		t.Errorf("Order() = %v, want dependencies first", names)
+	}
+
+	cfg.Workspace.Modules = append(cfg.Workspace.Modules, config.ModuleConfig{Name: "shared", DependsOn: []string{"web"}})
+	g, err = BuildGraph(setupTestFS(nil), graphModules(), cfg)
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+	if _, err := g.Order(); err == nil || !strings.Contains(err.Error(), "dependency cycle") {
+		t.Errorf("expected dependency cycle error, got %v", err)
+	}
+}
+
+func TestGraph_Format(t *testing.T) {
+	cfg := &config.Config{Workspace: &config.WorkspaceConfig{
+		Modules: []config.ModuleConfig{{Name: "web", DependsOn: []string{"api"}}},
+	}}
+	modules := []*Module{{Name: "web"}, {Name: "api"}}
+	g, err := BuildGraph(setupTestFS(nil), modules, cfg)
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"text", g.FormatText(), "web -> api\napi\n"},
+		{"dot", g.FormatDOT(), "digraph modules {\n  \"web\";\n  \"api\";\n  \"web\" -> \"api\";\n}\n"},
+		{"mermaid", g.FormatMermaid(), "graph TD\n  web[\"web\"]\n  api[\"api\"]\n  web --> api\n"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s format = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package workspace
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/indaco/verso/internal/config"
+)
+
+// Group is a set of modules versioned in lockstep: its members always share a
+// version.
+type Group struct {
+	// Name is the group identifier.
+	Name string
+
+	// Modules lists the member modules, in configuration order.
+	Modules []*Module
+}
+
+// BuildGroups resolves the groups configured in the workspace against
+// modules. A module belongs to at most one group. Members that are not in
+// modules, such as disabled modules, are left out.
+func BuildGroups(modules []*Module, cfg *config.Config) ([]*Group, error) {
+	if cfg == nil || cfg.Workspace == nil {
+		return nil, nil
+	}
+
+	byName := make(map[string]*Module, len(modules))
+	for _, mod := range modules {
+		byName[mod.Name] = mod
+	}
+
+	groups := make([]*Group, 0, len(cfg.Workspace.Groups))
+	memberOf := map[string]string{}
+	for _, gc := range cfg.Workspace.Groups {
+		if gc.Name == "" {
+			return nil, fmt.Errorf("group with modules %v has no name", gc.Modules)
+		}
+		if slices.ContainsFunc(groups, func(g *Group) bool { return g.Name == gc.Name }) {
+			return nil, fmt.Errorf("group %q is defined more than once", gc.Name)
+		}
+
+		if len(gc.Modules) == 0 {
+			return nil, fmt.Errorf("group %q has no modules", gc.Name)
+		}
+
+		group := &Group{Name: gc.Name}
+		for _, name := range gc.Modules {
+			if other, ok := memberOf[name]; ok {
+				return nil, fmt.Errorf("module %q belongs to groups %q and %q", name, other, gc.Name)
+			}
+			memberOf[name] = gc.Name
+			if mod, ok := byName[name]; ok {
+				group.Modules = append(group.Modules, mod)
+			}
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// Has reports whether the named module is a member of the group.
+func (g *Group) Has(name string) bool {
+	return slices.ContainsFunc(g.Modules, func(mod *Module) bool { return mod.Name == name })
+}
+
+// Drifted reports whether the members of the group have different versions.
+func (g *Group) Drifted() bool {
+	for _, mod := range g.Modules {
+		if mod.CurrentVersion != g.Modules[0].CurrentVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupOf returns the group the named module belongs to, or nil.
+func GroupOf(groups []*Group, name string) *Group {
+	for _, g := range groups {
+		if g.Has(name) {
+			return g
+		}
+	}
+	return nil
+}
+
+// ExpandGroups adds to modules the members of every group one of them belongs
+// to, so that groups are always operated on as a whole. Added members follow
+// the selected modules.
+func ExpandGroups(modules []*Module, groups []*Group) []*Module {
+	expanded := slices.Clone(modules)
+	for _, mod := range modules {
+		g := GroupOf(groups, mod.Name)
+		if g == nil {
+			continue
+		}
+		for _, member := range g.Modules {
+			if !slices.ContainsFunc(expanded, func(m *Module) bool { return m.Name == member.Name }) {
+				expanded = append(expanded, member)
+			}
+		}
+	}
+	return expanded
+}
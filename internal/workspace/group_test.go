@@ -0,0 +1,135 @@
+package workspace
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/indaco/verso/internal/config"
+)
+
+func groupModules() []*Module {
+	return []*Module{
+		{Name: "sdk", CurrentVersion: "1.2.0"},
+		{Name: "cli", CurrentVersion: "1.2.0"},
+		{Name: "server", CurrentVersion: "1.1.0"},
+		{Name: "docs", CurrentVersion: "0.3.0"},
+	}
+}
+
+func groupNames(modules []*Module) []string {
+	names := make([]string, 0, len(modules))
+	for _, mod := range modules {
+		names = append(names, mod.Name)
+	}
+	return names
+}
+
+func TestBuildGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		groups  []config.GroupConfig
+		want    map[string][]string
+		wantErr string
+	}{
+		{
+			name:   "members resolved in configuration order",
+			groups: []config.GroupConfig{{Name: "platform", Modules: []string{"server", "sdk", "cli"}}},
+			want:   map[string][]string{"platform": {"server", "sdk", "cli"}},
+		},
+		{
+			name:   "unknown members are left out",
+			groups: []config.GroupConfig{{Name: "platform", Modules: []string{"sdk", "legacy"}}},
+			want:   map[string][]string{"platform": {"sdk"}},
+		},
+		{
+			name:    "module in two groups",
+			groups:  []config.GroupConfig{{Name: "a", Modules: []string{"sdk"}}, {Name: "b", Modules: []string{"sdk"}}},
+			wantErr: `module "sdk" belongs to groups "a" and "b"`,
+		},
+		{
+			name:    "duplicate group name",
+			groups:  []config.GroupConfig{{Name: "a", Modules: []string{"sdk"}}, {Name: "a", Modules: []string{"cli"}}},
+			wantErr: `group "a" is defined more than once`,
+		},
+		{
+			name:    "group without modules",
+			groups:  []config.GroupConfig{{Name: "a"}},
+			wantErr: `group "a" has no modules`,
+		},
+		{
+			name:    "missing group name",
+			groups:  []config.GroupConfig{{Modules: []string{"sdk"}}},
+			wantErr: "group with modules [sdk] has no name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{Workspace: &config.WorkspaceConfig{Groups: tt.groups}}
+			groups, err := BuildGroups(groupModules(), cfg)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("BuildGroups() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BuildGroups() error = %v", err)
+			}
+			if len(groups) != len(tt.want) {
+				t.Fatalf("BuildGroups() returned %d groups, want %d", len(groups), len(tt.want))
+			}
+			for _, g := range groups {
+				if got := groupNames(g.Modules); !slices.Equal(got, tt.want[g.Name]) {
+					t.Errorf("group %s = %v, want %v", g.Name, got, tt.want[g.Name])
+				}
+			}
+		})
+	}
+}
+
+func TestBuildGroups_NoWorkspace(t *testing.T) {
+	groups, err := BuildGroups(groupModules(), &config.Config{})
+	if err != nil || len(groups) != 0 {
+		t.Errorf("BuildGroups() = %v, %v, want no groups", groups, err)
+	}
+}
+
+func TestGroup_Drifted(t *testing.T) {
+	modules := groupModules()
+	inSync := &Group{Name: "a", Modules: modules[:2]}
+	drifted := &Group{Name: "b", Modules: modules[:3]}
+
+	if inSync.Drifted() {
+		t.Error("expected sdk and cli to be in sync")
+	}
+	if !drifted.Drifted() {
+		t.Error("expected server to have drifted")
+	}
+}
+
+func TestExpandGroups(t *testing.T) {
+	modules := groupModules()
+	groups := []*Group{{Name: "platform", Modules: modules[:3]}}
+
+	tests := []struct {
+		name     string
+		selected []*Module
+		want     []string
+	}{
+		{"member adds the group", []*Module{modules[1]}, []string{"cli", "sdk", "server"}},
+		{"non-member unchanged", []*Module{modules[3]}, []string{"docs"}},
+		{"no duplicates", []*Module{modules[3], modules[2], modules[0]}, []string{"docs", "server", "sdk", "cli"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := groupNames(ExpandGroups(tt.selected, groups)); !slices.Equal(got, tt.want) {
+				t.Errorf("ExpandGroups() = %v, want %v", got, tt.want)
+			}
+			if GroupOf(groups, "docs") != nil {
+				t.Error("GroupOf(docs) expected nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package workspace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/indaco/verso/internal/core"
+	"github.com/indaco/verso/internal/semver"
+)
+
+// manifest holds the package identity and the dependencies a module declares
+// in its go.mod and package.json files.
+type manifest struct {
+	goModPath string
+	goModule  string
+	goMod     string
+	// requireRe matches requirements of goModule, compiled once per module.
+	requireRe *regexp.Regexp
+
+	pkgPath string
+	pkgName string
+	pkgDeps map[string]bool
+	// dependencyRe matches dependencies on pkgName, compiled once per module.
+	dependencyRe *regexp.Regexp
+}
+
+// packageJSON is the part of package.json read to detect dependencies.
+type packageJSON struct {
+	Name                 string            `json:"name"`
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+}
+
+var (
+	// Matches the module directive of go.mod.
+	goModuleRe = regexp.MustCompile(`(?m)^module\s+(\S+)`)
+
+	// Matches the major version suffix of a Go module path: "/v2".
+	goMajorSuffixRe = regexp.MustCompile(`/v(\d+)$`)
+)
+
+// readManifest reads the go.mod and package.json files in dir. Missing files
+// are skipped.
+func readManifest(fsys core.FileSystem, dir string) (*manifest, error) {
+	m := &manifest{pkgDeps: map[string]bool{}}
+
+	goModPath := filepath.Join(dir, "go.mod")
+	data, err := fsys.ReadFile(goModPath)
+	switch {
+	case err == nil:
+		m.goModPath, m.goMod = goModPath, string(data)
+		if match := goModuleRe.FindStringSubmatch(m.goMod); match != nil {
+			m.goModule = match[1]
+			m.requireRe = goRequireRe(m.goModule)
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, err
+	}
+
+	pkgPath := filepath.Join(dir, "package.json")
+	data, err = fsys.ReadFile(pkgPath)
+	switch {
+	case err == nil:
+		var pkg packageJSON
+		if err := json.Unmarshal(data, &pkg); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", pkgPath, err)
+		}
+		m.pkgPath, m.pkgName = pkgPath, pkg.Name
+		if m.pkgName != "" {
+			m.dependencyRe = pkgDependencyRe(m.pkgName)
+		}
+		for _, deps := range []map[string]string{pkg.Dependencies, pkg.DevDependencies, pkg.PeerDependencies, pkg.OptionalDependencies} {
+			for name := range deps {
+				m.pkgDeps[name] = true
+			}
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// goRequireRe matches the requirement of the Go module path in go.mod, either
+// on a require line or inside a require block.
+func goRequireRe(path string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)^(\s*(?:require\s+)?` + regexp.QuoteMeta(path) + `\s+)(v\S+)`)
+}
+
+// pkgDependencyRe matches a package.json dependency on the named package with
+// an exact, caret, tilde or minimum version.
+func pkgDependencyRe(name string) *regexp.Regexp {
+	return regexp.MustCompile(`("` + regexp.QuoteMeta(name) + `"\s*:\s*"(?:[\^~]|>=|=)?)(\d+\.\d+\.\d+[^"]*)(")`)
+}
+
+// references reports whether m declares a dependency on the module of dep.
+func (m *manifest) references(dep *manifest) bool {
+	if m.goMod != "" && dep.requireRe != nil && dep.requireRe.MatchString(m.goMod) {
+		return true
+	}
+	return dep.pkgName != "" && m.pkgDeps[dep.pkgName]
+}
+
+// updateReferences sets the version of the references to dep and returns the
+// paths of the updated files. Go requirements are only updated if the module
+// path allows the new major version ("/vN" for N >= 2). Files are replaced
+// atomically through fsys.WriteFile (core.WriteFileAtomic on disk).
+func (m *manifest) updateReferences(fsys core.FileSystem, dep *manifest, version string) ([]string, error) {
+	var updated []string
+
+	if m.goMod != "" && dep.requireRe != nil && goAllowsVersion(dep.goModule, version) {
+		content := dep.requireRe.ReplaceAllString(m.goMod, "${1}v"+version)
+		if content != m.goMod {
+			if err := fsys.WriteFile(m.goModPath, []byte(content), 0644); err != nil {
+				return updated, fmt.Errorf("failed to write %s: %w", m.goModPath, err)
+			}
+			m.goMod = content
+			updated = append(updated, m.goModPath)
+		}
+	}
+
+	if m.pkgPath != "" && dep.dependencyRe != nil && m.pkgDeps[dep.pkgName] {
+		data, err := fsys.ReadFile(m.pkgPath)
+		if err != nil {
+			return updated, err
+		}
+		content := dep.dependencyRe.ReplaceAllString(string(data), "${1}"+version+"${3}")
+		if content != string(data) {
+			if err := fsys.WriteFile(m.pkgPath, []byte(content), 0644); err != nil {
+				return updated, fmt.Errorf("failed to write %s: %w", m.pkgPath, err)
+			}
+			updated = append(updated, m.pkgPath)
+		}
+	}
+
+	return updated, nil
+}
+
+// goAllowsVersion reports whether the Go module path can be required at version.
+func goAllowsVersion(path, version string) bool {
+	v, err := semver.ParseVersion(version)
+	if err != nil {
+		return false
+	}
+	if match := goMajorSuffixRe.FindStringSubmatch(path); match != nil {
+		major, _ := strconv.Atoi(match[1])
+		return v.Major == major
+	}
+	return v.Major <= 1
+}
@@ -0,0 +1,133 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/indaco/verso/internal/config"
+	"github.com/indaco/verso/internal/core"
+)
+
+func TestGraph_UpdateReferences(t *testing.T) {
+	fs := setupTestFS(map[string]string{
+		"/repo/shared/go.mod":       "module example.com/repo/shared\n",
+		"/repo/api/go.mod":          "module example.com/repo/api\n\nrequire example.com/repo/shared v1.2.0\n",
+		"/repo/shared/package.json": `{"name": "@repo/shared"}`,
+		"/repo/web/package.json":    "{\n  \"dependencies\": {\n    \"@repo/shared\": \"^1.2.0\"\n  },\n  \"devDependencies\": {\n    \"@repo/shared-dev\": \"1.0.0\"\n  }\n}\n",
+	})
+
+	g, err := BuildGraph(fs, graphModules(), &config.Config{})
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		module  string
+		version string
+		path    string
+		want    string
+	}{
+		{
+			name: "go.mod require", module: "api", version: "1.3.0", path: "/repo/api/go.mod",
+			want: "module example.com/repo/api\n\nrequire example.com/repo/shared v1.3.0\n",
+		},
+		{
+			name: "go.mod major needs a new module path", module: "api", version: "2.0.0", path: "/repo/api/go.mod",
+			want: "module example.com/repo/api\n\nrequire example.com/repo/shared v1.3.0\n",
+		},
+		{
+			name: "package.json keeps the range", module: "web", version: "1.3.0", path: "/repo/web/package.json",
+			want: "{\n  \"dependencies\": {\n    \"@repo/shared\": \"^1.3.0\"\n  },\n  \"devDependencies\": {\n    \"@repo/shared-dev\": \"1.0.0\"\n  }\n}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := g.UpdateReferences(fs, tt.module, "shared", tt.version); err != nil {
+				t.Fatalf("UpdateReferences() error = %v", err)
+			}
+			data, err := fs.ReadFile(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("%s = %q, want %q", tt.path, data, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_UpdateReferences_ReplacesAtomically(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"shared/go.mod": "module example.com/repo/shared\n",
+		"api/go.mod":    "module example.com/repo/api\n\nrequire example.com/repo/shared v1.2.0\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	modules := []*Module{
+		{Name: "api", Dir: filepath.Join(root, "api"), Path: filepath.Join(root, "api", ".version")},
+		{Name: "shared", Dir: filepath.Join(root, "shared"), Path: filepath.Join(root, "shared", ".version")},
+	}
+
+	fs := core.NewOSFileSystem()
+	g, err := BuildGraph(fs, modules, &config.Config{})
+	if err != nil {
+		t.Fatalf("BuildGraph() error = %v", err)
+	}
+
+	goMod := filepath.Join(root, "api", "go.mod")
+	before, err := os.Stat(goMod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.UpdateReferences(fs, "api", "shared", "1.3.0"); err != nil {
+		t.Fatalf("UpdateReferences() error = %v", err)
+	}
+
+	after, err := os.Stat(goMod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if os.SameFile(before, after) {
+		t.Error("expected go.mod to be replaced by a rename, not rewritten in place")
+	}
+	if after.Mode().Perm() != 0600 {
+		t.Errorf("go.mod mode = %v, want 0600 kept", after.Mode().Perm())
+	}
+	entries, err := os.ReadDir(filepath.Dir(goMod))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected no temporary files left, got %d entries", len(entries))
+	}
+}
+
+func TestGoAllowsVersion(t *testing.T) {
+	tests := []struct {
+		path    string
+		version string
+		want    bool
+	}{
+		{"example.com/lib", "0.4.0", true},
+		{"example.com/lib", "1.9.0", true},
+		{"example.com/lib", "2.0.0", false},
+		{"example.com/lib/v2", "2.1.0", true},
+		{"example.com/lib/v2", "3.0.0", false},
+	}
+	for _, tt := range tests {
+		if got := goAllowsVersion(tt.path, tt.version); got != tt.want {
+			t.Errorf("goAllowsVersion(%q, %q) = %v, want %v", tt.path, tt.version, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package workspace
+
+// PlanEntry is the pending version change of a module, as previewed by a
+// release plan. Nothing is written to compute it.
+type PlanEntry struct {
+	// Module is the planned module.
+	Module *Module
+
+	// CurrentVersion is the version of the module.
+	CurrentVersion string
+
+	// NextVersion is the version the release would write, or empty if the
+	// module is not bumped.
+	NextVersion string
+
+	// BumpType is the planned bump: major, minor, patch, or auto to promote a
+	// pre-release or bump patch. Empty if the module is not bumped.
+	BumpType string
+
+	// Source is what decided the bump: changes, changelog, commits, cascade
+	// or default.
+	Source string
+
+	// Reason explains the bump, or why the module is not bumped.
+	Reason string
+
+	// Note describes adjustments to the bump, such as the initial
+	// development policy.
+	Note string
+
+	// Tags lists the git tags the release would create.
+	Tags []string
+
+	// Files lists the dependency files the release would sync.
+	Files []string
+
+	// Changelog is the changelog entry the release would write.
+	Changelog string
+
+	// Error is set if the module could not be planned.
+	Error error
+}
+
+// Bumped reports whether the plan bumps the module.
+func (e *PlanEntry) Bumped() bool {
+	return e.Error == nil && e.NextVersion != ""
+}
+
+// BumpedCount returns the number of modules the plan bumps.
+func BumpedCount(entries []PlanEntry) int {
+	count := 0
+	for i := range entries {
+		if entries[i].Bumped() {
+			count++
+		}
+	}
+	return count
+}
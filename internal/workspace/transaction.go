@@ -0,0 +1,149 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/indaco/verso/internal/core"
+)
+
+// Transaction records the content of files before an operation changes them,
+// so that a failed multi-module operation can restore every module.
+type Transaction struct {
+	fs core.FileSystem
+
+	mu        sync.Mutex
+	paths     []string
+	snapshots map[string]fileSnapshot
+}
+
+// fileSnapshot is the content of a file when it was first recorded.
+type fileSnapshot struct {
+	data   []byte
+	perm   fs.FileMode
+	exists bool
+}
+
+// RollbackReport lists the files a rollback restored or removed.
+type RollbackReport struct {
+	// Restored lists the files whose original content was written back.
+	Restored []string
+
+	// Removed lists the files created during the transaction.
+	Removed []string
+}
+
+// NewTransaction creates a transaction over the file system.
+func NewTransaction(fs core.FileSystem) *Transaction {
+	return &Transaction{fs: fs, snapshots: map[string]fileSnapshot{}}
+}
+
+// Snapshot records the current content of paths. A path keeps its first
+// snapshot. Missing files are recorded too, so that rollback removes them.
+func (tx *Transaction) Snapshot(paths ...string) error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	for _, path := range paths {
+		path = filepath.Clean(path)
+		if _, ok := tx.snapshots[path]; ok {
+			continue
+		}
+
+		var snap fileSnapshot
+		info, err := tx.fs.Stat(path)
+		switch {
+		case err == nil:
+			data, err := tx.fs.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("failed to snapshot %s: %w", path, err)
+			}
+			snap = fileSnapshot{data: data, perm: info.Mode().Perm(), exists: true}
+		case !errors.Is(err, fs.ErrNotExist):
+			return fmt.Errorf("failed to snapshot %s: %w", path, err)
+		}
+
+		tx.snapshots[path] = snap
+		tx.paths = append(tx.paths, path)
+	}
+	return nil
+}
+
+// Rollback restores every recorded file: changed files get their original
+// content back and files created since their snapshot are removed. Unchanged
+// files are left alone and not reported. Rollback goes on past errors and
+// returns them joined.
+func (tx *Transaction) Rollback() (*RollbackReport, error) {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	report := &RollbackReport{}
+	var errs []error
+	for _, path := range tx.paths {
+		snap := tx.snapshots[path]
+		current, err := tx.fs.ReadFile(path)
+		exists := err == nil
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, fmt.Errorf("failed to read %s: %w", path, err))
+			continue
+		}
+
+		switch {
+		case snap.exists && (!exists || !slices.Equal(current, snap.data)):
+			if err := tx.fs.WriteFile(path, snap.data, snap.perm); err != nil {
+				errs = append(errs, fmt.Errorf("failed to restore %s: %w", path, err))
+				continue
+			}
+			report.Restored = append(report.Restored, path)
+		case !snap.exists && exists:
+			if err := tx.fs.Remove(path); err != nil {
+				errs = append(errs, fmt.Errorf("failed to remove %s: %w", path, err))
+				continue
+			}
+			report.Removed = append(report.Removed, path)
+		}
+	}
+	return report, errors.Join(errs...)
+}
+
+// Count returns the number of files the rollback restored or removed.
+func (r *RollbackReport) Count() int {
+	return len(r.Restored) + len(r.Removed)
+}
+
+// String renders the report as text, one file per line.
+func (r *RollbackReport) String() string {
+	if r.Count() == 0 {
+		return "Rolled back: no files changed\n"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Rolled back %d file%s:\n", r.Count(), pluralize(r.Count()))
+	for _, path := range r.Restored {
+		fmt.Fprintf(&sb, "  restored %s\n", path)
+	}
+	for _, path := range r.Removed {
+		fmt.Fprintf(&sb, "  removed  %s\n", path)
+	}
+	return sb.String()
+}
+
+// RollBackResults marks the successful results as rolled back: their
+// changes were undone because the transaction failed.
+func RollBackResults(results []ExecutionResult) {
+	for i := range results {
+		result := &results[i]
+		if !result.Success {
+			continue
+		}
+		result.Success = false
+		result.RolledBack = true
+		result.Error = fmt.Errorf("rolled back, %s not applied", result.NewVersion)
+		result.Module.CurrentVersion = result.OldVersion
+	}
+}
@@ -0,0 +1,114 @@
+package workspace
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTransaction_Rollback(t *testing.T) {
+	fs := setupTestFS(map[string]string{
+		"/repo/api/.version":   "1.0.0\n",
+		"/repo/web/.version":   "2.0.0\n",
+		"/repo/CHANGELOG.md":   "# Changelog\n",
+		"/repo/unchanged.json": "{}",
+	})
+
+	tx := NewTransaction(fs)
+	if err := tx.Snapshot("/repo/api/.version", "/repo/web/.version", "/repo/CHANGELOG.md", "/repo/unchanged.json", "/repo/.changes/v1.0.1.md"); err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	_ = fs.WriteFile("/repo/api/.version", []byte("1.0.1\n"), 0644)
+	// A later snapshot keeps the original content
+	if err := tx.Snapshot("/repo/api/.version"); err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+	_ = fs.WriteFile("/repo/CHANGELOG.md", []byte("# Changelog\n\n## v1.0.1\n"), 0644)
+	_ = fs.WriteFile("/repo/.changes/v1.0.1.md", []byte("## v1.0.1\n"), 0644)
+
+	report, err := tx.Rollback()
+	if err != nil {
+		t.Fatalf("Rollback() error = %v", err)
+	}
+
+	if want := []string{"/repo/api/.version", "/repo/CHANGELOG.md"}; !slices.Equal(report.Restored, want) {
+		t.Errorf("Restored = %v, want %v", report.Restored, want)
+	}
+	if want := []string{"/repo/.changes/v1.0.1.md"}; !slices.Equal(report.Removed, want) {
+		t.Errorf("Removed = %v, want %v", report.Removed, want)
+	}
+
+	for path, want := range map[string]string{
+		"/repo/api/.version": "1.0.0\n",
+		"/repo/web/.version": "2.0.0\n",
+		"/repo/CHANGELOG.md": "# Changelog\n",
+	} {
+		if data, _ := fs.ReadFile(path); string(data) != want {
+			t.Errorf("%s = %q, want %q", path, data, want)
+		}
+	}
+	if _, err := fs.ReadFile("/repo/.changes/v1.0.1.md"); err == nil {
+		t.Error("expected the created file to be removed")
+	}
+
+	text := report.String()
+	for _, want := range []string{"Rolled back 3 files:", "restored /repo/api/.version", "removed  /repo/.changes/v1.0.1.md"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("String() = %q, want it to contain %q", text, want)
+		}
+	}
+}
+
+func TestTransaction_SnapshotError(t *testing.T) {
+	fs := setupTestFS(map[string]string{"/repo/.version": "1.0.0\n"})
+	fs.StatErr = errors.New("permission denied")
+
+	if err := NewTransaction(fs).Snapshot("/repo/.version"); err == nil {
+		t.Error("Snapshot() expected error")
+	}
+}
+
+func TestTransaction_RollbackNothingChanged(t *testing.T) {
+	fs := setupTestFS(map[string]string{"/repo/.version": "1.0.0\n"})
+	tx := NewTransaction(fs)
+	if err := tx.Snapshot("/repo/.version", "/repo/missing"); err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	report, err := tx.Rollback()
+	if err != nil {
+		t.Fatalf("Rollback() error = %v", err)
+	}
+	if report.Count() != 0 {
+		t.Errorf("Count() = %d, want 0", report.Count())
+	}
+	if got := report.String(); got != "Rolled back: no files changed\n" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestRollBackResults(t *testing.T) {
+	api := &Module{Name: "api", CurrentVersion: "1.0.1"}
+	web := &Module{Name: "web", CurrentVersion: "2.0.0"}
+	results := []ExecutionResult{
+		{Module: api, OldVersion: "1.0.0", NewVersion: "1.0.1", Success: true},
+		{Module: web, OldVersion: "2.0.0", Error: errors.New("tag exists")},
+	}
+
+	RollBackResults(results)
+
+	if results[0].Success || !results[0].RolledBack || results[0].Error == nil {
+		t.Errorf("api result = %+v, want rolled back", results[0])
+	}
+	if api.CurrentVersion != "1.0.0" {
+		t.Errorf("api version = %s, want 1.0.0", api.CurrentVersion)
+	}
+	if results[1].RolledBack || results[1].Error.Error() != "tag exists" {
+		t.Errorf("web result = %+v, want unchanged failure", results[1])
+	}
+	if ErrorCount(results) != 2 {
+		t.Errorf("ErrorCount() = %d, want 2", ErrorCount(results))
+	}
+}